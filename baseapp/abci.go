@@ -6,6 +6,7 @@ import (
 	"sort"
 	"strings"
 	"syscall"
+	"time"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 
@@ -105,6 +106,13 @@ func (app *BaseApp) FilterPeerByID(info string) abci.ResponseQuery {
 func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeginBlock) {
 	defer telemetry.MeasureSince("abci", "begin_block")
 
+	// the span of the block ends on Commit
+	app.blockSpan = app.spanTracer.StartSpan("Block")
+	app.blockSpan.SetAttribute("height", req.Header.Height)
+
+	span := app.blockSpan.StartChild("BeginBlock")
+	defer span.End()
+
 	if app.cms.TracingEnabled() {
 		app.cms.SetTracingContext(sdk.TraceContext(
 			map[string]interface{}{"blockHeight": req.Header.Height},
@@ -143,6 +151,15 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 	}
 	// set the signed validators for addition to context in deliverTx
 	app.voteInfos = req.LastCommitInfo.GetVotes()
+
+	for _, s := range app.streamingServices {
+		if err := s.ListenBeginBlock(app.deliverState.ctx, req, res); err != nil {
+			app.logger.Error("BeginBlock listening hook failed", "height", req.Header.Height, "err", err)
+		}
+	}
+
+	app.speculateBlock(req)
+
 	return res
 }
 
@@ -150,14 +167,29 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 func (app *BaseApp) EndBlock(req abci.RequestEndBlock) (res abci.ResponseEndBlock) {
 	defer telemetry.MeasureSince("abci", "end_block")
 
+	span := app.blockSpan.StartChild("EndBlock")
+	defer span.End()
+
 	if app.deliverState.ms.TracingEnabled() {
 		app.deliverState.ms = app.deliverState.ms.SetTracingContext(nil).(sdk.CacheMultiStore)
 	}
 
+	app.endSpeculativeBlock()
+
+	if app.blockFinalizer != nil {
+		app.blockFinalizer(app.deliverState.ctx)
+	}
+
 	if app.endBlocker != nil {
 		res = app.endBlocker(app.deliverState.ctx, req)
 	}
 
+	for _, s := range app.streamingServices {
+		if err := s.ListenEndBlock(app.deliverState.ctx, req, res); err != nil {
+			app.logger.Error("EndBlock listening hook failed", "height", req.Height, "err", err)
+		}
+	}
+
 	return
 }
 
@@ -170,6 +202,8 @@ func (app *BaseApp) EndBlock(req abci.RequestEndBlock) (res abci.ResponseEndBloc
 func (app *BaseApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 	defer telemetry.MeasureSince("abci", "check_tx")
 
+	start := time.Now()
+
 	tx, err := app.txDecoder(req.Tx)
 	if err != nil {
 		return sdkerrors.ResponseCheckTx(err, 0, 0, app.trace)
@@ -189,6 +223,8 @@ func (app *BaseApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 	}
 
 	gInfo, result, err := app.runTx(mode, req.Tx, tx)
+	observeTx(mode, tx, start, gInfo.GasUsed)
+
 	if err != nil {
 		return sdkerrors.ResponseCheckTx(err, gInfo.GasWanted, gInfo.GasUsed, app.trace)
 	}
@@ -207,9 +243,30 @@ func (app *BaseApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 // Otherwise, the ResponseDeliverTx will contain releveant error information.
 // Regardless of tx execution outcome, the ResponseDeliverTx will contain relevant
 // gas execution context.
-func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
+func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) (res abci.ResponseDeliverTx) {
 	defer telemetry.MeasureSince("abci", "deliver_tx")
 
+	start := time.Now()
+
+	// the span of the tx is the parent of the ones of its AnteHandler and
+	// message handlers, through the Context returned by getContextForTx
+	app.txSpan = app.blockSpan.StartChild("DeliverTx")
+	defer func() {
+		app.txSpan.SetAttribute("code", res.Code)
+		app.txSpan.End()
+		app.txSpan = nil
+	}()
+
+	defer func() {
+		for _, s := range app.streamingServices {
+			if err := s.ListenDeliverTx(app.deliverState.ctx, req, res); err != nil {
+				app.logger.Error("DeliverTx listening hook failed", "err", err)
+			}
+		}
+	}()
+
+	specTx := app.nextSpeculativeTx(req.Tx)
+
 	tx, err := app.txDecoder(req.Tx)
 	if err != nil {
 		return sdkerrors.ResponseDeliverTx(err, 0, 0, app.trace)
@@ -225,21 +282,51 @@ func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx
 		telemetry.SetGauge(float32(gInfo.GasWanted), "tx", "gas", "wanted")
 	}()
 
-	gInfo, result, err := app.runTx(runTxModeDeliver, req.Tx, tx)
+	gInfo, result, failEvents, err := app.runDeliverTx(specTx, req.Tx, tx)
+	observeTx(runTxModeDeliver, tx, start, gInfo.GasUsed)
+
 	if err != nil {
 		resultStr = "failed"
-		return sdkerrors.ResponseDeliverTx(err, gInfo.GasWanted, gInfo.GasUsed, app.trace)
+		res = sdkerrors.ResponseDeliverTx(err, gInfo.GasWanted, gInfo.GasUsed, app.trace)
+		res.Info = app.deliverTxDebugInfo(gInfo, nil)
+
+		// the state changes of the PostHandler are persisted even though the
+		// tx failed, so its events are kept
+		if len(failEvents) > 0 {
+			res.Events = failEvents.ToABCIEvents()
+		}
+
+		return res
 	}
 
 	return abci.ResponseDeliverTx{
 		GasWanted: int64(gInfo.GasWanted), // TODO: Should type accept unsigned ints?
 		GasUsed:   int64(gInfo.GasUsed),   // TODO: Should type accept unsigned ints?
 		Log:       result.Log,
+		Info:      app.deliverTxDebugInfo(gInfo, result),
 		Data:      result.Data,
 		Events:    result.Events,
 	}
 }
 
+// deliverTxDebugInfo returns the Info of the response of a tx delivered in
+// debug mode, i.e. the tx's JSON encoded SimulationResponse with its gas
+// consumption by descriptor and the result of each of its messages. The Info
+// is empty otherwise.
+func (app *BaseApp) deliverTxDebugInfo(gInfo sdk.GasInfo, result *sdk.Result) string {
+	if !app.debugDeliverTx {
+		return ""
+	}
+
+	bz, err := codec.ProtoMarshalJSON(&sdk.SimulationResponse{GasInfo: gInfo, Result: result})
+	if err != nil {
+		app.logger.Error("failed to JSON encode DeliverTx debug info", "err", err)
+		return ""
+	}
+
+	return string(bz)
+}
+
 // Commit implements the ABCI interface. It will commit all state that exists in
 // the deliver state's multi-store and includes the resulting commit ID in the
 // returned abci.ResponseCommit. Commit will set the check state based on the
@@ -250,7 +337,10 @@ func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx
 func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	defer telemetry.MeasureSince("abci", "commit")
 
-	header := app.deliverState.ctx.BlockHeader()
+	span := app.blockSpan.StartChild("Commit")
+
+	ctx := app.deliverState.ctx
+	header := ctx.BlockHeader()
 
 	// Write the DeliverTx state which is cache-wrapped and commit the MultiStore.
 	// The write to the DeliverTx state writes all state transitions to the root
@@ -268,6 +358,20 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	// empty/reset the deliver state
 	app.deliverState = nil
 
+	res = abci.ResponseCommit{
+		Data: commitID.Hash,
+	}
+
+	for _, s := range app.streamingServices {
+		if err := s.ListenCommit(ctx, res); err != nil {
+			app.logger.Error("Commit listening hook failed", "height", header.Height, "err", err)
+		}
+	}
+
+	span.End()
+	app.blockSpan.End()
+	app.blockSpan = nil
+
 	var halt bool
 
 	switch {
@@ -286,9 +390,11 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 		app.halt()
 	}
 
-	return abci.ResponseCommit{
-		Data: commitID.Hash,
+	if app.snapshotInterval > 0 && uint64(header.Height)%app.snapshotInterval == 0 {
+		go app.snapshot(header.Height)
 	}
+
+	return res
 }
 
 // halt attempts to gracefully shutdown the node via SIGINT and SIGTERM falling
@@ -315,9 +421,16 @@ func (app *BaseApp) halt() {
 
 // Query implements the ABCI interface. It delegates to CommitMultiStore if it
 // implements Queryable.
-func (app *BaseApp) Query(req abci.RequestQuery) abci.ResponseQuery {
+func (app *BaseApp) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
 	defer telemetry.MeasureSince("abci", "query")
 
+	span := app.spanTracer.StartSpan("Query")
+	span.SetAttribute("path", req.Path)
+	defer func() {
+		span.SetAttribute("code", res.Code)
+		span.End()
+	}()
+
 	// handle gRPC routes first rather than calling splitPath because '/' characters
 	// are used as part of gRPC paths
 	if grpcHandler := app.grpcQueryRouter.Route(req.Path); grpcHandler != nil {
@@ -348,28 +461,53 @@ func (app *BaseApp) Query(req abci.RequestQuery) abci.ResponseQuery {
 }
 
 func (app *BaseApp) handleQueryGRPC(handler GRPCQueryHandler, req abci.RequestQuery) abci.ResponseQuery {
-	ctx, err := app.createQueryContext(req)
+	// when a client did not provide a query height, manually inject the latest
+	if req.Height == 0 {
+		req.Height = app.LastBlockHeight()
+	}
+
+	ctx, err := app.createQueryContext(req.Height, req.Prove)
 	if err != nil {
 		return sdkerrors.QueryResult(err)
 	}
 
-	res, err := handler(ctx, req)
+	return runQuery(ctx, req, func() (abci.ResponseQuery, error) {
+		return handler(ctx, req)
+	})
+}
+
+// runQuery runs a custom or gRPC query in its query Context, recovering from
+// the panics of a query exceeding the query gas limit or timeout. The gas
+// consumed by the query is reported in the Info of the response.
+func runQuery(ctx sdk.Context, req abci.RequestQuery, query func() (abci.ResponseQuery, error)) (res abci.ResponseQuery) {
+	defer func() {
+		if r := recover(); r != nil {
+			err := newQueryRecoveryHandler(ctx)(r)
+			if err == nil {
+				panic(r)
+			}
+
+			res = sdkerrors.QueryResult(err)
+			res.Height = req.Height
+		}
+
+		res.Info = fmt.Sprintf("gas used: %d", ctx.GasMeter().GasConsumed())
+	}()
+
+	res, err := query()
 	if err != nil {
 		res = sdkerrors.QueryResult(err)
 		res.Height = req.Height
-		return res
 	}
 
 	return res
 }
 
-func (app *BaseApp) createQueryContext(req abci.RequestQuery) (sdk.Context, error) {
-	// when a client did not provide a query height, manually inject the latest
-	if req.Height == 0 {
-		req.Height = app.LastBlockHeight()
-	}
-
-	if req.Height <= 1 && req.Prove {
+// createQueryContext creates a new sdk.Context for a query, taking as args
+// the block height and whether the query needs a proof or not. Callers are
+// expected to resolve a zero height to the latest committed height.
+func (app *BaseApp) createQueryContext(height int64, prove bool) (sdk.Context, error) {
+	if height <= 1 && prove {
 		return sdk.Context{},
 			sdkerrors.Wrap(
 				sdkerrors.ErrInvalidRequest,
@@ -377,23 +515,88 @@ func (app *BaseApp) createQueryContext(req abci.RequestQuery) (sdk.Context, erro
 			)
 	}
 
-	cacheMS, err := app.cms.CacheMultiStoreWithVersion(req.Height)
+	cacheMS, err := app.cms.CacheMultiStoreWithVersion(height)
 	if err != nil {
 		return sdk.Context{},
 			sdkerrors.Wrapf(
 				sdkerrors.ErrInvalidRequest,
-				"failed to load state at height %d; %s (latest height: %d)", req.Height, err, app.LastBlockHeight(),
+				"failed to load state at height %d; %s (latest height: %d)", height, err, app.LastBlockHeight(),
 			)
 	}
 
 	// cache wrap the commit-multistore for safety
 	ctx := sdk.NewContext(
 		cacheMS, app.checkState.ctx.BlockHeader(), true, app.logger,
-	).WithMinGasPrices(app.minGasPrices)
+	).WithMinGasPrices(app.minGasPrices).WithGasMeter(app.newQueryGasMeter())
 
 	return ctx, nil
 }
 
+// newQueryGasMeter returns the GasMeter of a query, limited to the query gas
+// limit and bounding the duration of the query to the query timeout, if set.
+func (app *BaseApp) newQueryGasMeter() sdk.GasMeter {
+	var gasMeter sdk.GasMeter = sdk.NewInfiniteGasMeter()
+	if app.queryGasLimit > 0 {
+		gasMeter = sdk.NewGasMeter(app.queryGasLimit)
+	}
+
+	if app.queryTimeout > 0 {
+		gasMeter = &timeoutGasMeter{
+			GasMeter: gasMeter,
+			timeout:  app.queryTimeout,
+			deadline: time.Now().Add(app.queryTimeout),
+		}
+	}
+
+	return gasMeter
+}
+
+// timeoutGasMeter is a GasMeter panicking with a queryTimeout when gas is
+// consumed past its deadline. As reading from the state consumes gas, a query
+// can't run for much longer than its timeout.
+type timeoutGasMeter struct {
+	sdk.GasMeter
+
+	timeout  time.Duration
+	deadline time.Time
+}
+
+// queryTimeout is the panic of a timeoutGasMeter consuming gas past its deadline.
+type queryTimeout struct {
+	Descriptor string
+	Timeout    time.Duration
+}
+
+func (tgm *timeoutGasMeter) ConsumeGas(amount sdk.Gas, descriptor string) {
+	if time.Now().After(tgm.deadline) {
+		panic(queryTimeout{Descriptor: descriptor, Timeout: tgm.timeout})
+	}
+
+	tgm.GasMeter.ConsumeGas(amount, descriptor)
+}
+
+// newQueryRecoveryHandler creates a RecoveryHandler processing the panics of a
+// query exceeding the query gas limit or timeout, given the query Context.
+func newQueryRecoveryHandler(ctx sdk.Context) RecoveryHandler {
+	return func(recoveryObj interface{}) error {
+		switch r := recoveryObj.(type) {
+		case sdk.ErrorOutOfGas:
+			return sdkerrors.Wrapf(
+				sdkerrors.ErrOutOfGas, "query out of gas in location: %v; gasLimit: %d, gasUsed: %d",
+				r.Descriptor, ctx.GasMeter().Limit(), ctx.GasMeter().GasConsumed(),
+			)
+
+		case queryTimeout:
+			return sdkerrors.Wrapf(
+				sdkerrors.ErrQueryTimeout, "query timed out in location: %v; timeout: %s, gasUsed: %d",
+				r.Descriptor, r.Timeout, ctx.GasMeter().GasConsumed(),
+			)
+		}
+
+		return nil
+	}
+}
+
 func handleQueryApp(app *BaseApp, path []string, req abci.RequestQuery) abci.ResponseQuery {
 	if len(path) >= 2 {
 		switch path[1] {
@@ -516,26 +719,31 @@ func handleQueryCustom(app *BaseApp, path []string, req abci.RequestQuery) abci.
 		return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "no custom querier found for route %s", path[1]))
 	}
 
-	ctx, err := app.createQueryContext(req)
-	if err != nil {
-		return sdkerrors.QueryResult(err)
+	// when a client did not provide a query height, manually inject the latest
+	if req.Height == 0 {
+		req.Height = app.LastBlockHeight()
 	}
 
-	// Passes the rest of the path as an argument to the querier.
-	//
-	// For example, in the path "custom/gov/proposal/test", the gov querier gets
-	// []string{"proposal", "test"} as the path.
-	resBytes, err := querier(ctx, path[2:], req)
+	ctx, err := app.createQueryContext(req.Height, req.Prove)
 	if err != nil {
-		res := sdkerrors.QueryResult(err)
-		res.Height = req.Height
-		return res
+		return sdkerrors.QueryResult(err)
 	}
 
-	return abci.ResponseQuery{
-		Height: req.Height,
-		Value:  resBytes,
-	}
+	return runQuery(ctx, req, func() (abci.ResponseQuery, error) {
+		// Passes the rest of the path as an argument to the querier.
+		//
+		// For example, in the path "custom/gov/proposal/test", the gov querier gets
+		// []string{"proposal", "test"} as the path.
+		resBytes, err := querier(ctx, path[2:], req)
+		if err != nil {
+			return abci.ResponseQuery{}, err
+		}
+
+		return abci.ResponseQuery{
+			Height: req.Height,
+			Value:  resBytes,
+		}, nil
+	})
 }
 
 // splitPath splits a string path using the delimiter '/'.
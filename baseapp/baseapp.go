@@ -1,9 +1,11 @@
 package baseapp
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 
@@ -13,6 +15,9 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	"github.com/cosmos/cosmos-sdk/store/snapshots"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -42,23 +47,34 @@ type (
 // BaseApp reflects the ABCI application implementation.
 type BaseApp struct { // nolint: maligned
 	// initialized on creation
-	logger          log.Logger
-	name            string               // application name from abci.Info
-	db              dbm.DB               // common DB backend
-	cms             sdk.CommitMultiStore // Main (uncached) state
-	storeLoader     StoreLoader          // function to handle store loading, may be overridden with SetStoreLoader()
-	router          sdk.Router           // handle any kind of message
-	queryRouter     sdk.QueryRouter      // router for redirecting query calls
-	grpcQueryRouter *GRPCQueryRouter     // router for redirecting gRPC query calls
-	txDecoder       sdk.TxDecoder        // unmarshal []byte into sdk.Tx
-
-	anteHandler    sdk.AnteHandler  // ante handler for fee and auth
-	initChainer    sdk.InitChainer  // initialize state with validators and state blob
-	beginBlocker   sdk.BeginBlocker // logic to run before any txs
-	endBlocker     sdk.EndBlocker   // logic to run after all txs, and to determine valset changes
-	addrPeerFilter sdk.PeerFilter   // filter peers by address and port
-	idPeerFilter   sdk.PeerFilter   // filter peers by node ID
-	fauxMerkleMode bool             // if true, IAVL MountStores uses MountStoresDB for simulation speed.
+	logger           log.Logger
+	name             string               // application name from abci.Info
+	db               dbm.DB               // common DB backend
+	cms              sdk.CommitMultiStore // Main (uncached) state
+	storeLoader      StoreLoader          // function to handle store loading, may be overridden with SetStoreLoader()
+	router           sdk.Router           // handle any kind of legacy message
+	msgServiceRouter *MsgServiceRouter    // router for redirecting Msg service messages
+	queryRouter      sdk.QueryRouter      // router for redirecting query calls
+	grpcQueryRouter  *GRPCQueryRouter     // router for redirecting gRPC query calls
+	txDecoder        sdk.TxDecoder        // unmarshal []byte into sdk.Tx
+
+	anteHandler    sdk.AnteHandler    // ante handler for fee and auth
+	postHandler    sdk.PostHandler    // post handler run after the messages, e.g. for fee refunds
+	blockFinalizer sdk.BlockFinalizer // logic to run after all txs, before the endBlocker
+	initChainer    sdk.InitChainer    // initialize state with validators and state blob
+	beginBlocker   sdk.BeginBlocker   // logic to run before any txs
+	endBlocker     sdk.EndBlocker     // logic to run after all txs, and to determine valset changes
+	addrPeerFilter sdk.PeerFilter     // filter peers by address and port
+	idPeerFilter   sdk.PeerFilter     // filter peers by node ID
+	fauxMerkleMode bool               // if true, IAVL MountStores uses MountStoresDB for simulation speed.
+
+	// manages snapshots, i.e. dumps of app state at certain intervals
+	snapshotManager    *snapshots.Manager
+	snapshotInterval   uint64 // block interval between state sync snapshots
+	snapshotKeepRecent uint32 // recent state sync snapshots to keep
+
+	// app hash of the snapshot currently being restored, set by OfferSnapshot
+	snapshotRestoreAppHash []byte
 
 	// volatile states:
 	//
@@ -81,6 +97,22 @@ type BaseApp struct { // nolint: maligned
 	// transaction. This is mainly used for DoS and spam prevention.
 	minGasPrices sdk.DecCoins
 
+	// if true, the gas consumption by descriptor and the result of each message
+	// of the txs are recorded in DeliverTx, as they are in simulations
+	debugDeliverTx bool
+
+	// maximum gas a custom or gRPC query can consume, 0 for no limit
+	queryGasLimit uint64
+
+	// tracer of the blocks, txs and queries, nil if tracing is disabled, along
+	// with the spans of the block and tx being processed
+	spanTracer *telemetry.Tracer
+	blockSpan  *telemetry.Span
+	txSpan     *telemetry.Span
+
+	// maximum duration of a custom or gRPC query, 0 for no limit
+	queryTimeout time.Duration
+
 	// flag for sealing options and parameters to a BaseApp
 	sealed bool
 
@@ -98,6 +130,23 @@ type BaseApp struct { // nolint: maligned
 
 	// trace set will return full stack traces for errors in ABCI Log field
 	trace bool
+
+	// streaming services notified of the ABCI messages and state changes of each block
+	streamingServices []StreamingService
+
+	// number of workers executing a block's txs speculatively, 0 if parallel
+	// DeliverTx execution is disabled
+	parallelDeliverTxWorkers int
+
+	// routes of the messages whose txs can be executed speculatively
+	parallelMsgRoutes map[string]bool
+
+	// provides the txs of a block at BeginBlock for their parallel execution
+	blockTxsProvider BlockTxsProvider
+
+	// speculative execution of the current block's txs, set on BeginBlock and
+	// reset on EndBlock
+	speculativeBlock *speculativeBlock
 }
 
 // NewBaseApp returns a reference to an initialized BaseApp. It accepts a
@@ -109,16 +158,17 @@ func NewBaseApp(
 	name string, logger log.Logger, db dbm.DB, txDecoder sdk.TxDecoder, options ...func(*BaseApp),
 ) *BaseApp {
 	app := &BaseApp{
-		logger:          logger,
-		name:            name,
-		db:              db,
-		cms:             store.NewCommitMultiStore(db),
-		storeLoader:     DefaultStoreLoader,
-		router:          NewRouter(),
-		queryRouter:     NewQueryRouter(),
-		grpcQueryRouter: NewGRPCQueryRouter(),
-		txDecoder:       txDecoder,
-		fauxMerkleMode:  false,
+		logger:           logger,
+		name:             name,
+		db:               db,
+		cms:              store.NewCommitMultiStore(db),
+		storeLoader:      DefaultStoreLoader,
+		router:           NewRouter(),
+		msgServiceRouter: NewMsgServiceRouter(),
+		queryRouter:      NewQueryRouter(),
+		grpcQueryRouter:  NewGRPCQueryRouter(),
+		txDecoder:        txDecoder,
+		fauxMerkleMode:   false,
 	}
 
 	for _, option := range options {
@@ -206,12 +256,14 @@ func (app *BaseApp) MountMemoryStores(keys map[string]*sdk.MemoryStoreKey) {
 // multistore, using a specified DB.
 func (app *BaseApp) MountStoreWithDB(key sdk.StoreKey, typ sdk.StoreType, db dbm.DB) {
 	app.cms.MountStoreWithDB(key, typ, db)
+	app.addListeners(key)
 }
 
 // MountStore mounts a store to the provided key in the BaseApp multistore,
 // using the default DB.
 func (app *BaseApp) MountStore(key sdk.StoreKey, typ sdk.StoreType) {
 	app.cms.MountStoreWithDB(key, typ, nil)
+	app.addListeners(key)
 }
 
 // LoadLatestVersion loads the latest application version. It will panic if
@@ -251,6 +303,19 @@ func (app *BaseApp) LastBlockHeight() int64 {
 	return app.cms.LastCommitID().Version
 }
 
+// CommitMultiStore returns the root multi-store of the app. It should only be
+// used by offline tools operating on the app's state, such as rollback.
+func (app *BaseApp) CommitMultiStore() sdk.CommitMultiStore {
+	return app.cms
+}
+
+// Close releases the resources of the app once it stopped processing blocks
+// and queries. It shuts the span tracer down, so that its exporter flushes the
+// spans and closes its file, if any.
+func (app *BaseApp) Close() error {
+	return app.spanTracer.Shutdown()
+}
+
 func (app *BaseApp) init() error {
 	if app.sealed {
 		panic("cannot call initFromMainStore: baseapp already sealed")
@@ -260,6 +325,20 @@ func (app *BaseApp) init() error {
 	app.setCheckState(abci.Header{})
 	app.Seal()
 
+	// make sure the snapshot interval is a multiple of the pruning KeepEvery interval
+	if app.snapshotManager != nil && app.snapshotInterval > 0 {
+		rms, ok := app.cms.(*rootmulti.Store)
+		if !ok {
+			return errors.New("state sync snapshots require a rootmulti store")
+		}
+		pruningOpts := rms.GetPruning()
+		if pruningOpts.KeepEvery > 0 && app.snapshotInterval%pruningOpts.KeepEvery != 0 {
+			return fmt.Errorf(
+				"state sync snapshot interval %v must be a multiple of pruning keep every interval %v",
+				app.snapshotInterval, pruningOpts.KeepEvery)
+		}
+	}
+
 	return nil
 }
 
@@ -275,6 +354,22 @@ func (app *BaseApp) setHaltTime(haltTime uint64) {
 	app.haltTime = haltTime
 }
 
+func (app *BaseApp) setDebugDeliverTx(debug bool) {
+	app.debugDeliverTx = debug
+}
+
+func (app *BaseApp) setSpanTracer(tracer *telemetry.Tracer) {
+	app.spanTracer = tracer
+}
+
+func (app *BaseApp) setQueryGasLimit(gasLimit uint64) {
+	app.queryGasLimit = gasLimit
+}
+
+func (app *BaseApp) setQueryTimeout(timeout time.Duration) {
+	app.queryTimeout = timeout
+}
+
 func (app *BaseApp) setInterBlockCache(cache sdk.MultiStorePersistentCache) {
 	app.interBlockCache = cache
 }
@@ -294,6 +389,9 @@ func (app *BaseApp) Router() sdk.Router {
 	return app.router
 }
 
+// MsgServiceRouter returns the MsgServiceRouter of a BaseApp.
+func (app *BaseApp) MsgServiceRouter() *MsgServiceRouter { return app.msgServiceRouter }
+
 // QueryRouter returns the QueryRouter of a BaseApp.
 func (app *BaseApp) QueryRouter() sdk.QueryRouter { return app.queryRouter }
 
@@ -453,7 +551,7 @@ func (app *BaseApp) getContextForTx(mode runTxMode, txBytes []byte) sdk.Context
 		WithTxBytes(txBytes).
 		WithVoteInfos(app.voteInfos)
 
-	ctx = ctx.WithConsensusParams(app.GetConsensusParams(ctx))
+	ctx = app.withTxConsensusParams(ctx)
 
 	if mode == runTxModeReCheck {
 		ctx = ctx.WithIsReCheckTx(true)
@@ -463,9 +561,22 @@ func (app *BaseApp) getContextForTx(mode runTxMode, txBytes []byte) sdk.Context
 		ctx, _ = ctx.CacheContext()
 	}
 
+	if mode == runTxModeDeliver && app.txSpan != nil {
+		ctx = ctx.WithSpan(app.txSpan)
+	}
+
 	return ctx
 }
 
+// withTxConsensusParams returns the Context of a tx with the consensus params
+// read from the param store, which consumes gas from the GasMeter of the given
+// Context. In DeliverTx, it is the GasMeter of the deliverState's Context,
+// shared by the txs of the block, so the consensus params must be read this
+// way for every tx delivered, including the ones executed speculatively.
+func (app *BaseApp) withTxConsensusParams(ctx sdk.Context) sdk.Context {
+	return ctx.WithConsensusParams(app.GetConsensusParams(ctx))
+}
+
 // cacheTxContext returns a new context based off of the provided context with
 // a cache wrapped multi-store.
 func (app *BaseApp) cacheTxContext(ctx sdk.Context, txBytes []byte) (sdk.Context, sdk.CacheMultiStore) {
@@ -488,23 +599,44 @@ func (app *BaseApp) cacheTxContext(ctx sdk.Context, txBytes []byte) (sdk.Context
 // runTx processes a transaction within a given execution mode, encoded transaction
 // bytes, and the decoded transaction itself. All state transitions occur through
 // a cached Context depending on the mode provided. State only gets persisted
-// if all messages get executed successfully and the execution mode is DeliverTx.
+// if all messages get executed successfully and the execution mode is DeliverTx,
+// except for the ones of the AnteHandler and PostHandler, see runPostHandler.
 // Note, gas execution info is always returned. A reference to a Result is
 // returned if the tx does not run out of gas and if all the messages are valid
 // and execute successfully. An error is returned otherwise.
 func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.GasInfo, result *sdk.Result, err error) {
+	gInfo, result, _, err = app.runTxWithContext(app.getContextForTx(mode, txBytes), mode, txBytes, tx)
+	return gInfo, result, unwrapAnteError(mode, err)
+}
+
+// runTxWithContext is runTx with the Context to process the transaction in,
+// which must be the one returned by getContextForTx, possibly with a
+// different MultiStore, BlockGasMeter or EventManager. The errors returned by
+// the AnteHandler are wrapped in an anteError, to be unwrapped by the caller.
+// If the messages of the tx fail, the state changes of the PostHandler are
+// persisted anyway, see runPostHandler, and its events are returned with the
+// error.
+func (app *BaseApp) runTxWithContext(
+	ctx sdk.Context, mode runTxMode, txBytes []byte, tx sdk.Tx,
+) (gInfo sdk.GasInfo, result *sdk.Result, failEvents sdk.Events, err error) {
 	// NOTE: GasWanted should be returned by the AnteHandler. GasUsed is
 	// determined by the GasMeter. We need access to the context to get the gas
 	// meter so we initialize upfront.
 	var gasWanted uint64
 
-	ctx := app.getContextForTx(mode, txBytes)
 	ms := ctx.MultiStore()
 
+	// The gas consumption by descriptor and the result of each message are
+	// recorded in simulations, and in DeliverTx in debug mode. The AnteHandler
+	// is expected to keep recording when setting its GasMeter.
+	if mode == runTxModeSimulate || (mode == runTxModeDeliver && app.debugDeliverTx) {
+		ctx = ctx.WithGasMeter(sdk.NewRecordingGasMeter(ctx.GasMeter()))
+	}
+
 	// only run the tx if there is block gas remaining
 	if mode == runTxModeDeliver && ctx.BlockGasMeter().IsOutOfGas() {
 		gInfo = sdk.GasInfo{GasUsed: ctx.BlockGasMeter().GasConsumed()}
-		return gInfo, nil, sdkerrors.Wrap(sdkerrors.ErrOutOfGas, "no block gas left to run tx")
+		return gInfo, nil, nil, sdkerrors.Wrap(sdkerrors.ErrOutOfGas, "no block gas left to run tx")
 	}
 
 	var startingGas uint64
@@ -519,6 +651,9 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.
 		}
 
 		gInfo = sdk.GasInfo{GasWanted: gasWanted, GasUsed: ctx.GasMeter().GasConsumed()}
+		if gasMeter, ok := ctx.GasMeter().(*sdk.RecordingGasMeter); ok {
+			gInfo.GasConsumption = sdk.NewGasConsumption(gasMeter.GasConsumedByDescriptor())
+		}
 	}()
 
 	// If BlockGasMeter() panics it will be caught by the above recover and will
@@ -540,7 +675,7 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.
 
 	msgs := tx.GetMsgs()
 	if err := validateBasicTxMsgs(msgs); err != nil {
-		return sdk.GasInfo{}, nil, err
+		return sdk.GasInfo{}, nil, nil, err
 	}
 
 	var events sdk.Events
@@ -559,7 +694,20 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.
 		// performance benefits, but it'll be more difficult to get right.
 		anteCtx, msCache = app.cacheTxContext(ctx, txBytes)
 		anteCtx = anteCtx.WithEventManager(sdk.NewEventManager())
+
+		txSpan := ctx.Span()
+		anteSpan := txSpan.StartChild("AnteHandler")
+		if anteSpan != nil {
+			anteCtx = anteCtx.WithSpan(anteSpan)
+		}
+
 		newCtx, err := app.anteHandler(anteCtx, tx, mode == runTxModeSimulate)
+		anteSpan.SetError(err)
+		anteSpan.End()
+
+		if anteSpan != nil && !newCtx.IsZero() {
+			newCtx = newCtx.WithSpan(txSpan)
+		}
 
 		if !newCtx.IsZero() {
 			// At this point, newCtx.MultiStore() is cache-wrapped, or something else
@@ -578,7 +726,7 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.
 		gasWanted = ctx.GasMeter().Limit()
 
 		if err != nil {
-			return gInfo, nil, err
+			return gInfo, nil, nil, anteError{err}
 		}
 
 		msCache.Write()
@@ -593,16 +741,72 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.
 	// and we're in DeliverTx. Note, runMsgs will never return a reference to a
 	// Result if any single message fails or does not have a registered Handler.
 	result, err = app.runMsgs(runMsgCtx, msgs, mode)
-	if err == nil && mode == runTxModeDeliver {
+
+	// The PostHandler is only run when the messages are executed, whether they
+	// succeeded or not.
+	var postEvents sdk.Events
+	if app.postHandler != nil && mode != runTxModeCheck && mode != runTxModeReCheck {
+		var postErr error
+		postEvents, postErr = app.runPostHandler(ctx, runMsgCtx, msCache, mode, txBytes, tx, err == nil)
+
+		if err == nil {
+			err = postErr
+		}
+	}
+
+	if err != nil {
+		// postEvents is only set if the state changes of the PostHandler
+		// were persisted, i.e. if the messages failed
+		return gInfo, nil, postEvents, err
+	}
+
+	if mode == runTxModeDeliver {
 		msCache.Write()
 
+		// append the events in the order of occurrence
 		if len(events) > 0 {
-			// append the events in the order of occurrence
 			result.Events = append(events.ToABCIEvents(), result.Events...)
 		}
+
+		if len(postEvents) > 0 {
+			result.Events = append(result.Events, postEvents.ToABCIEvents()...)
+		}
 	}
 
-	return gInfo, result, err
+	return gInfo, result, nil, err
+}
+
+// runPostHandler runs the PostHandler after the messages of a tx, with the
+// GasMeter of the tx, so that the gas they consumed is known. If the messages
+// succeeded, it runs on top of their state changes, in runMsgCtx, and its
+// state changes are written with theirs. Otherwise, their state changes are
+// discarded, and the PostHandler runs on top of the AnteHandler's only, in
+// which case its state changes are written here in DeliverTx. The events the
+// PostHandler emitted are returned.
+func (app *BaseApp) runPostHandler(
+	ctx, runMsgCtx sdk.Context, msCache sdk.CacheMultiStore, mode runTxMode, txBytes []byte, tx sdk.Tx, success bool,
+) (sdk.Events, error) {
+	postCtx, postCache := runMsgCtx, msCache
+	if !success {
+		postCtx, postCache = app.cacheTxContext(ctx, txBytes)
+	}
+
+	postCtx = postCtx.WithEventManager(sdk.NewEventManager())
+
+	newCtx, err := app.postHandler(postCtx, tx, mode == runTxModeSimulate, success)
+	if err != nil {
+		return nil, err
+	}
+
+	if newCtx.IsZero() {
+		newCtx = postCtx
+	}
+
+	if !success && mode == runTxModeDeliver {
+		postCache.Write()
+	}
+
+	return newCtx.EventManager().Events(), nil
 }
 
 // runMsgs iterates through a list of messages and executes them with the provided
@@ -617,6 +821,11 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (*s
 		Data: make([]*sdk.MsgData, 0, len(msgs)),
 	}
 
+	// the result of each message is recorded along with the gas consumption
+	txGasMeter, recording := ctx.GasMeter().(*sdk.RecordingGasMeter)
+
+	var msgResults []*sdk.MsgResult
+
 	// NOTE: GasWanted is determined by the AnteHandler and GasUsed by the GasMeter.
 	for i, msg := range msgs {
 		// skip actual execution for (Re)CheckTx mode
@@ -624,14 +833,29 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (*s
 			break
 		}
 
-		msgRoute := msg.Route()
-		handler := app.router.Route(ctx, msgRoute)
+		msgCtx := ctx
+
+		// the gas consumption of the message is also recorded on its own
+		var msgGasMeter *sdk.RecordingGasMeter
+		if recording {
+			msgGasMeter = sdk.NewRecordingGasMeter(txGasMeter)
+			msgCtx = ctx.WithGasMeter(msgGasMeter)
+		}
 
-		if handler == nil {
-			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s; message index: %d", msgRoute, i)
+		msgSpan := ctx.Span().StartChild("Msg")
+		if msgSpan != nil {
+			msgSpan.SetAttribute("index", i)
+			msgSpan.SetAttribute("type_url", sdk.MsgTypeURL(msg))
+			msgSpan.SetAttribute("route", msg.Route())
+			msgCtx = msgCtx.WithSpan(msgSpan)
 		}
 
-		msgResult, err := handler(ctx, msg)
+		gasBefore := ctx.GasMeter().GasConsumed()
+
+		msgResult, err := app.routeMsg(msgCtx, msg)
+		msgSpan.SetError(err)
+		msgSpan.End()
+
 		if err != nil {
 			return nil, sdkerrors.Wrapf(err, "failed to execute message; message index: %d", i)
 		}
@@ -649,6 +873,16 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (*s
 
 		txData.Data = append(txData.Data, &sdk.MsgData{MsgType: msg.Type(), Data: msgResult.Data})
 		msgLogs = append(msgLogs, sdk.NewABCIMessageLog(uint16(i), msgResult.Log, msgEvents))
+
+		if recording {
+			msgResults = append(msgResults, &sdk.MsgResult{
+				MsgType:        msg.Type(),
+				GasUsed:        ctx.GasMeter().GasConsumed() - gasBefore,
+				GasConsumption: sdk.NewGasConsumption(msgGasMeter.GasConsumedByDescriptor()),
+				Data:           msgResult.Data,
+				Events:         msgEvents.ToABCIEvents(),
+			})
+		}
 	}
 
 	data, err := proto.Marshal(txData)
@@ -657,8 +891,24 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (*s
 	}
 
 	return &sdk.Result{
-		Data:   data,
-		Log:    strings.TrimSpace(msgLogs.String()),
-		Events: events.ToABCIEvents(),
+		Data:       data,
+		Log:        strings.TrimSpace(msgLogs.String()),
+		Events:     events.ToABCIEvents(),
+		MsgResults: msgResults,
 	}, nil
 }
+
+// routeMsg executes a message with the handler of its Msg service if any, and
+// with the legacy handler of its route otherwise.
+func (app *BaseApp) routeMsg(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+	if handler := app.msgServiceRouter.Handler(msg); handler != nil {
+		return handler(ctx, msg)
+	}
+
+	handler := app.router.Route(ctx, msg.Route())
+	if handler == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s", msg.Route())
+	}
+
+	return handler(ctx, msg)
+}
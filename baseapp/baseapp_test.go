@@ -4,11 +4,15 @@ import (
 	"bytes"
 	"encoding/binary"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io/ioutil"
+	"math/rand"
 	"os"
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec/testdata"
 
@@ -21,6 +25,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	"github.com/cosmos/cosmos-sdk/store/snapshots"
+	snapshottypes "github.com/cosmos/cosmos-sdk/store/snapshots/types"
 	store "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -443,6 +449,15 @@ func TestBaseAppOptionSeal(t *testing.T) {
 	require.Panics(t, func() {
 		app.SetAnteHandler(nil)
 	})
+	require.Panics(t, func() {
+		app.SetPostHandler(nil)
+	})
+	require.Panics(t, func() {
+		app.SetBlockFinalizer(nil)
+	})
+	require.Panics(t, func() {
+		app.SetParallelMsgRoutes()
+	})
 	require.Panics(t, func() {
 		app.SetAddrPeerFilter(nil)
 	})
@@ -993,6 +1008,81 @@ func TestSimulateTx(t *testing.T) {
 	}
 }
 
+// Simulations and DeliverTx in debug mode record the gas consumption by
+// descriptor, and the result of each message.
+func TestSimulateTxMsgResults(t *testing.T) {
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			ctx.GasMeter().ConsumeGas(7, "ante")
+			return ctx, nil
+		})
+	}
+
+	routerOpt := func(bapp *BaseApp) {
+		r := sdk.NewRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			var counter int64
+			switch m := msg.(type) {
+			case msgCounter:
+				counter = m.Counter
+			case *msgCounter:
+				counter = m.Counter
+			}
+
+			ctx.GasMeter().ConsumeGas(uint64(counter)*10, "msg")
+			return &sdk.Result{
+				Data:   []byte{byte(counter)},
+				Events: counterEvent("handler", counter).ToABCIEvents(),
+			}, nil
+		})
+		bapp.Router().AddRoute(r)
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	cdc := codec.New()
+	registerTestCodec(cdc)
+
+	header := abci.Header{Height: 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	tx := newTxCounter(0, 1, 2)
+	txBytes, err := cdc.MarshalBinaryBare(tx)
+	require.NoError(t, err)
+
+	gInfo, result, err := app.Simulate(txBytes, tx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(37), gInfo.GasUsed)
+	require.Equal(t, []sdk.GasConsumption{{GasDescriptor: "ante", Gas: 7}, {GasDescriptor: "msg", Gas: 30}}, gInfo.GasConsumption)
+
+	require.Len(t, result.MsgResults, 2)
+	for i, msgResult := range result.MsgResults {
+		counter := int64(i + 1)
+		require.Equal(t, "counter1", msgResult.MsgType)
+		require.Equal(t, uint64(counter)*10, msgResult.GasUsed)
+		require.Equal(t, []sdk.GasConsumption{{GasDescriptor: "msg", Gas: uint64(counter) * 10}}, msgResult.GasConsumption)
+		require.Equal(t, []byte{byte(counter)}, msgResult.Data)
+		require.Len(t, msgResult.Events, 2)
+		require.Equal(t, sdk.EventTypeMessage, msgResult.Events[0].Type)
+		require.Equal(t, "handler", msgResult.Events[1].Type)
+	}
+
+	// the results of the messages aren't recorded in DeliverTx by default
+	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+	require.Empty(t, res.Info)
+
+	// in debug mode, they're reported in the response info
+	app.setDebugDeliverTx(true)
+	res = app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+
+	var simRes sdk.SimulationResponse
+	require.NoError(t, jsonpb.Unmarshal(strings.NewReader(res.Info), &simRes))
+	require.Equal(t, gInfo.GasConsumption, simRes.GasInfo.GasConsumption)
+	require.Equal(t, result.MsgResults, simRes.Result.MsgResults)
+}
+
 func TestRunInvalidTransaction(t *testing.T) {
 	anteOpt := func(bapp *BaseApp) {
 		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
@@ -1403,6 +1493,141 @@ func TestBaseAppAnteHandler(t *testing.T) {
 	app.Commit()
 }
 
+func TestBaseAppBlockFinalizer(t *testing.T) {
+	var calls []string
+
+	key := []byte("finalized")
+	finalizerOpt := func(bapp *BaseApp) {
+		bapp.SetBlockFinalizer(func(ctx sdk.Context) {
+			calls = append(calls, "finalizer")
+			setIntOnStore(ctx.KVStore(capKey1), key, ctx.BlockHeight())
+		})
+	}
+	endBlockerOpt := func(bapp *BaseApp) {
+		bapp.SetEndBlocker(func(ctx sdk.Context, _ abci.RequestEndBlock) abci.ResponseEndBlock {
+			calls = append(calls, "endblocker")
+
+			// the state changes of the finalizer are visible to the EndBlocker
+			require.Equal(t, ctx.BlockHeight(), getIntFromStore(ctx.KVStore(capKey1), key))
+
+			return abci.ResponseEndBlock{}
+		})
+	}
+
+	app := setupBaseApp(t, finalizerOpt, endBlockerOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	header := abci.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	app.Commit()
+
+	require.Equal(t, []string{"finalizer", "endblocker"}, calls)
+}
+
+func TestBaseAppPostHandler(t *testing.T) {
+	anteKey := []byte("ante-key")
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey))
+	}
+
+	postKey := []byte("post-key")
+	failPost := false
+	var postSuccess []bool
+	postOpt := func(bapp *BaseApp) {
+		bapp.SetPostHandler(func(ctx sdk.Context, tx sdk.Tx, simulate, success bool) (sdk.Context, error) {
+			postSuccess = append(postSuccess, success)
+			if failPost {
+				return ctx, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "post handler failure")
+			}
+
+			store := ctx.KVStore(capKey1)
+			setIntOnStore(store, postKey, getIntFromStore(store, postKey)+1)
+
+			ctx.EventManager().EmitEvents(counterEvent("post_handler", tx.(txTest).Counter))
+
+			return ctx, nil
+		})
+	}
+
+	deliverKey := []byte("deliver-key")
+	routerOpt := func(bapp *BaseApp) {
+		r := sdk.NewRoute(routeMsgCounter, handlerMsgCounter(t, capKey1, deliverKey))
+		bapp.Router().AddRoute(r)
+	}
+
+	cdc := codec.New()
+	app := setupBaseApp(t, anteOpt, postOpt, routerOpt)
+
+	app.InitChain(abci.RequestInitChain{})
+	registerTestCodec(cdc)
+
+	header := abci.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	// the post handler isn't run in CheckTx
+	tx := newTxCounter(0, 0)
+	txBytes, err := cdc.MarshalBinaryBare(tx)
+	require.NoError(t, err)
+	checkRes := app.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+	require.True(t, checkRes.IsOK(), fmt.Sprintf("%v", checkRes))
+	require.Empty(t, postSuccess)
+
+	// the post handler is run when the message handler fails, and its state
+	// changes are kept with the ante handler's
+	tx = newTxCounter(0, 0)
+	tx.setFailOnHandler(true)
+	txBytes, err = cdc.MarshalBinaryBare(tx)
+	require.NoError(t, err)
+
+	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.False(t, res.IsOK(), fmt.Sprintf("%v", res))
+	require.Equal(t, []bool{false}, postSuccess)
+
+	store := app.getState(runTxModeDeliver).ctx.KVStore(capKey1)
+	require.Equal(t, int64(1), getIntFromStore(store, anteKey))
+	require.Equal(t, int64(0), getIntFromStore(store, deliverKey))
+	require.Equal(t, int64(1), getIntFromStore(store, postKey))
+
+	// and so are the events of the post handler
+	require.Equal(t, counterEvent("post_handler", 0).ToABCIEvents(), res.Events)
+
+	// the events of the post handler follow the ones of the messages
+	tx = newTxCounter(1, 0)
+	txBytes, err = cdc.MarshalBinaryBare(tx)
+	require.NoError(t, err)
+
+	res = app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+	require.Equal(t, []bool{false, true}, postSuccess)
+	require.Equal(t, "post_handler", res.Events[len(res.Events)-1].Type)
+
+	store = app.getState(runTxModeDeliver).ctx.KVStore(capKey1)
+	require.Equal(t, int64(2), getIntFromStore(store, anteKey))
+	require.Equal(t, int64(1), getIntFromStore(store, deliverKey))
+	require.Equal(t, int64(2), getIntFromStore(store, postKey))
+
+	// the tx fails with the post handler, and the state changes of the
+	// messages are discarded
+	failPost = true
+	tx = newTxCounter(2, 1)
+	txBytes, err = cdc.MarshalBinaryBare(tx)
+	require.NoError(t, err)
+
+	res = app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.False(t, res.IsOK(), fmt.Sprintf("%v", res))
+	require.Equal(t, sdkerrors.ErrUnauthorized.ABCICode(), res.Code)
+	require.Empty(t, res.Events)
+
+	store = app.getState(runTxModeDeliver).ctx.KVStore(capKey1)
+	require.Equal(t, int64(3), getIntFromStore(store, anteKey))
+	require.Equal(t, int64(1), getIntFromStore(store, deliverKey))
+	require.Equal(t, int64(2), getIntFromStore(store, postKey))
+
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+}
+
 func TestGasConsumptionBadTx(t *testing.T) {
 	gasWanted := uint64(5)
 	anteOpt := func(bapp *BaseApp) {
@@ -1561,6 +1786,7 @@ func TestGRPCQuery(t *testing.T) {
 	resQuery := app.Query(reqQuery)
 
 	require.Equal(t, abci.CodeTypeOK, resQuery.Code, resQuery)
+	require.Equal(t, "gas used: 0", resQuery.Info)
 
 	var res testdata.SayHelloResponse
 	err = res.Unmarshal(resQuery.Value)
@@ -1568,6 +1794,63 @@ func TestGRPCQuery(t *testing.T) {
 	require.Equal(t, "Hello foo!", res.Greeting)
 }
 
+func TestQueryGasLimitAndTimeout(t *testing.T) {
+	key, value := []byte("hello"), []byte("goodbye")
+
+	// the querier reads the key 10 times, after sleeping for the given duration
+	queryOpt := func(bapp *BaseApp) {
+		bapp.QueryRouter().AddRoute("test", func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+			sleep, err := time.ParseDuration(path[0])
+			require.NoError(t, err)
+			time.Sleep(sleep)
+
+			store := ctx.KVStore(capKey1)
+			for i := 0; i < 9; i++ {
+				store.Get(key)
+			}
+
+			return store.Get(key), nil
+		})
+	}
+
+	testCases := []struct {
+		msg      string
+		options  []func(*BaseApp)
+		path     string
+		expected *sdkerrors.Error
+	}{
+		{"no limits", nil, "/custom/test/0s", nil},
+		{"within limits", []func(*BaseApp){SetQueryGasLimit(100000), SetQueryTimeout(time.Minute)}, "/custom/test/0s", nil},
+		{"out of gas", []func(*BaseApp){SetQueryGasLimit(5000)}, "/custom/test/0s", sdkerrors.ErrOutOfGas},
+		{"timeout", []func(*BaseApp){SetQueryTimeout(10 * time.Millisecond)}, "/custom/test/20ms", sdkerrors.ErrQueryTimeout},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.msg, func(t *testing.T) {
+			app := setupBaseApp(t, append(tc.options, queryOpt)...)
+			app.InitChain(abci.RequestInitChain{})
+
+			header := abci.Header{Height: app.LastBlockHeight() + 1}
+			app.BeginBlock(abci.RequestBeginBlock{Header: header})
+			app.deliverState.ctx.KVStore(capKey1).Set(key, value)
+			app.Commit()
+
+			res := app.Query(abci.RequestQuery{Path: tc.path})
+			require.Equal(t, int64(1), res.Height)
+			require.Regexp(t, "^gas used: [0-9]+$", res.Info)
+
+			if tc.expected == nil {
+				require.Equal(t, abci.CodeTypeOK, res.Code, res.Log)
+				require.Equal(t, value, res.Value)
+			} else {
+				require.Equal(t, tc.expected.ABCICode(), res.Code, res.Log)
+				require.Equal(t, tc.expected.Codespace(), res.Codespace)
+			}
+		})
+	}
+}
+
 // Test p2p filter queries
 func TestP2PQuery(t *testing.T) {
 	addrPeerFilterOpt := func(bapp *BaseApp) {
@@ -1678,3 +1961,208 @@ func TestWithRouter(t *testing.T) {
 		app.Commit()
 	}
 }
+
+func setupBaseAppWithSnapshots(t *testing.T, blocks uint, blockTxs int, options ...func(*BaseApp)) (*BaseApp, func()) {
+	snapshotInterval := uint64(2)
+	snapshotTimeout := 1 * time.Minute
+	snapshotDir, err := ioutil.TempDir("", "baseapp")
+	require.NoError(t, err)
+	snapshotStore, err := snapshots.NewStore(dbm.NewMemDB(), snapshotDir)
+	require.NoError(t, err)
+	teardown := func() {
+		os.RemoveAll(snapshotDir)
+	}
+
+	app := setupBaseApp(t, append(options,
+		SetSnapshotStore(snapshotStore),
+		SetSnapshotInterval(snapshotInterval),
+		SetPruning(sdk.PruningOptions{KeepEvery: 1}),
+	)...)
+
+	r := rand.New(rand.NewSource(3920758213583))
+	keyCounter := 0
+	for height := int64(1); height <= int64(blocks); height++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: height}})
+		store := app.deliverState.ctx.KVStore(capKey2)
+		for txNum := 0; txNum < blockTxs; txNum++ {
+			key := []byte(fmt.Sprintf("%v", keyCounter))
+			value := make([]byte, 10000)
+			_, err := r.Read(value)
+			require.NoError(t, err)
+			store.Set(key, value)
+			keyCounter++
+		}
+		app.EndBlock(abci.RequestEndBlock{Height: height})
+		app.Commit()
+
+		// Wait for snapshot to be taken, since it happens asynchronously.
+		if uint64(height)%snapshotInterval == 0 {
+			start := time.Now()
+			for {
+				if time.Since(start) > snapshotTimeout {
+					t.Errorf("timed out waiting for snapshot after %v", snapshotTimeout)
+				}
+				snapshot, err := snapshotStore.Get(uint64(height), snapshottypes.CurrentFormat)
+				require.NoError(t, err)
+				if snapshot != nil {
+					break
+				}
+				time.Sleep(100 * time.Millisecond)
+			}
+		}
+	}
+
+	return app, teardown
+}
+
+func TestListSnapshots(t *testing.T) {
+	app, teardown := setupBaseAppWithSnapshots(t, 5, 4)
+	defer teardown()
+
+	snapshots, err := app.ListSnapshots()
+	require.NoError(t, err)
+	require.Len(t, snapshots, 2)
+	assert.EqualValues(t, 4, snapshots[0].Height)
+	assert.EqualValues(t, 2, snapshots[1].Height)
+	for _, s := range snapshots {
+		assert.EqualValues(t, snapshottypes.CurrentFormat, s.Format)
+		assert.NoError(t, s.Validate())
+	}
+}
+
+func TestSnapshotKeepRecent(t *testing.T) {
+	app, teardown := setupBaseAppWithSnapshots(t, 7, 1, SetSnapshotKeepRecent(2))
+	defer teardown()
+
+	// pruning happens after the snapshot at height 6 is saved, so wait for it
+	// to settle before listing
+	var snapshots []*snapshottypes.Snapshot
+	var err error
+	for i := 0; i < 50; i++ {
+		snapshots, err = app.ListSnapshots()
+		require.NoError(t, err)
+		if len(snapshots) == 2 {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	require.Len(t, snapshots, 2)
+	assert.EqualValues(t, 6, snapshots[0].Height)
+	assert.EqualValues(t, 4, snapshots[1].Height)
+}
+
+func TestLoadSnapshotChunk(t *testing.T) {
+	app, teardown := setupBaseAppWithSnapshots(t, 2, 5)
+	defer teardown()
+
+	testcases := map[string]struct {
+		height      uint64
+		format      uint32
+		chunk       uint32
+		expectEmpty bool
+	}{
+		"Existing snapshot": {2, snapshottypes.CurrentFormat, 0, false},
+		"Missing height":    {100, snapshottypes.CurrentFormat, 0, true},
+		"Missing format":    {2, 3, 0, true},
+		"Missing chunk":     {2, snapshottypes.CurrentFormat, 9, true},
+		"Zero height":       {0, snapshottypes.CurrentFormat, 0, true},
+		"Zero format":       {2, 0, 0, true},
+	}
+	for name, tc := range testcases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			chunk, err := app.LoadSnapshotChunk(tc.height, tc.format, tc.chunk)
+			require.NoError(t, err)
+			if tc.expectEmpty {
+				assert.Empty(t, chunk)
+				return
+			}
+			assert.NotEmpty(t, chunk)
+		})
+	}
+}
+
+func TestOfferSnapshot_Errors(t *testing.T) {
+	app, teardown := setupBaseAppWithSnapshots(t, 0, 0)
+	defer teardown()
+
+	hash := []byte{1, 2, 3}
+	m := snapshottypes.Metadata{ChunkHashes: [][]byte{{1}, {2}, {3}}}
+
+	testcases := map[string]struct {
+		snapshot *snapshottypes.Snapshot
+		appHash  []byte
+	}{
+		"nil snapshot":    {nil, hash},
+		"empty app hash":  {&snapshottypes.Snapshot{Height: 1, Format: snapshottypes.CurrentFormat, Chunks: 3, Hash: hash, Metadata: m}, nil},
+		"invalid format":  {&snapshottypes.Snapshot{Height: 1, Format: 9, Chunks: 3, Hash: hash, Metadata: m}, hash},
+		"no chunks":       {&snapshottypes.Snapshot{Height: 1, Format: snapshottypes.CurrentFormat, Chunks: 0, Hash: hash, Metadata: m}, hash},
+		"chunks mismatch": {&snapshottypes.Snapshot{Height: 1, Format: snapshottypes.CurrentFormat, Chunks: 2, Hash: hash, Metadata: m}, hash},
+	}
+	for name, tc := range testcases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			require.Error(t, app.OfferSnapshot(tc.snapshot, tc.appHash))
+		})
+	}
+
+	// Offering a snapshot after one has been accepted should error
+	snapshot := &snapshottypes.Snapshot{Height: 1, Format: snapshottypes.CurrentFormat, Chunks: 3, Hash: hash, Metadata: m}
+	require.NoError(t, app.OfferSnapshot(snapshot, hash))
+	require.Error(t, app.OfferSnapshot(snapshot, hash))
+}
+
+func TestApplySnapshotChunk(t *testing.T) {
+	source, teardown := setupBaseAppWithSnapshots(t, 4, 10)
+	defer teardown()
+
+	target, teardown := setupBaseAppWithSnapshots(t, 0, 0)
+	defer teardown()
+
+	// Fetch latest snapshot to restore
+	snapshots, err := source.ListSnapshots()
+	require.NoError(t, err)
+	require.NotEmpty(t, snapshots)
+	snapshot := snapshots[0]
+
+	// Offer it to the target, with a mismatching app hash
+	err = target.OfferSnapshot(snapshot, []byte{1, 2, 3})
+	require.NoError(t, err)
+
+	// Restore it, which should fail the app hash verification
+	applySnapshotChunks(t, source, target, snapshot, snapshottypes.ErrAppHashMismatch)
+
+	// Start over with a new target and the correct app hash
+	target, teardown = setupBaseAppWithSnapshots(t, 0, 0)
+	defer teardown()
+
+	err = target.OfferSnapshot(snapshot, source.LastCommitID().Hash)
+	require.NoError(t, err)
+	applySnapshotChunks(t, source, target, snapshot, nil)
+
+	// The target should now have the same hash as the source
+	assert.Equal(t, source.LastCommitID(), target.LastCommitID())
+}
+
+func applySnapshotChunks(t *testing.T, source, target *BaseApp, snapshot *snapshottypes.Snapshot, expectErr error) {
+	for chunk := uint32(0); chunk < snapshot.Chunks; chunk++ {
+		body, err := source.LoadSnapshotChunk(snapshot.Height, snapshot.Format, chunk)
+		require.NoError(t, err)
+
+		done, err := target.ApplySnapshotChunk(body)
+		if chunk < snapshot.Chunks-1 {
+			require.NoError(t, err)
+			require.False(t, done)
+			continue
+		}
+
+		if expectErr != nil {
+			require.Error(t, err)
+			require.True(t, errors.Is(err, expectErr))
+			return
+		}
+
+		require.NoError(t, err)
+		require.True(t, done)
+	}
+}
@@ -0,0 +1,129 @@
+package baseapp
+
+import (
+	gocontext "context"
+	"strconv"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+)
+
+// grpcMethodHandler defines the signature of the handler of a gRPC unary method
+// as found in a grpc.MethodDesc.
+type grpcMethodHandler = func(srv interface{}, ctx gocontext.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error)
+
+// RegisterGRPCServer registers every Query service registered with the
+// BaseApp's GRPCQueryRouter directly with the provided gRPC server. Each
+// method handler is wrapped such that it receives an sdk.Context built from
+// committed state, in the same way ABCI queries do. The query height may be
+// pinned through the GRPCBlockHeightHeader request metadata and defaults to
+// the latest committed height; the served height is returned as a response
+// header.
+func (app *BaseApp) RegisterGRPCServer(server gogogrpc.Server) {
+	for _, data := range app.grpcQueryRouter.serviceData {
+		desc := data.serviceDesc
+		newMethods := make([]grpc.MethodDesc, len(desc.Methods))
+
+		for i, method := range desc.Methods {
+			newMethods[i] = grpc.MethodDesc{
+				MethodName: method.MethodName,
+				Handler:    app.wrapGRPCMethodHandler(method.Handler),
+			}
+		}
+
+		newDesc := &grpc.ServiceDesc{
+			ServiceName: desc.ServiceName,
+			HandlerType: desc.HandlerType,
+			Methods:     newMethods,
+			Streams:     desc.Streams,
+			Metadata:    desc.Metadata,
+		}
+
+		server.RegisterService(newDesc, data.handler)
+	}
+}
+
+// wrapGRPCMethodHandler wraps a gRPC method handler so that it is executed
+// against a query context of the application's committed state at the
+// requested height. Queries exceeding the query gas limit or timeout fail with
+// a ResourceExhausted or DeadlineExceeded error, and any other panic raised by
+// the handler is recovered and returned as an internal gRPC error.
+func (app *BaseApp) wrapGRPCMethodHandler(methodHandler grpcMethodHandler) grpcMethodHandler {
+	return func(srv interface{}, ctx gocontext.Context, dec func(interface{}) error, _ grpc.UnaryServerInterceptor) (res interface{}, err error) {
+		var sdkCtx sdk.Context
+
+		defer func() {
+			if r := recover(); r != nil {
+				queryErr := newQueryRecoveryHandler(sdkCtx)(r)
+
+				switch {
+				case sdkerrors.ErrOutOfGas.Is(queryErr):
+					res, err = nil, status.Error(codes.ResourceExhausted, queryErr.Error())
+
+				case sdkerrors.ErrQueryTimeout.Is(queryErr):
+					res, err = nil, status.Error(codes.DeadlineExceeded, queryErr.Error())
+
+				default:
+					res, err = nil, status.Errorf(codes.Internal, "panic while handling query: %v", r)
+				}
+			}
+		}()
+
+		// get the height header from the request metadata, if present
+		var height int64
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if heights := md.Get(grpctypes.GRPCBlockHeightHeader); len(heights) > 0 {
+				height, err = strconv.ParseInt(heights[0], 10, 64)
+				if err != nil {
+					return nil, status.Errorf(
+						codes.InvalidArgument,
+						"invalid %s header %q: %s", grpctypes.GRPCBlockHeightHeader, heights[0], err,
+					)
+				}
+				if height < 0 {
+					return nil, status.Errorf(
+						codes.InvalidArgument,
+						"%s header must be non-negative, got %d", grpctypes.GRPCBlockHeightHeader, height,
+					)
+				}
+			}
+		}
+
+		// when a client did not provide a query height, manually inject the latest
+		if height == 0 {
+			height = app.LastBlockHeight()
+		}
+
+		sdkCtx, err = app.createQueryContext(height, false)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		// echo the height the query is served at back to the client
+		if err := grpc.SetHeader(ctx, metadata.Pairs(grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10))); err != nil {
+			return nil, err
+		}
+
+		unpackingDec := func(i interface{}) error {
+			if err := dec(i); err != nil {
+				return err
+			}
+
+			if anyUnpacker := app.grpcQueryRouter.AnyUnpacker(); anyUnpacker != nil {
+				return types.UnpackInterfaces(i, anyUnpacker)
+			}
+
+			return nil
+		}
+
+		return methodHandler(srv, sdk.WrapSDKContext(sdkCtx.WithContext(ctx)), unpackingDec, nil)
+	}
+}
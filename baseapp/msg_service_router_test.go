@@ -0,0 +1,74 @@
+package baseapp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+type testBankMsgServer struct{}
+
+var _ banktypes.MsgServer = testBankMsgServer{}
+
+func (testBankMsgServer) Send(goCtx context.Context, msg *banktypes.MsgSend) (*banktypes.MsgSendResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx.EventManager().EmitEvent(sdk.NewEvent("send", sdk.NewAttribute("amount", msg.Amount.String())))
+
+	return &banktypes.MsgSendResponse{}, nil
+}
+
+func (testBankMsgServer) MultiSend(context.Context, *banktypes.MsgMultiSend) (*banktypes.MsgMultiSendResponse, error) {
+	return nil, sdkerrors.ErrInsufficientFunds
+}
+
+func TestMsgServiceRouter(t *testing.T) {
+	msr := NewMsgServiceRouter()
+	banktypes.RegisterMsgServer(msr, testBankMsgServer{})
+
+	msgSend := banktypes.NewMsgSend(nil, nil, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	require.Equal(t, "/cosmos.bank.MsgSend", sdk.MsgTypeURL(msgSend))
+
+	handler := msr.Handler(msgSend)
+	require.NotNil(t, handler)
+	require.NotNil(t, msr.HandlerByTypeURL("/cosmos.bank.MsgMultiSend"))
+	require.Nil(t, msr.HandlerByTypeURL("/cosmos.bank.MsgUnknown"))
+
+	ctx := sdk.NewContext(nil, abci.Header{}, false, log.NewNopLogger())
+	res, err := handler(ctx, msgSend)
+	require.NoError(t, err)
+	require.Equal(t, []abci.Event{
+		abci.Event(sdk.NewEvent("send", sdk.NewAttribute("amount", "10stake"))),
+	}, res.Events)
+
+	var resData banktypes.MsgSendResponse
+	require.NoError(t, resData.Unmarshal(res.Data))
+
+	// the events of the handler are not emitted to the caller's event manager
+	require.Empty(t, ctx.EventManager().Events())
+
+	_, err = msr.Handler(&banktypes.MsgMultiSend{})(ctx, &banktypes.MsgMultiSend{})
+	require.Equal(t, sdkerrors.ErrInsufficientFunds, err)
+}
+
+func TestMsgServiceRouter_RegisterServicePanics(t *testing.T) {
+	msr := NewMsgServiceRouter()
+	banktypes.RegisterMsgServer(msr, testBankMsgServer{})
+
+	// a request type can only be handled by a single service
+	require.Panics(t, func() {
+		banktypes.RegisterMsgServer(msr, testBankMsgServer{})
+	})
+
+	// the request types must be messages
+	require.Panics(t, func() {
+		testdata.RegisterTestServiceServer(msr, testdata.TestServiceImpl{})
+	})
+}
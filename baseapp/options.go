@@ -3,10 +3,13 @@ package baseapp
 import (
 	"fmt"
 	"io"
+	"time"
 
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/store/snapshots"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -38,6 +41,32 @@ func SetHaltTime(haltTime uint64) func(*BaseApp) {
 	return func(bap *BaseApp) { bap.setHaltTime(haltTime) }
 }
 
+// SetDebugDeliverTx returns a BaseApp option function that enables the debug
+// mode of DeliverTx, in which the gas consumption by descriptor and the result
+// of each message of a tx are reported in the Info of its response.
+func SetDebugDeliverTx(debug bool) func(*BaseApp) {
+	return func(bap *BaseApp) { bap.setDebugDeliverTx(debug) }
+}
+
+// SetSpanTracer returns a BaseApp option function that sets the Tracer opening
+// spans for the blocks, with child spans for BeginBlock, each DeliverTx, with
+// its AnteHandler and message handlers, EndBlock and Commit, and for each Query.
+func SetSpanTracer(tracer *telemetry.Tracer) func(*BaseApp) {
+	return func(bap *BaseApp) { bap.setSpanTracer(tracer) }
+}
+
+// SetQueryGasLimit returns a BaseApp option function that sets the maximum gas
+// a custom or gRPC query can consume, 0 meaning no limit.
+func SetQueryGasLimit(gasLimit uint64) func(*BaseApp) {
+	return func(bap *BaseApp) { bap.setQueryGasLimit(gasLimit) }
+}
+
+// SetQueryTimeout returns a BaseApp option function that sets the maximum
+// duration of a custom or gRPC query, 0 meaning no limit.
+func SetQueryTimeout(timeout time.Duration) func(*BaseApp) {
+	return func(bap *BaseApp) { bap.setQueryTimeout(timeout) }
+}
+
 // SetTrace will turn on or off trace flag
 func SetTrace(trace bool) func(*BaseApp) {
 	return func(app *BaseApp) { app.setTrace(trace) }
@@ -49,6 +78,33 @@ func SetInterBlockCache(cache sdk.MultiStorePersistentCache) func(*BaseApp) {
 	return func(app *BaseApp) { app.setInterBlockCache(cache) }
 }
 
+// SetSnapshotStore sets the snapshot store.
+func SetSnapshotStore(snapshotStore *snapshots.Store) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetSnapshotStore(snapshotStore) }
+}
+
+// SetSnapshotInterval sets the snapshot interval.
+func SetSnapshotInterval(interval uint64) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetSnapshotInterval(interval) }
+}
+
+// SetSnapshotKeepRecent sets the recent snapshots to keep.
+func SetSnapshotKeepRecent(keepRecent uint32) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetSnapshotKeepRecent(keepRecent) }
+}
+
+// SetStreamingService returns a BaseApp option function that registers a
+// streaming service.
+func SetStreamingService(s StreamingService) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetStreamingService(s) }
+}
+
+// SetParallelDeliverTx returns a BaseApp option function that enables the
+// parallel execution of DeliverTx with the given number of workers.
+func SetParallelDeliverTx(workers int) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetParallelDeliverTx(workers) }
+}
+
 func (app *BaseApp) SetName(name string) {
 	if app.sealed {
 		panic("SetName() on sealed BaseApp")
@@ -123,6 +179,26 @@ func (app *BaseApp) SetAnteHandler(ah sdk.AnteHandler) {
 	app.anteHandler = ah
 }
 
+// SetPostHandler sets the PostHandler, which is run after the messages of a tx
+// in DeliverTx and in simulations, whether they succeeded or not.
+func (app *BaseApp) SetPostHandler(ph sdk.PostHandler) {
+	if app.sealed {
+		panic("SetPostHandler() on sealed BaseApp")
+	}
+
+	app.postHandler = ph
+}
+
+// SetBlockFinalizer sets the BlockFinalizer, which is run at the end of each
+// block, before the EndBlocker.
+func (app *BaseApp) SetBlockFinalizer(bf sdk.BlockFinalizer) {
+	if app.sealed {
+		panic("SetBlockFinalizer() on sealed BaseApp")
+	}
+
+	app.blockFinalizer = bf
+}
+
 func (app *BaseApp) SetAddrPeerFilter(pf sdk.PeerFilter) {
 	if app.sealed {
 		panic("SetAddrPeerFilter() on sealed BaseApp")
@@ -147,6 +223,41 @@ func (app *BaseApp) SetFauxMerkleMode() {
 	app.fauxMerkleMode = true
 }
 
+// SetSnapshotStore sets the snapshot store used to create and restore state
+// sync snapshots of the application's CommitMultiStore.
+func (app *BaseApp) SetSnapshotStore(snapshotStore *snapshots.Store) {
+	if app.sealed {
+		panic("SetSnapshotStore() on sealed BaseApp")
+	}
+
+	if snapshotStore == nil {
+		app.snapshotManager = nil
+		return
+	}
+
+	app.snapshotManager = snapshots.NewManager(snapshotStore, app.cms)
+}
+
+// SetSnapshotInterval sets the snapshot interval, in blocks. A value of 0
+// disables snapshots.
+func (app *BaseApp) SetSnapshotInterval(snapshotInterval uint64) {
+	if app.sealed {
+		panic("SetSnapshotInterval() on sealed BaseApp")
+	}
+
+	app.snapshotInterval = snapshotInterval
+}
+
+// SetSnapshotKeepRecent sets the number of recent snapshots to keep. A value
+// of 0 keeps all snapshots.
+func (app *BaseApp) SetSnapshotKeepRecent(snapshotKeepRecent uint32) {
+	if app.sealed {
+		panic("SetSnapshotKeepRecent() on sealed BaseApp")
+	}
+
+	app.snapshotKeepRecent = snapshotKeepRecent
+}
+
 // SetCommitMultiStoreTracer sets the store tracer on the BaseApp's underlying
 // CommitMultiStore.
 func (app *BaseApp) SetCommitMultiStoreTracer(w io.Writer) {
@@ -0,0 +1,347 @@
+package baseapp
+
+import (
+	"bytes"
+	"runtime"
+	"sync"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/store/rwset"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BlockTxsProvider returns the txs of the block with the given height and
+// hash, as passed to BeginBlock, or false if they aren't known.
+type BlockTxsProvider func(height int64, hash []byte) ([][]byte, bool)
+
+// speculativeBlock holds the results of the speculative execution of a
+// block's txs, which are run concurrently, each in its own branch of the
+// deliverState left by BeginBlock.
+//
+// When a tx is delivered, its speculative result is used if none of the keys
+// it read or iterated over was written by the txs delivered before it, in
+// which case it is the result the tx would have had if executed serially.
+// Otherwise, the tx is re-executed on the deliverState. Either way, the keys
+// written by the tx are recorded to check the txs delivered after it.
+type speculativeBlock struct {
+	txs []*speculativeTx
+
+	// index in txs of the tx expected by the next DeliverTx
+	next int
+
+	// keys written to the deliverState by the txs delivered so far
+	written *rwset.RWSet
+
+	// number of txs whose speculative result was used, and of txs which were
+	// re-executed
+	applied, reexecuted int
+}
+
+// speculativeTx is the result of the speculative execution of a tx.
+type speculativeTx struct {
+	txBytes []byte
+
+	// branch of the deliverState holding the writes of the tx, nil if the
+	// speculative result can't be used
+	ms    sdk.CacheMultiStore
+	rwSet *rwset.RWSet
+
+	// block gas consumed, and events emitted to the block's EventManager
+	blockGasUsed uint64
+	events       sdk.Events
+
+	gInfo      sdk.GasInfo
+	result     *sdk.Result
+	failEvents sdk.Events
+	err        error
+
+	// recording span of the tx, whose spans are exported under the span of its
+	// DeliverTx if its speculative result is used
+	span *telemetry.Span
+}
+
+// SetParallelDeliverTx enables the parallel execution of the txs of the
+// blocks provided by the BlockTxsProvider, using the given number of workers,
+// or one per CPU if workers is not positive.
+//
+// Txs are executed speculatively at the end of BeginBlock and DeliverTx then
+// uses their results, or re-executes them in order if they conflict with the
+// txs delivered before them, so that the resulting state and responses are
+// the same as with serial execution. This requires txs to only have side
+// effects through the MultiStore of their Context, since the speculative
+// execution of a tx may be discarded. Only the txs whose messages all have
+// routes set with SetParallelMsgRoutes are executed speculatively, the others
+// are executed serially by DeliverTx. Txs writing the same keys conflict, so
+// state shared by all the txs, like the balance of the fee collector, should
+// be updated once per block, e.g. by the BlockFinalizer, rather than by each
+// tx.
+func (app *BaseApp) SetParallelDeliverTx(workers int) {
+	if app.sealed {
+		panic("SetParallelDeliverTx() on sealed BaseApp")
+	}
+
+	if workers < 1 {
+		workers = runtime.NumCPU()
+	}
+
+	app.parallelDeliverTxWorkers = workers
+}
+
+// SetParallelMsgRoutes sets the routes of the messages whose txs can be
+// executed speculatively when parallel DeliverTx execution is enabled. The
+// handlers of these messages, like the AnteHandler, must only have side
+// effects through the MultiStore of their Context: keepers holding in-memory
+// state, like the capability keeper used by IBC, aren't safe to run
+// concurrently, and would keep the changes of discarded speculative
+// executions.
+func (app *BaseApp) SetParallelMsgRoutes(routes ...string) {
+	if app.sealed {
+		panic("SetParallelMsgRoutes() on sealed BaseApp")
+	}
+
+	app.parallelMsgRoutes = make(map[string]bool, len(routes))
+	for _, route := range routes {
+		app.parallelMsgRoutes[route] = true
+	}
+}
+
+// SetBlockTxsProvider sets the BlockTxsProvider used to execute the txs of a
+// block in parallel. As the provider typically depends on the node running
+// the app, it can be set once the app is sealed but must be set before the
+// app processes any block.
+func (app *BaseApp) SetBlockTxsProvider(provider BlockTxsProvider) {
+	app.blockTxsProvider = provider
+}
+
+// speculateBlock executes the txs of the block being begun speculatively, if
+// parallel DeliverTx execution is enabled and the txs are known. It is
+// disabled while tracing, as the speculative accesses would be traced too.
+func (app *BaseApp) speculateBlock(req abci.RequestBeginBlock) {
+	app.speculativeBlock = nil
+
+	if app.parallelDeliverTxWorkers == 0 || app.blockTxsProvider == nil || app.cms.TracingEnabled() {
+		return
+	}
+
+	txs, ok := app.blockTxsProvider(req.Header.Height, req.Hash)
+	if !ok {
+		return
+	}
+
+	sb := &speculativeBlock{
+		txs:     make([]*speculativeTx, len(txs)),
+		written: rwset.NewRWSet(),
+	}
+
+	var wg sync.WaitGroup
+
+	specTxs := make(chan *speculativeTx)
+
+	for i := 0; i < app.parallelDeliverTxWorkers; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for specTx := range specTxs {
+				app.speculateTx(specTx)
+			}
+		}()
+	}
+
+	for i, txBytes := range txs {
+		rwSet := rwset.NewRWSet()
+		sb.txs[i] = &speculativeTx{
+			txBytes: txBytes,
+			ms:      rwset.NewMultiStore(app.deliverState.ms.CacheMultiStore(), rwSet),
+			rwSet:   rwSet,
+		}
+
+		specTxs <- sb.txs[i]
+	}
+
+	close(specTxs)
+	wg.Wait()
+
+	app.speculativeBlock = sb
+}
+
+// speculateTx executes a tx in its own branch of the deliverState. Its
+// Context is the one of getContextForTx in runTxModeDeliver, with its own
+// GasMeter, BlockGasMeter and EventManager, and a recording span.
+func (app *BaseApp) speculateTx(specTx *speculativeTx) {
+	tx, err := app.txDecoder(specTx.txBytes)
+	if err != nil || !app.isParallelTx(tx) {
+		specTx.ms = nil
+		return
+	}
+
+	ctx := app.deliverState.ctx.
+		WithMultiStore(specTx.ms).
+		WithGasMeter(sdk.NewInfiniteGasMeter()).
+		WithBlockGasMeter(sdk.NewInfiniteGasMeter()).
+		WithEventManager(sdk.NewEventManager()).
+		WithTxBytes(specTx.txBytes).
+		WithVoteInfos(app.voteInfos)
+
+	ctx = app.withTxConsensusParams(ctx)
+
+	specTx.span = app.spanTracer.StartRecordingSpan("DeliverTx")
+	ctx = ctx.WithSpan(specTx.span)
+
+	// The GasMeter of the deliverState's Context is shared by the txs of the
+	// block, so the result of a tx using it, rather than the one set by the
+	// AnteHandler, depends on the txs before it.
+	gasMeter := &usageGasMeter{GasMeter: sdk.NewInfiniteGasMeter()}
+	ctx = ctx.WithGasMeter(gasMeter)
+
+	specTx.gInfo, specTx.result, specTx.failEvents, specTx.err = app.runTxWithContext(ctx, runTxModeDeliver, specTx.txBytes, tx)
+	specTx.blockGasUsed = ctx.BlockGasMeter().GasConsumed()
+	specTx.events = ctx.EventManager().Events()
+
+	if gasMeter.used {
+		specTx.ms = nil
+	}
+}
+
+// isParallelTx returns true if all the messages of a tx have routes which
+// can be executed speculatively.
+func (app *BaseApp) isParallelTx(tx sdk.Tx) bool {
+	for _, msg := range tx.GetMsgs() {
+		if !app.parallelMsgRoutes[msg.Route()] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// nextSpeculativeTx returns the speculative execution of the tx being
+// delivered, if any. Speculative results are not used for the rest of the
+// block if the tx isn't the one which was expected.
+func (app *BaseApp) nextSpeculativeTx(txBytes []byte) *speculativeTx {
+	sb := app.speculativeBlock
+	if sb == nil || sb.next >= len(sb.txs) {
+		return nil
+	}
+
+	specTx := sb.txs[sb.next]
+	sb.next++
+
+	if !bytes.Equal(specTx.txBytes, txBytes) {
+		app.logger.Error("delivered tx differs from the speculatively executed one", "index", sb.next-1)
+		sb.next = len(sb.txs)
+
+		return nil
+	}
+
+	return specTx
+}
+
+// runDeliverTx runs a tx in runTxModeDeliver, using the result of its
+// speculative execution if it is still valid. As runTxWithContext, it returns
+// the events of the PostHandler if the messages of the tx failed.
+func (app *BaseApp) runDeliverTx(
+	specTx *speculativeTx, txBytes []byte, tx sdk.Tx,
+) (sdk.GasInfo, *sdk.Result, sdk.Events, error) {
+	sb := app.speculativeBlock
+	if sb == nil {
+		gInfo, result, failEvents, err := app.runTxWithContext(app.getContextForTx(runTxModeDeliver, txBytes), runTxModeDeliver, txBytes, tx)
+		return gInfo, result, failEvents, unwrapAnteError(runTxModeDeliver, err)
+	}
+
+	blockGasMeter := app.deliverState.ctx.BlockGasMeter()
+
+	if specTx != nil && specTx.ms != nil && !specTx.rwSet.HasReadConflict(sb.written) &&
+		hasBlockGasLeft(blockGasMeter, specTx.blockGasUsed) {
+		// consume the gas of reading the consensus params from the shared
+		// GasMeter, as getContextForTx does when the tx is run
+		app.withTxConsensusParams(app.deliverState.ctx)
+
+		specTx.ms.Write()
+		blockGasMeter.ConsumeGas(specTx.blockGasUsed, "block gas meter")
+		app.deliverState.ctx.EventManager().EmitEvents(specTx.events)
+		app.txSpan.AdoptRecorded(specTx.span)
+
+		sb.written.AddWrites(specTx.rwSet)
+		sb.applied++
+
+		return specTx.gInfo, specTx.result, specTx.failEvents, unwrapAnteError(runTxModeDeliver, specTx.err)
+	}
+
+	rwSet := rwset.NewRWSet()
+	ctx := app.getContextForTx(runTxModeDeliver, txBytes)
+	ctx = ctx.WithMultiStore(rwset.NewMultiStore(app.deliverState.ms, rwSet))
+
+	gInfo, result, failEvents, err := app.runTxWithContext(ctx, runTxModeDeliver, txBytes, tx)
+
+	sb.written.AddWrites(rwSet)
+	sb.reexecuted++
+
+	return gInfo, result, failEvents, unwrapAnteError(runTxModeDeliver, err)
+}
+
+// endSpeculativeBlock discards the speculative execution of the current block.
+func (app *BaseApp) endSpeculativeBlock() {
+	if sb := app.speculativeBlock; sb != nil {
+		app.logger.Debug("parallel DeliverTx", "txs", len(sb.txs), "applied", sb.applied, "reexecuted", sb.reexecuted)
+	}
+
+	app.speculativeBlock = nil
+}
+
+// hasBlockGasLeft returns true if runTx wouldn't run out of block gas when
+// consuming gas, in which case it would behave as in the speculative
+// execution, which uses an infinite block gas meter.
+func hasBlockGasLeft(meter sdk.GasMeter, gas uint64) bool {
+	if meter.IsOutOfGas() {
+		return false
+	}
+
+	// an infinite gas meter has no limit
+	if meter.Limit() == 0 {
+		return true
+	}
+
+	consumed := meter.GasConsumed()
+
+	return consumed+gas >= consumed && consumed+gas <= meter.Limit()
+}
+
+// usageGasMeter is a GasMeter recording whether it was used.
+type usageGasMeter struct {
+	sdk.GasMeter
+	used bool
+}
+
+func (g *usageGasMeter) GasConsumed() sdk.Gas {
+	g.used = true
+	return g.GasMeter.GasConsumed()
+}
+
+func (g *usageGasMeter) GasConsumedToLimit() sdk.Gas {
+	g.used = true
+	return g.GasMeter.GasConsumedToLimit()
+}
+
+func (g *usageGasMeter) Limit() sdk.Gas {
+	g.used = true
+	return g.GasMeter.Limit()
+}
+
+func (g *usageGasMeter) ConsumeGas(amount sdk.Gas, descriptor string) {
+	g.used = true
+	g.GasMeter.ConsumeGas(amount, descriptor)
+}
+
+func (g *usageGasMeter) IsPastLimit() bool {
+	g.used = true
+	return g.GasMeter.IsPastLimit()
+}
+
+func (g *usageGasMeter) IsOutOfGas() bool {
+	g.used = true
+	return g.GasMeter.IsOutOfGas()
+}
@@ -0,0 +1,290 @@
+package baseapp
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+)
+
+// parallelTestAnteHandler sets the tx gas meter and increments the sequence of
+// the tx's sender, which is the tx's Counter.
+func parallelTestAnteHandler(ctx sdk.Context, tx sdk.Tx, _ bool) (sdk.Context, error) {
+	ctx = ctx.WithGasMeter(sdk.NewGasMeter(1000000))
+	txTest := tx.(txTest)
+
+	if txTest.FailOnAnte {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "ante handler failure")
+	}
+
+	store := ctx.KVStore(capKey1)
+	key := []byte(fmt.Sprintf("seq/%d", txTest.Counter))
+	seq := getIntFromStore(store, key) + 1
+	setIntOnStore(store, key, seq)
+
+	ctx.EventManager().EmitEvents(counterEvent("ante_handler", seq))
+
+	return ctx, nil
+}
+
+// parallelTestHandler increments the balance of a msgCounter's Counter, and
+// stores the sum of all the balances for a msgCounter2.
+func parallelTestHandler(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+	store := ctx.KVStore(capKey1)
+
+	switch m := msg.(type) {
+	case *msgCounter:
+		key := []byte(fmt.Sprintf("bal/%d", m.Counter))
+		balance := getIntFromStore(store, key) + 1
+		setIntOnStore(store, key, balance)
+
+		if m.FailOnHandler {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "message handler failure")
+		}
+
+		return &sdk.Result{Events: counterEvent("balance", balance).ToABCIEvents()}, nil
+
+	case *msgCounter2:
+		var sum int64
+
+		iter := sdk.KVStorePrefixIterator(store, []byte("bal/"))
+		for ; iter.Valid(); iter.Next() {
+			balance, _ := binary.Varint(iter.Value())
+			sum += balance
+		}
+		iter.Close()
+
+		setIntOnStore(store, []byte(fmt.Sprintf("sum/%d", m.Counter)), sum)
+
+		return &sdk.Result{Events: counterEvent("sum", sum).ToABCIEvents()}, nil
+
+	default:
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unexpected msg %T", msg)
+	}
+}
+
+// randParallelTestTx returns a random encoded tx. Txs from the same sender,
+// updating the same balances or summing them conflict with each other.
+func randParallelTestTx(t *testing.T, r *rand.Rand, cdc *codec.Codec) []byte {
+	// some txs can't be decoded
+	if r.Intn(20) == 0 {
+		return []byte("invalid tx")
+	}
+
+	msgs := make([]sdk.Msg, 1+r.Intn(3))
+	for i := range msgs {
+		if r.Intn(10) == 0 {
+			msgs[i] = msgCounter2{Counter: r.Int63n(5)}
+		} else {
+			msgs[i] = msgCounter{Counter: r.Int63n(40), FailOnHandler: r.Intn(20) == 0}
+		}
+	}
+
+	tx := &txTest{Msgs: msgs, Counter: r.Int63n(40), FailOnAnte: r.Intn(20) == 0}
+
+	txBytes, err := cdc.MarshalBinaryBare(tx)
+	require.NoError(t, err)
+
+	return txBytes
+}
+
+// gasParamStore is a ParamStore consuming gas from the GasMeter of the Context
+// for each read, as the params keeper does.
+type gasParamStore struct {
+	ParamStore
+}
+
+func (ps gasParamStore) Has(ctx sdk.Context, key []byte) bool {
+	ctx.GasMeter().ConsumeGas(10, "param store has")
+	return ps.ParamStore.Has(ctx, key)
+}
+
+func (ps gasParamStore) Get(ctx sdk.Context, key []byte, ptr interface{}) {
+	ctx.GasMeter().ConsumeGas(10, "param store get")
+	ps.ParamStore.Get(ctx, key, ptr)
+}
+
+func TestParallelDeliverTxDeterminism(t *testing.T) {
+	cdc := codec.New()
+	registerTestCodec(cdc)
+
+	r := rand.New(rand.NewSource(1))
+	blocks := make([][][]byte, 6)
+
+	for i := range blocks {
+		for j := 0; j < 60; j++ {
+			blocks[i] = append(blocks[i], randParallelTestTx(t, r, cdc))
+		}
+	}
+
+	// the block gas limit is reached in the last block
+	blocks[len(blocks)-1] = append(blocks[len(blocks)-1], blocks[0]...)
+
+	options := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(parallelTestAnteHandler)
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgCounter, parallelTestHandler))
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgCounter2, parallelTestHandler))
+		bapp.SetParallelMsgRoutes(routeMsgCounter)
+	}
+
+	serialApp := setupBaseApp(t, options)
+	serialApp.paramStore = gasParamStore{serialApp.paramStore}
+	parallelApp := setupBaseApp(t, options, SetParallelDeliverTx(4))
+	parallelApp.paramStore = gasParamStore{parallelApp.paramStore}
+	parallelApp.SetBlockTxsProvider(func(height int64, _ []byte) ([][]byte, bool) {
+		txs := blocks[height-1]
+
+		// provide wrong txs for one block, whose speculative results must
+		// then be discarded
+		if height == 3 {
+			txs = blocks[0]
+		}
+
+		return txs, true
+	})
+
+	initReq := abci.RequestInitChain{
+		ConsensusParams: &abci.ConsensusParams{
+			Block: &abci.BlockParams{MaxGas: 800000},
+		},
+	}
+	serialApp.InitChain(initReq)
+	parallelApp.InitChain(initReq)
+
+	var applied, reexecuted int
+
+	for i, txs := range blocks {
+		header := abci.Header{Height: int64(i) + 1}
+		serialApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+		parallelApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+		for _, tx := range txs {
+			req := abci.RequestDeliverTx{Tx: tx}
+			require.Equal(t, serialApp.DeliverTx(req), parallelApp.DeliverTx(req))
+		}
+
+		require.Equal(t, serialApp.deliverState.ctx.BlockGasMeter().GasConsumed(), parallelApp.deliverState.ctx.BlockGasMeter().GasConsumed())
+
+		// reading the consensus params of each tx consumes gas from the
+		// GasMeter shared by the txs of the block
+		require.NotZero(t, serialApp.deliverState.ctx.GasMeter().GasConsumed())
+		require.Equal(t, serialApp.deliverState.ctx.GasMeter().GasConsumed(), parallelApp.deliverState.ctx.GasMeter().GasConsumed())
+
+		applied += parallelApp.speculativeBlock.applied
+		reexecuted += parallelApp.speculativeBlock.reexecuted
+
+		req := abci.RequestEndBlock{Height: header.Height}
+		require.Equal(t, serialApp.EndBlock(req), parallelApp.EndBlock(req))
+		require.Equal(t, serialApp.Commit().Data, parallelApp.Commit().Data, "app hash mismatch at height %d", header.Height)
+	}
+
+	// both the speculative results and the re-execution of conflicting txs
+	// were used
+	require.NotZero(t, applied)
+	require.NotZero(t, reexecuted)
+}
+
+func TestParallelDeliverTxInMemoryState(t *testing.T) {
+	cdc := codec.New()
+	registerTestCodec(cdc)
+
+	// msgCounter2 creates a capability, which the capability keeper keeps in
+	// memory, so it can't be executed speculatively
+	capKey := sdk.NewKVStoreKey(capabilitytypes.StoreKey)
+	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
+
+	newApp := func(options ...func(*BaseApp)) (*BaseApp, *capabilitykeeper.Keeper) {
+		capKeeper := capabilitykeeper.NewKeeper(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()), capKey, memKeys[capabilitytypes.MemStoreKey])
+		scopedKeeper := capKeeper.ScopeToModule("test")
+
+		capHandler := func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			m, ok := msg.(*msgCounter2)
+			if !ok {
+				return parallelTestHandler(ctx, msg)
+			}
+
+			if _, err := scopedKeeper.NewCapability(ctx, fmt.Sprintf("cap/%d", m.Counter)); err != nil {
+				return nil, err
+			}
+
+			return &sdk.Result{}, nil
+		}
+
+		options = append(options, func(bapp *BaseApp) {
+			bapp.SetAnteHandler(parallelTestAnteHandler)
+			bapp.Router().AddRoute(sdk.NewRoute(routeMsgCounter, capHandler))
+			bapp.Router().AddRoute(sdk.NewRoute(routeMsgCounter2, capHandler))
+			bapp.MountStores(capKey)
+			bapp.MountMemoryStores(memKeys)
+			bapp.SetParallelMsgRoutes(routeMsgCounter)
+		})
+
+		app := setupBaseApp(t, options...)
+		capKeeper.InitializeAndSeal(app.NewUncachedContext(true, abci.Header{}))
+
+		return app, capKeeper
+	}
+
+	serialApp, _ := newApp()
+	parallelApp, capKeeper := newApp(SetParallelDeliverTx(4))
+
+	blocks := make([][][]byte, 3)
+	for i := range blocks {
+		for j := 0; j < 40; j++ {
+			var msg sdk.Msg = msgCounter{Counter: int64(j)}
+			if j%2 == 0 {
+				msg = msgCounter2{Counter: int64(i*40 + j)}
+			}
+
+			txBytes, err := cdc.MarshalBinaryBare(&txTest{Msgs: []sdk.Msg{msg}, Counter: int64(j)})
+			require.NoError(t, err)
+
+			blocks[i] = append(blocks[i], txBytes)
+		}
+	}
+
+	parallelApp.SetBlockTxsProvider(func(height int64, _ []byte) ([][]byte, bool) {
+		return blocks[height-1], true
+	})
+
+	serialApp.InitChain(abci.RequestInitChain{})
+	parallelApp.InitChain(abci.RequestInitChain{})
+
+	var applied int
+
+	for i, txs := range blocks {
+		header := abci.Header{Height: int64(i) + 1}
+		serialApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+		parallelApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+		for _, tx := range txs {
+			req := abci.RequestDeliverTx{Tx: tx}
+			res := serialApp.DeliverTx(req)
+			require.True(t, res.IsOK(), res.Log)
+			require.Equal(t, res, parallelApp.DeliverTx(req))
+		}
+
+		applied += parallelApp.speculativeBlock.applied
+
+		req := abci.RequestEndBlock{Height: header.Height}
+		require.Equal(t, serialApp.EndBlock(req), parallelApp.EndBlock(req))
+		require.Equal(t, serialApp.Commit().Data, parallelApp.Commit().Data, "app hash mismatch at height %d", header.Height)
+	}
+
+	// only the txs of the allowed route were executed speculatively, and the
+	// capabilities were created once each
+	require.Equal(t, len(blocks)*20, applied)
+
+	ctx := parallelApp.NewContext(true, abci.Header{})
+	require.Equal(t, uint64(len(blocks)*20), capKeeper.GetLatestIndex(ctx))
+}
@@ -0,0 +1,121 @@
+package baseapp
+
+import (
+	"bytes"
+	"errors"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	snapshottypes "github.com/cosmos/cosmos-sdk/store/snapshots/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// The methods below mirror the ABCI state sync interface (ListSnapshots,
+// LoadSnapshotChunk, OfferSnapshot and ApplySnapshotChunk). They allow a
+// node to serve and restore state sync snapshots of the application's
+// CommitMultiStore through its snapshot manager.
+
+// snapshot takes a state sync snapshot of the application state at the given
+// height and prunes old snapshots, logging any errors. It is called
+// asynchronously from Commit every snapshotInterval blocks.
+func (app *BaseApp) snapshot(height int64) {
+	if app.snapshotManager == nil {
+		app.logger.Info("snapshot manager not configured")
+		return
+	}
+
+	app.logger.Info("creating state snapshot", "height", height)
+
+	snapshot, err := app.snapshotManager.Create(uint64(height))
+	if err != nil {
+		app.logger.Error("failed to create state snapshot", "height", height, "err", err)
+		return
+	}
+
+	app.logger.Info("completed state snapshot", "height", height, "format", snapshot.Format)
+
+	if app.snapshotKeepRecent > 0 {
+		app.logger.Debug("pruning state snapshots")
+
+		pruned, err := app.snapshotManager.Prune(app.snapshotKeepRecent)
+		if err != nil {
+			app.logger.Error("failed to prune state snapshots", "err", err)
+			return
+		}
+
+		app.logger.Debug("pruned state snapshots", "pruned", pruned)
+	}
+}
+
+// ListSnapshots returns the available state sync snapshots, newest first.
+func (app *BaseApp) ListSnapshots() ([]*snapshottypes.Snapshot, error) {
+	if app.snapshotManager == nil {
+		return []*snapshottypes.Snapshot{}, nil
+	}
+
+	return app.snapshotManager.List()
+}
+
+// LoadSnapshotChunk returns a chunk of a state sync snapshot. It returns nil
+// if the snapshot or chunk does not exist.
+func (app *BaseApp) LoadSnapshotChunk(height uint64, format uint32, chunk uint32) ([]byte, error) {
+	if app.snapshotManager == nil {
+		return nil, nil
+	}
+
+	return app.snapshotManager.LoadChunk(height, format, chunk)
+}
+
+// OfferSnapshot begins the restoration of a state sync snapshot. The
+// restored state is expected to match the given app hash, which must be
+// obtained from a trusted source (e.g. a light client). Chunks are then
+// applied with ApplySnapshotChunk.
+func (app *BaseApp) OfferSnapshot(snapshot *snapshottypes.Snapshot, appHash []byte) error {
+	if snapshot == nil {
+		return sdkerrors.Wrap(snapshottypes.ErrInvalidSnapshot, "snapshot cannot be nil")
+	}
+	if len(appHash) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "app hash cannot be empty")
+	}
+	if app.snapshotManager == nil {
+		return errors.New("snapshot manager not configured")
+	}
+
+	if err := app.snapshotManager.Restore(*snapshot); err != nil {
+		return err
+	}
+
+	app.snapshotRestoreAppHash = appHash
+	return nil
+}
+
+// ApplySnapshotChunk applies a chunk of the snapshot being restored, as
+// started by OfferSnapshot, returning true once the restore is complete.
+// Once complete, the restored state is verified against the app hash given
+// to OfferSnapshot, returning ErrAppHashMismatch on a mismatch.
+func (app *BaseApp) ApplySnapshotChunk(chunk []byte) (bool, error) {
+	if app.snapshotManager == nil {
+		return false, errors.New("snapshot manager not configured")
+	}
+
+	done, err := app.snapshotManager.RestoreChunk(chunk)
+	if err != nil || !done {
+		return done, err
+	}
+
+	appHash := app.snapshotRestoreAppHash
+	app.snapshotRestoreAppHash = nil
+
+	lastCommitID := app.cms.LastCommitID()
+	if !bytes.Equal(lastCommitID.Hash, appHash) {
+		return false, sdkerrors.Wrapf(
+			snapshottypes.ErrAppHashMismatch,
+			"expected %X, got %X at height %d", appHash, lastCommitID.Hash, lastCommitID.Version,
+		)
+	}
+
+	// reset the check state to the restored state
+	app.setCheckState(abci.Header{})
+
+	return true, nil
+}
@@ -0,0 +1,55 @@
+package baseapp
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ABCIListener is the interface used to hook into the ABCI message processing
+// of the BaseApp. The hooks are called once the BaseApp has processed the
+// message, in the order the messages are received from Tendermint.
+type ABCIListener interface {
+	// ListenBeginBlock updates the listener with the BeginBlock request and response
+	ListenBeginBlock(ctx sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error
+	// ListenDeliverTx updates the listener with a DeliverTx request and response
+	ListenDeliverTx(ctx sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error
+	// ListenEndBlock updates the listener with the EndBlock request and response
+	ListenEndBlock(ctx sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error
+	// ListenCommit updates the listener with the Commit response. All the state
+	// changes of the block have been notified to the WriteListeners when it is
+	// called.
+	ListenCommit(ctx sdk.Context, res abci.ResponseCommit) error
+}
+
+// StreamingService is used to stream the ABCI messages of each block along with
+// the state changes they cause to the KVStores of the BaseApp.
+type StreamingService interface {
+	ABCIListener
+
+	// Listeners returns the WriteListeners notified of the writes committed to
+	// the KVStore mounted with the given key. The KVStore isn't listened to if
+	// none are returned.
+	Listeners(key sdk.StoreKey) []storetypes.WriteListener
+}
+
+// SetStreamingService registers a StreamingService with the BaseApp. It must
+// be called before the stores are mounted, e.g. as a BaseApp option.
+func (app *BaseApp) SetStreamingService(s StreamingService) {
+	if app.sealed {
+		panic("SetStreamingService() on sealed BaseApp")
+	}
+
+	app.streamingServices = append(app.streamingServices, s)
+}
+
+// addListeners registers the WriteListeners of the streaming services for the
+// store mounted with the given key.
+func (app *BaseApp) addListeners(key sdk.StoreKey) {
+	for _, s := range app.streamingServices {
+		if listeners := s.Listeners(key); len(listeners) > 0 {
+			app.cms.AddListeners(key, listeners)
+		}
+	}
+}
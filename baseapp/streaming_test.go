@@ -0,0 +1,106 @@
+package baseapp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ StreamingService = (*mockStreamingService)(nil)
+
+// mockStreamingService records the ABCI messages it is notified of along with
+// the writes to capKey1.
+type mockStreamingService struct {
+	events  []string
+	kvPairs []storetypes.StoreKVPair
+}
+
+func (s *mockStreamingService) Listeners(key sdk.StoreKey) []storetypes.WriteListener {
+	if key != capKey1 {
+		return nil
+	}
+
+	return []storetypes.WriteListener{s}
+}
+
+func (s *mockStreamingService) OnWrite(storeKey storetypes.StoreKey, key []byte, value []byte, delete bool) error {
+	s.kvPairs = append(s.kvPairs, storetypes.StoreKVPair{StoreKey: storeKey.Name(), Delete: delete, Key: key, Value: value})
+	return nil
+}
+
+func (s *mockStreamingService) ListenBeginBlock(_ sdk.Context, _ abci.RequestBeginBlock, _ abci.ResponseBeginBlock) error {
+	s.events = append(s.events, "begin")
+	return nil
+}
+
+func (s *mockStreamingService) ListenDeliverTx(_ sdk.Context, _ abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	if res.IsOK() {
+		s.events = append(s.events, "tx")
+	} else {
+		s.events = append(s.events, "failed tx")
+	}
+	return nil
+}
+
+func (s *mockStreamingService) ListenEndBlock(_ sdk.Context, _ abci.RequestEndBlock, _ abci.ResponseEndBlock) error {
+	s.events = append(s.events, "end")
+	return nil
+}
+
+func (s *mockStreamingService) ListenCommit(_ sdk.Context, _ abci.ResponseCommit) error {
+	s.events = append(s.events, "commit")
+	return nil
+}
+
+func TestStreamingService(t *testing.T) {
+	anteKey := []byte("ante-key")
+	anteOpt := func(bapp *BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
+
+	deliverKey := []byte("deliver-key")
+	routerOpt := func(bapp *BaseApp) {
+		r := sdk.NewRoute(routeMsgCounter, handlerMsgCounter(t, capKey1, deliverKey))
+		bapp.Router().AddRoute(r)
+	}
+
+	streamingService := &mockStreamingService{}
+	app := setupBaseApp(t, anteOpt, routerOpt, SetStreamingService(streamingService))
+	app.InitChain(abci.RequestInitChain{})
+
+	codec := codec.New()
+	registerTestCodec(codec)
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 1}})
+
+	txBytes, err := codec.MarshalBinaryBare(newTxCounter(0, 0))
+	require.NoError(t, err)
+	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.True(t, res.IsOK(), res.Log)
+
+	// the ante handler writes of a tx failing in its handler are committed
+	failingTx := newTxCounter(1, 1)
+	failingTx.setFailOnHandler(true)
+	txBytes, err = codec.MarshalBinaryBare(failingTx)
+	require.NoError(t, err)
+	res = app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.False(t, res.IsOK())
+
+	app.EndBlock(abci.RequestEndBlock{Height: 1})
+	require.Empty(t, streamingService.kvPairs, "writes must only be notified once committed")
+
+	app.Commit()
+	require.Equal(t, []string{"begin", "tx", "failed tx", "end", "commit"}, streamingService.events)
+
+	// the cached writes of the block are flushed once per key, in key order
+	store := app.cms.GetKVStore(capKey1)
+	require.Equal(t, []storetypes.StoreKVPair{
+		{StoreKey: capKey1.Name(), Key: anteKey, Value: store.Get(anteKey)},
+		{StoreKey: capKey1.Name(), Key: deliverKey, Value: store.Get(deliverKey)},
+	}, streamingService.kvPairs)
+	require.Equal(t, int64(2), getIntFromStore(store, anteKey))
+	require.Equal(t, int64(1), getIntFromStore(store, deliverKey))
+}
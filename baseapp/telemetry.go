@@ -0,0 +1,75 @@
+package baseapp
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// String returns the name of the mode, as used to label the tx metrics.
+func (mode runTxMode) String() string {
+	switch mode {
+	case runTxModeCheck:
+		return "check"
+
+	case runTxModeReCheck:
+		return "recheck"
+
+	case runTxModeSimulate:
+		return "simulate"
+
+	case runTxModeDeliver:
+		return "deliver"
+
+	default:
+		return "unknown"
+	}
+}
+
+// anteError marks an error returned by the AnteHandler in runTxWithContext, so
+// that the rejection of the tx is only counted once its result is final, i.e.
+// not when it is executed speculatively. It never leaves the BaseApp.
+type anteError struct {
+	err error
+}
+
+func (e anteError) Error() string { return e.err.Error() }
+
+// unwrapAnteError returns the error of a tx run in the given mode, counting
+// its rejection by the AnteHandler, if any.
+func unwrapAnteError(mode runTxMode, err error) error {
+	ae, ok := err.(anteError)
+	if !ok {
+		return err
+	}
+
+	codespace, code, _ := sdkerrors.ABCIInfo(ae.err, false)
+	telemetry.IncrAnteRejected(mode.String(), codespace, code)
+
+	return ae.err
+}
+
+// observeTx records the latency since start and the gas used by a tx run in
+// the given mode, once for each distinct type URL and route of its messages.
+func observeTx(mode runTxMode, tx sdk.Tx, start time.Time, gasUsed uint64) {
+	if !telemetry.TxMetricsEnabled() {
+		return
+	}
+
+	type msgLabels struct {
+		typeURL, route string
+	}
+
+	observed := make(map[msgLabels]bool)
+	for _, msg := range tx.GetMsgs() {
+		labels := msgLabels{sdk.MsgTypeURL(msg), msg.Route()}
+		if observed[labels] {
+			continue
+		}
+
+		telemetry.ObserveTx(mode.String(), labels.typeURL, labels.route, start, gasUsed)
+		observed[labels] = true
+	}
+}
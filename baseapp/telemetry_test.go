@@ -0,0 +1,246 @@
+package baseapp
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestTxMetrics(t *testing.T) {
+	m, err := telemetry.New(telemetry.Config{
+		Enabled:                 true,
+		PrometheusRetentionTime: 60,
+		EnableTxMetrics:         true,
+	})
+	require.NoError(t, err)
+
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, []byte("ante-key")))
+	}
+	routerOpt := func(bapp *BaseApp) {
+		r := sdk.NewRoute(routeMsgCounter, handlerMsgCounter(t, capKey1, []byte("deliver-key")))
+		bapp.Router().AddRoute(r)
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	cdc := codec.New()
+	registerTestCodec(cdc)
+
+	header := abci.Header{Height: 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	// the txs rejected by the AnteHandler are counted by error code, which is
+	// returned unchanged
+	tx := newTxCounter(0, 0)
+	tx.setFailOnAnte(true)
+	txBytes, err := cdc.MarshalBinaryBare(tx)
+	require.NoError(t, err)
+
+	checkRes := app.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+	require.Equal(t, sdkerrors.ErrUnauthorized.ABCICode(), checkRes.Code)
+	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.Equal(t, sdkerrors.ErrUnauthorized.ABCICode(), res.Code)
+
+	// the txs are observed once for each of their distinct message types
+	tx = newTxCounter(0, 0, 1)
+	txBytes, err = cdc.MarshalBinaryBare(tx)
+	require.NoError(t, err)
+
+	res = app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+
+	gr, err := m.Gather(telemetry.FormatPrometheus)
+	require.NoError(t, err)
+
+	out := string(gr.Metrics)
+	code := sdkerrors.ErrUnauthorized.ABCICode()
+	require.Contains(t, out, fmt.Sprintf(`tx_ante_rejected_total{code="%d",codespace="sdk",mode="check"} 1`, code))
+	require.Contains(t, out, fmt.Sprintf(`tx_ante_rejected_total{code="%d",codespace="sdk",mode="deliver"} 1`, code))
+	require.Contains(t, out, `tx_msg_latency_seconds_count{mode="check",msg_type_url="/",route="msgCounter"} 1`)
+	require.Contains(t, out, `tx_msg_latency_seconds_count{mode="deliver",msg_type_url="/",route="msgCounter"} 2`)
+	require.Contains(t, out, `tx_msg_gas_used_count{mode="deliver",msg_type_url="/",route="msgCounter"} 2`)
+}
+
+type spanExporter struct {
+	spans    []telemetry.SpanData
+	shutdown bool
+}
+
+func (e *spanExporter) ExportSpan(span telemetry.SpanData) error {
+	e.spans = append(e.spans, span)
+	return nil
+}
+
+func (e *spanExporter) Shutdown() error {
+	e.shutdown = true
+	return nil
+}
+
+type spanTestDecorator struct{}
+
+func (spanTestDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	return next(ctx, tx, simulate)
+}
+
+func TestSpanTracing(t *testing.T) {
+	exporter := &spanExporter{}
+
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(sdk.ChainAnteDecorators(spanTestDecorator{}, spanTestDecorator{}))
+	}
+	routerOpt := func(bapp *BaseApp) {
+		r := sdk.NewRoute(routeMsgCounter, handlerMsgCounter(t, capKey1, []byte("deliver-key")))
+		bapp.Router().AddRoute(r)
+	}
+	tracerOpt := SetSpanTracer(telemetry.NewTracer(exporter))
+
+	app := setupBaseApp(t, anteOpt, routerOpt, tracerOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	cdc := codec.New()
+	registerTestCodec(cdc)
+
+	header := abci.Header{Height: 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	tx := newTxCounter(0, 0, 1)
+	txBytes, err := cdc.MarshalBinaryBare(tx)
+	require.NoError(t, err)
+
+	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+	app.Query(abci.RequestQuery{Path: "/unknown"})
+
+	var names []string
+	spans := make(map[string]telemetry.SpanData)
+	for _, span := range exporter.spans {
+		names = append(names, span.Name)
+		spans[span.SpanID] = span
+	}
+
+	require.Equal(t, []string{
+		"BeginBlock",
+		"baseapp.spanTestDecorator", "baseapp.spanTestDecorator", "AnteHandler",
+		"Msg", "Msg", "DeliverTx",
+		"EndBlock", "Commit", "Block",
+		"Query",
+	}, names)
+
+	parentName := func(i int) string {
+		return spans[exporter.spans[i].ParentID].Name
+	}
+
+	// the spans of the ABCI methods of a block are under the block's one
+	require.Equal(t, "Block", parentName(0))
+	require.Equal(t, "Block", parentName(6))
+	require.Equal(t, "Block", parentName(7))
+	require.Equal(t, "Block", parentName(8))
+	require.Equal(t, "1", exporter.spans[9].Attributes["height"])
+
+	// the decorators' spans are nested under the AnteHandler's one
+	require.Equal(t, "baseapp.spanTestDecorator", parentName(1))
+	require.Equal(t, "AnteHandler", parentName(2))
+	require.Equal(t, "DeliverTx", parentName(3))
+
+	// the messages' spans are under the tx's one
+	require.Equal(t, "DeliverTx", parentName(4))
+	require.Equal(t, "DeliverTx", parentName(5))
+	require.Equal(t, "/", exporter.spans[4].Attributes["type_url"])
+	require.Equal(t, routeMsgCounter, exporter.spans[4].Attributes["route"])
+	require.Equal(t, "1", exporter.spans[5].Attributes["index"])
+	require.Equal(t, "0", exporter.spans[6].Attributes["code"])
+
+	// queries have their own trace
+	query := exporter.spans[10]
+	require.Empty(t, query.ParentID)
+	require.NotEqual(t, exporter.spans[9].TraceID, query.TraceID)
+	require.Equal(t, "/unknown", query.Attributes["path"])
+	require.Equal(t, fmt.Sprint(sdkerrors.ErrUnknownRequest.ABCICode()), query.Attributes["code"])
+
+	// closing the app shuts the exporter down
+	require.False(t, exporter.shutdown)
+	require.NoError(t, app.Close())
+	require.True(t, exporter.shutdown)
+
+	// the app can be closed without a tracer
+	require.NoError(t, setupBaseApp(t).Close())
+}
+
+type spanGasTestDecorator struct{}
+
+func (spanGasTestDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	return next(ctx.WithGasMeter(sdk.NewGasMeter(1000000)), tx, simulate)
+}
+
+func TestSpanTracingParallelDeliverTx(t *testing.T) {
+	exporter := &spanExporter{}
+
+	options := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(sdk.ChainAnteDecorators(spanGasTestDecorator{}, spanTestDecorator{}))
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgCounter, parallelTestHandler))
+		bapp.SetParallelMsgRoutes(routeMsgCounter)
+	}
+
+	cdc := codec.New()
+	registerTestCodec(cdc)
+
+	// the txs are independent, so that their speculative results are used
+	var txs [][]byte
+	for i := int64(0); i < 10; i++ {
+		txBytes, err := cdc.MarshalBinaryBare(&txTest{Msgs: []sdk.Msg{msgCounter{Counter: i}}, Counter: i})
+		require.NoError(t, err)
+
+		txs = append(txs, txBytes)
+	}
+
+	app := setupBaseApp(t, options, SetParallelDeliverTx(4), SetSpanTracer(telemetry.NewTracer(exporter)))
+	app.SetBlockTxsProvider(func(int64, []byte) ([][]byte, bool) {
+		return txs, true
+	})
+	app.InitChain(abci.RequestInitChain{})
+
+	header := abci.Header{Height: 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	for _, tx := range txs {
+		res := app.DeliverTx(abci.RequestDeliverTx{Tx: tx})
+		require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+	}
+
+	require.Equal(t, len(txs), app.speculativeBlock.applied)
+
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+
+	spans := make(map[string]telemetry.SpanData)
+	for _, span := range exporter.spans {
+		spans[span.SpanID] = span
+	}
+
+	// the spans of the speculative executions are under the DeliverTx ones,
+	// in the trace of the block
+	children := make(map[string]int)
+	for _, span := range exporter.spans {
+		parent, ok := spans[span.ParentID]
+		if !ok || parent.Name != "DeliverTx" {
+			continue
+		}
+
+		require.Equal(t, parent.TraceID, span.TraceID)
+		children[span.Name]++
+	}
+
+	require.Equal(t, map[string]int{"AnteHandler": len(txs), "Msg": len(txs)}, children)
+}
@@ -3,26 +3,53 @@ package client
 import (
 	gocontext "context"
 	"fmt"
+	"strconv"
 
 	gogogrpc "github.com/gogo/protobuf/grpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/encoding"
 	"google.golang.org/grpc/encoding/proto"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
 )
 
 var _ gogogrpc.ClientConn = Context{}
 
 var protoCodec = encoding.GetCodec(proto.Name)
 
-// Invoke implements the grpc ClientConn.Invoke method
-func (ctx Context) Invoke(_ gocontext.Context, method string, args, reply interface{}, _ ...grpc.CallOption) error {
+// Invoke implements the grpc ClientConn.Invoke method. If the outgoing context
+// carries the block height header, the query is executed at that height. The
+// height at which the query was served is set on any grpc.Header call option.
+func (ctx Context) Invoke(grpcCtx gocontext.Context, method string, args, reply interface{}, opts ...grpc.CallOption) error {
 	reqBz, err := protoCodec.Marshal(args)
 	if err != nil {
 		return err
 	}
-	resBz, _, err := ctx.QueryWithData(method, reqBz)
+
+	if md, ok := metadata.FromOutgoingContext(grpcCtx); ok {
+		if heights := md.Get(grpctypes.GRPCBlockHeightHeader); len(heights) > 0 {
+			height, err := strconv.ParseInt(heights[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrapf(
+					sdkerrors.ErrInvalidRequest,
+					"invalid %s header %q: %s", grpctypes.GRPCBlockHeightHeader, heights[0], err,
+				)
+			}
+			if height < 0 {
+				return sdkerrors.Wrapf(
+					sdkerrors.ErrInvalidRequest,
+					"%s header must be non-negative, got %d", grpctypes.GRPCBlockHeightHeader, height,
+				)
+			}
+
+			ctx = ctx.WithHeight(height)
+		}
+	}
+
+	resBz, height, err := ctx.QueryWithData(method, reqBz)
 	if err != nil {
 		return err
 	}
@@ -32,6 +59,14 @@ func (ctx Context) Invoke(_ gocontext.Context, method string, args, reply interf
 		return err
 	}
 
+	// echo the served height back through any requested header call options
+	md := metadata.Pairs(grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10))
+	for _, opt := range opts {
+		if header, ok := opt.(grpc.HeaderCallOption); ok {
+			*header.HeaderAddr = md
+		}
+	}
+
 	if ctx.InterfaceRegistry != nil {
 		return types.UnpackInterfaces(reply, ctx.InterfaceRegistry)
 	}
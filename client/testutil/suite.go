@@ -54,6 +54,17 @@ func (s *TxGeneratorTestSuite) TestTxBuilderSetGasLimit() {
 	s.Require().Equal(newGas, feeTx.GetGas())
 }
 
+func (s *TxGeneratorTestSuite) TestTxBuilderSetFeeGranter() {
+	_, _, addr := authtypes.KeyTestPubAddr()
+	txBuilder := s.TxGenerator.NewTxBuilder()
+	feeTx := txBuilder.GetTx()
+	s.Require().Empty(feeTx.FeeGranter())
+
+	txBuilder.SetFeeGranter(addr)
+	feeTx = txBuilder.GetTx()
+	s.Require().Equal(addr, feeTx.FeeGranter())
+}
+
 func (s *TxGeneratorTestSuite) TestTxBuilderSetMemo() {
 	const newMemo string = "newfoomemo"
 	txBuilder := s.TxGenerator.NewTxBuilder()
@@ -62,6 +73,16 @@ func (s *TxGeneratorTestSuite) TestTxBuilderSetMemo() {
 	s.Require().Equal(txWithMemo.GetMemo(), newMemo)
 }
 
+func (s *TxGeneratorTestSuite) TestTxBuilderSetTimeoutHeight() {
+	const newTimeoutHeight uint64 = 100
+	txBuilder := s.TxGenerator.NewTxBuilder()
+	s.Require().Zero(txBuilder.GetTx().GetTimeoutHeight())
+
+	txBuilder.SetTimeoutHeight(newTimeoutHeight)
+	txWithTimeoutHeight := txBuilder.GetTx()
+	s.Require().Equal(newTimeoutHeight, txWithTimeoutHeight.GetTimeoutHeight())
+}
+
 func (s *TxGeneratorTestSuite) TestTxBuilderSetMsgs() {
 	_, _, addr1 := authtypes.KeyTestPubAddr()
 	_, _, addr2 := authtypes.KeyTestPubAddr()
@@ -1,6 +1,7 @@
 package tx
 
 import (
+	"fmt"
 	"io"
 
 	"github.com/spf13/pflag"
@@ -25,8 +26,10 @@ type Factory struct {
 	gasAdjustment      float64
 	chainID            string
 	memo               string
+	timeoutHeight      uint64
 	fees               sdk.Coins
 	gasPrices          sdk.DecCoins
+	feeGranter         sdk.AccAddress
 	signMode           signing.SignMode
 	simulateAndExecute bool
 }
@@ -34,9 +37,12 @@ type Factory struct {
 const (
 	signModeDirect    = "direct"
 	signModeAminoJSON = "amino-json"
+	signModeTextual   = "textual"
 )
 
-func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) Factory {
+// NewFactoryCLI returns a Factory built from the tx flags of a command. It
+// returns an error if the --fee-account flag isn't a valid address.
+func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) (Factory, error) {
 	signModeStr, _ := flagSet.GetString(flags.FlagSignMode)
 
 	signMode := signing.SignMode_SIGN_MODE_UNSPECIFIED
@@ -45,12 +51,15 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) Factory {
 		signMode = signing.SignMode_SIGN_MODE_DIRECT
 	case signModeAminoJSON:
 		signMode = signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+	case signModeTextual:
+		signMode = signing.SignMode_SIGN_MODE_TEXTUAL
 	}
 
 	accNum, _ := flagSet.GetUint64(flags.FlagAccountNumber)
 	accSeq, _ := flagSet.GetUint64(flags.FlagSequence)
 	gasAdj, _ := flagSet.GetFloat64(flags.FlagGasAdjustment)
 	memo, _ := flagSet.GetString(flags.FlagMemo)
+	timeoutHeight, _ := flagSet.GetUint64(flags.FlagTimeoutHeight)
 
 	gasStr, _ := flagSet.GetString(flags.FlagGas)
 	gasSetting, _ := flags.ParseGasSetting(gasStr)
@@ -66,6 +75,7 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) Factory {
 		sequence:           accSeq,
 		gasAdjustment:      gasAdj,
 		memo:               memo,
+		timeoutHeight:      timeoutHeight,
 		signMode:           signMode,
 	}
 
@@ -75,7 +85,17 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) Factory {
 	gasPricesStr, _ := flagSet.GetString(flags.FlagGasPrices)
 	f = f.WithGasPrices(gasPricesStr)
 
-	return f
+	feeGranterStr, _ := flagSet.GetString(flags.FlagFeeAccount)
+	if feeGranterStr != "" {
+		feeGranter, err := sdk.AccAddressFromBech32(feeGranterStr)
+		if err != nil {
+			return Factory{}, fmt.Errorf("invalid --%s: %w", flags.FlagFeeAccount, err)
+		}
+
+		f = f.WithFeeGranter(feeGranter)
+	}
+
+	return f, nil
 }
 
 // TODO: Remove in favor of NewFactoryCLI
@@ -97,6 +117,8 @@ func NewFactoryFromDeprecated(input io.Reader) Factory {
 		signMode = signing.SignMode_SIGN_MODE_DIRECT
 	case signModeAminoJSON:
 		signMode = signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+	case signModeTextual:
+		signMode = signing.SignMode_SIGN_MODE_TEXTUAL
 	}
 
 	gasSetting, _ := flags.ParseGasSetting(viper.GetString(flags.FlagGas))
@@ -110,6 +132,7 @@ func NewFactoryFromDeprecated(input io.Reader) Factory {
 		simulateAndExecute: gasSetting.Simulate,
 		gasAdjustment:      viper.GetFloat64(flags.FlagGasAdjustment),
 		memo:               viper.GetString(flags.FlagMemo),
+		timeoutHeight:      viper.GetUint64(flags.FlagTimeoutHeight),
 		signMode:           signMode,
 	}
 
@@ -126,8 +149,10 @@ func (f Factory) GasAdjustment() float64                    { return f.gasAdjust
 func (f Factory) Keybase() keyring.Keyring                  { return f.keybase }
 func (f Factory) ChainID() string                           { return f.chainID }
 func (f Factory) Memo() string                              { return f.memo }
+func (f Factory) TimeoutHeight() uint64                     { return f.timeoutHeight }
 func (f Factory) Fees() sdk.Coins                           { return f.fees }
 func (f Factory) GasPrices() sdk.DecCoins                   { return f.gasPrices }
+func (f Factory) FeeGranter() sdk.AccAddress                { return f.feeGranter }
 func (f Factory) AccountRetriever() client.AccountRetriever { return f.accountRetriever }
 
 // SimulateAndExecute returns the option to simulate and then execute the transaction
@@ -180,6 +205,12 @@ func (f Factory) WithGasPrices(gasPrices string) Factory {
 	return f
 }
 
+// WithFeeGranter returns a copy of the Factory with an updated fee granter.
+func (f Factory) WithFeeGranter(feeGranter sdk.AccAddress) Factory {
+	f.feeGranter = feeGranter
+	return f
+}
+
 // WithKeybase returns a copy of the Factory with updated Keybase.
 func (f Factory) WithKeybase(keybase keyring.Keyring) Factory {
 	f.keybase = keybase
@@ -198,6 +229,12 @@ func (f Factory) WithMemo(memo string) Factory {
 	return f
 }
 
+// WithTimeoutHeight returns a copy of the Factory with an updated timeout height.
+func (f Factory) WithTimeoutHeight(height uint64) Factory {
+	f.timeoutHeight = height
+	return f
+}
+
 // WithAccountNumber returns a copy of the Factory with an updated account number.
 func (f Factory) WithAccountNumber(accnum uint64) Factory {
 	f.accountNumber = accnum
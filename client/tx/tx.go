@@ -24,7 +24,11 @@ import (
 // GenerateOrBroadcastTxCLI will either generate and print and unsigned transaction
 // or sign it and broadcast it returning an error upon failure.
 func GenerateOrBroadcastTxCLI(clientCtx client.Context, flagSet *pflag.FlagSet, msgs ...sdk.Msg) error {
-	txf := NewFactoryCLI(clientCtx, flagSet)
+	txf, err := NewFactoryCLI(clientCtx, flagSet)
+	if err != nil {
+		return err
+	}
+
 	return GenerateOrBroadcastTxWithFactory(clientCtx, txf, msgs...)
 }
 
@@ -84,17 +88,19 @@ func BroadcastTx(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) error {
 	}
 
 	if txf.SimulateAndExecute() || clientCtx.Simulate {
-		_, adjusted, err := CalculateGas(clientCtx.QueryWithData, txf, msgs...)
+		simRes, adjusted, err := CalculateGas(clientCtx.QueryWithData, txf, msgs...)
 		if err != nil {
 			return err
 		}
 
 		txf = txf.WithGas(adjusted)
 		_, _ = fmt.Fprintf(os.Stderr, "%s\n", GasEstimateResponse{GasEstimate: txf.Gas()})
-	}
 
-	if clientCtx.Simulate {
-		return nil
+		// a dry run outputs the gas consumption by descriptor, along with the
+		// gas, events and data of each message
+		if clientCtx.Simulate {
+			return clientCtx.PrintOutput(&simRes)
+		}
 	}
 
 	tx, err := BuildUnsignedTx(txf, msgs...)
@@ -233,6 +239,8 @@ func BuildUnsignedTx(txf Factory, msgs ...sdk.Msg) (client.TxBuilder, error) {
 	tx.SetMemo(txf.memo)
 	tx.SetFeeAmount(fees)
 	tx.SetGasLimit(txf.gas)
+	tx.SetFeeGranter(txf.feeGranter)
+	tx.SetTimeoutHeight(txf.TimeoutHeight())
 
 	return tx, nil
 }
@@ -7,12 +7,14 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
 
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/simapp"
@@ -164,3 +166,19 @@ func TestSign(t *testing.T) {
 	err = tx.Sign(txf, "non_existing_key", txn)
 	require.Error(t, err)
 }
+
+func TestNewFactoryCLIFeeAccount(t *testing.T) {
+	cmd := &cobra.Command{}
+	flags.AddTxFlagsToCmd(cmd)
+
+	// an invalid fee account is reported, rather than crashing the CLI
+	require.NoError(t, cmd.Flags().Set(flags.FlagFeeAccount, "invalid"))
+	_, err := tx.NewFactoryCLI(client.Context{}, cmd.Flags())
+	require.Error(t, err)
+
+	_, _, addr := types.KeyTestPubAddr()
+	require.NoError(t, cmd.Flags().Set(flags.FlagFeeAccount, addr.String()))
+	txf, err := tx.NewFactoryCLI(client.Context{}, cmd.Flags())
+	require.NoError(t, err)
+	require.Equal(t, addr, txf.FeeGranter())
+}
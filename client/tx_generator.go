@@ -32,5 +32,7 @@ type (
 		SetMemo(memo string)
 		SetFeeAmount(amount sdk.Coins)
 		SetGasLimit(limit uint64)
+		SetFeeGranter(feeGranter sdk.AccAddress)
+		SetTimeoutHeight(height uint64)
 	}
 )
@@ -0,0 +1,186 @@
+package api
+
+import (
+	"crypto/subtle"
+	"math"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/types/rest"
+)
+
+// APIKeyHeader defines the header holding the API key of a write request.
+const APIKeyHeader = "X-API-Key"
+
+// rateLimiter limits the rate of the requests of each client with a token
+// bucket, holding up to burst tokens and refilled with rate tokens per second,
+// each request consuming one token.
+type rateLimiter struct {
+	mtx sync.Mutex
+
+	rate  float64
+	burst float64
+
+	buckets     map[string]*tokenBucket
+	lastCleanup time.Time
+
+	now func() time.Time
+}
+
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// newRateLimiter returns a reference to a new rateLimiter allowing rate
+// requests per second to each client, with bursts of burst requests, or of
+// the rate rounded up if burst is 0.
+func newRateLimiter(rate float64, burst uint) *rateLimiter {
+	b := float64(burst)
+	if b == 0 {
+		b = math.Max(1, math.Ceil(rate))
+	}
+
+	return &rateLimiter{
+		rate:    rate,
+		burst:   b,
+		buckets: make(map[string]*tokenBucket),
+		now:     time.Now,
+	}
+}
+
+// allow returns true if the client can make a request, consuming a token of
+// its bucket.
+func (rl *rateLimiter) allow(client string) bool {
+	rl.mtx.Lock()
+	defer rl.mtx.Unlock()
+
+	now := rl.now()
+
+	// the full buckets are dropped from time to time to bound the memory, as
+	// they're equivalent to new ones
+	if now.Sub(rl.lastCleanup) > time.Minute {
+		for c, b := range rl.buckets {
+			if rl.refill(b, now) >= rl.burst {
+				delete(rl.buckets, c)
+			}
+		}
+
+		rl.lastCleanup = now
+	}
+
+	b, ok := rl.buckets[client]
+	if !ok {
+		b = &tokenBucket{tokens: rl.burst, last: now}
+		rl.buckets[client] = b
+	}
+
+	b.tokens = rl.refill(b, now)
+	b.last = now
+
+	if b.tokens < 1 {
+		return false
+	}
+
+	b.tokens--
+
+	return true
+}
+
+// refill returns the tokens of the bucket at the given time.
+func (rl *rateLimiter) refill(b *tokenBucket, now time.Time) float64 {
+	return math.Min(rl.burst, b.tokens+now.Sub(b.last).Seconds()*rl.rate)
+}
+
+// rateLimitHandler returns an http.Handler rejecting the requests of the
+// clients exceeding the rate limit of the rateLimiter, by IP.
+func rateLimitHandler(h http.Handler, rl *rateLimiter) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !rl.allow(clientIP(r)) {
+			rest.WriteErrorResponse(w, http.StatusTooManyRequests, "rate limit exceeded")
+			return
+		}
+
+		h.ServeHTTP(w, r)
+	})
+}
+
+// apiKeyHandler returns an http.Handler rejecting the write requests, i.e.
+// with another method than GET, HEAD or OPTIONS, which don't have one of the
+// given API keys in their APIKeyHeader.
+func apiKeyHandler(h http.Handler, apiKeys []string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			h.ServeHTTP(w, r)
+			return
+		}
+
+		key := []byte(r.Header.Get(APIKeyHeader))
+		for _, apiKey := range apiKeys {
+			if subtle.ConstantTimeCompare(key, []byte(apiKey)) == 1 {
+				h.ServeHTTP(w, r)
+				return
+			}
+		}
+
+		rest.WriteErrorResponse(w, http.StatusUnauthorized, "missing or invalid API key")
+	})
+}
+
+// requestLogHandler returns an http.Handler logging each request once served.
+func requestLogHandler(h http.Handler, logger log.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		sw := &statusWriter{ResponseWriter: w, status: http.StatusOK}
+
+		h.ServeHTTP(sw, r)
+
+		logger.Info(
+			"served API request",
+			"method", r.Method,
+			"path", r.URL.Path,
+			"status", sw.status,
+			"bytes", sw.bytes,
+			"duration", time.Since(start),
+			"client", clientIP(r),
+			"user-agent", r.UserAgent(),
+		)
+	})
+}
+
+// statusWriter is an http.ResponseWriter recording the status and size of
+// the response.
+type statusWriter struct {
+	http.ResponseWriter
+
+	status int
+	bytes  int
+}
+
+func (w *statusWriter) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *statusWriter) Write(bz []byte) (int, error) {
+	n, err := w.ResponseWriter.Write(bz)
+	w.bytes += n
+
+	return n, err
+}
+
+// clientIP returns the IP of the client making a request. Forwarding headers
+// are ignored, as they can be set by the clients.
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+
+	return host
+}
@@ -0,0 +1,100 @@
+package api
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+var okHandler = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+	_, _ = w.Write([]byte("ok"))
+})
+
+func serve(h http.Handler, method, remoteAddr string, header http.Header) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(method, "/txs", nil)
+	r.RemoteAddr = remoteAddr
+	for k, values := range header {
+		for _, v := range values {
+			r.Header.Add(k, v)
+		}
+	}
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+
+	return w
+}
+
+func TestRateLimiter(t *testing.T) {
+	now := time.Unix(0, 0)
+	rl := newRateLimiter(2, 3)
+	rl.now = func() time.Time { return now }
+
+	// bursts are allowed up to the burst size
+	for i := 0; i < 3; i++ {
+		require.True(t, rl.allow("a"))
+	}
+	require.False(t, rl.allow("a"))
+
+	// each client has its own bucket
+	require.True(t, rl.allow("b"))
+
+	// the buckets are refilled at the rate
+	now = now.Add(500 * time.Millisecond)
+	require.True(t, rl.allow("a"))
+	require.False(t, rl.allow("a"))
+
+	// up to the burst size
+	now = now.Add(time.Hour)
+	for i := 0; i < 3; i++ {
+		require.True(t, rl.allow("a"))
+	}
+	require.False(t, rl.allow("a"))
+
+	// the full buckets are dropped
+	require.Len(t, rl.buckets, 1)
+
+	// the burst size defaults to the rate rounded up
+	require.Equal(t, float64(3), newRateLimiter(2.5, 0).burst)
+	require.Equal(t, float64(1), newRateLimiter(0.1, 0).burst)
+}
+
+func TestRateLimitHandler(t *testing.T) {
+	h := rateLimitHandler(okHandler, newRateLimiter(0.001, 1))
+
+	require.Equal(t, http.StatusOK, serve(h, http.MethodGet, "10.0.0.1:1000", nil).Code)
+	require.Equal(t, http.StatusTooManyRequests, serve(h, http.MethodGet, "10.0.0.1:1001", nil).Code)
+	require.Equal(t, http.StatusOK, serve(h, http.MethodGet, "10.0.0.2:1000", nil).Code)
+}
+
+func TestAPIKeyHandler(t *testing.T) {
+	h := apiKeyHandler(okHandler, []string{"key1", "key2"})
+
+	// read requests aren't restricted
+	require.Equal(t, http.StatusOK, serve(h, http.MethodGet, "10.0.0.1:1000", nil).Code)
+	require.Equal(t, http.StatusOK, serve(h, http.MethodOptions, "10.0.0.1:1000", nil).Code)
+
+	require.Equal(t, http.StatusUnauthorized, serve(h, http.MethodPost, "10.0.0.1:1000", nil).Code)
+	require.Equal(t, http.StatusUnauthorized, serve(h, http.MethodPost, "10.0.0.1:1000", http.Header{APIKeyHeader: {"key3"}}).Code)
+	require.Equal(t, http.StatusOK, serve(h, http.MethodPost, "10.0.0.1:1000", http.Header{APIKeyHeader: {"key2"}}).Code)
+}
+
+func TestRequestLogHandler(t *testing.T) {
+	buf := &bytes.Buffer{}
+	h := requestLogHandler(apiKeyHandler(okHandler, []string{"key"}), log.NewTMLogger(buf))
+
+	serve(h, http.MethodPost, "10.0.0.1:1000", nil)
+
+	out := buf.String()
+	require.True(t, strings.Contains(out, "served API request"), out)
+	require.Contains(t, out, "method=POST")
+	require.Contains(t, out, "path=/txs")
+	require.Contains(t, out, "status=401")
+	require.Contains(t, out, "client=10.0.0.1")
+}
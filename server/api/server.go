@@ -42,9 +42,15 @@ func New(clientCtx client.Context, logger log.Logger) *Server {
 
 // Start starts the API server. Internally, the API server leverages Tendermint's
 // JSON RPC server. Configuration options are provided via config.APIConfig
-// and are delegated to the Tendermint JSON RPC server. The process is
-// non-blocking, so an external signal handler must be used.
+// and are delegated to the Tendermint JSON RPC server, with the TLS, rate
+// limiting, API key and request logging options handled by the API server. The
+// process is non-blocking, so an external signal handler must be used.
 func (s *Server) Start(cfg config.Config) error {
+	useTLS := cfg.API.TLSCertFile != "" || cfg.API.TLSKeyFile != ""
+	if useTLS && (cfg.API.TLSCertFile == "" || cfg.API.TLSKeyFile == "") {
+		return fmt.Errorf("both the TLS certificate and key files of the API server must be set")
+	}
+
 	if cfg.API.Swagger {
 		s.registerSwaggerUI()
 	}
@@ -74,10 +80,27 @@ func (s *Server) Start(cfg config.Config) error {
 	var h http.Handler = s.Router
 
 	if cfg.API.EnableUnsafeCORS {
-		return tmrpcserver.Serve(s.listener, handlers.CORS()(h), s.logger, tmCfg)
+		h = handlers.CORS()(h)
+	}
+
+	if len(cfg.API.APIKeys) > 0 {
+		h = apiKeyHandler(h, cfg.API.APIKeys)
+	}
+
+	if cfg.API.RateLimit > 0 {
+		h = rateLimitHandler(h, newRateLimiter(cfg.API.RateLimit, cfg.API.RateLimitBurst))
+	}
+
+	// the rejected requests are logged too
+	if cfg.API.LogRequests {
+		h = requestLogHandler(h, s.logger)
+	}
+
+	if useTLS {
+		return tmrpcserver.ServeTLS(s.listener, h, cfg.API.TLSCertFile, cfg.API.TLSKeyFile, s.logger, tmCfg)
 	}
 
-	return tmrpcserver.Serve(s.listener, s.Router, s.logger, tmCfg)
+	return tmrpcserver.Serve(s.listener, h, s.logger, tmCfg)
 }
 
 // Close closes the API server.
@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/spf13/viper"
 
@@ -13,6 +14,17 @@ import (
 
 const (
 	defaultMinGasPrices = ""
+
+	// DefaultGRPCAddress is the default address the gRPC server binds to.
+	DefaultGRPCAddress = "0.0.0.0:9090"
+
+	// DefaultGRPCMaxRecvMsgSize defines the default gRPC max message size in
+	// bytes the server can receive.
+	DefaultGRPCMaxRecvMsgSize = 1024 * 1024 * 10
+
+	// DefaultGRPCMaxSendMsgSize defines the default gRPC max message size in
+	// bytes the server can send.
+	DefaultGRPCMaxSendMsgSize = 1024 * 1024 * 10
 )
 
 // BaseConfig defines the server's basic configuration
@@ -42,6 +54,27 @@ type BaseConfig struct {
 
 	// InterBlockCache enables inter-block caching.
 	InterBlockCache bool `mapstructure:"inter-block-cache"`
+
+	// ParallelDeliverTx enables the speculative parallel execution of the txs
+	// of each block. The resulting state is the same as with serial execution.
+	ParallelDeliverTx bool `mapstructure:"parallel-deliver-tx"`
+
+	// ParallelDeliverTxWorkers is the number of txs executed concurrently when
+	// ParallelDeliverTx is enabled, 0 meaning one per CPU.
+	ParallelDeliverTxWorkers uint `mapstructure:"parallel-deliver-tx-workers"`
+
+	// QueryGasLimit defines the maximum gas a custom or gRPC query can consume,
+	// 0 meaning no limit.
+	QueryGasLimit uint64 `mapstructure:"query-gas-limit"`
+
+	// QueryTimeout defines the maximum duration of a custom or gRPC query, 0
+	// meaning no limit.
+	QueryTimeout time.Duration `mapstructure:"query-timeout"`
+
+	// DebugDeliverTx enables the debug mode of DeliverTx, in which the gas
+	// consumption by descriptor and the result of each message of a tx are
+	// reported in the Info of its response.
+	DebugDeliverTx bool `mapstructure:"debug-deliver-tx"`
 }
 
 // APIConfig defines the API listener configuration.
@@ -70,9 +103,68 @@ type APIConfig struct {
 	// RPCMaxBodyBytes defines the Tendermint maximum response body (in bytes)
 	RPCMaxBodyBytes uint `mapstructure:"rpc-max-body-bytes"`
 
-	// TODO: TLS/Proxy configuration.
-	//
-	// Ref: https://github.com/cosmos/cosmos-sdk/issues/6420
+	// TLSCertFile and TLSKeyFile define the certificate and private key files
+	// the API server serves HTTPS with. It serves HTTP if they're empty.
+	TLSCertFile string `mapstructure:"tls-cert-file"`
+	TLSKeyFile  string `mapstructure:"tls-key-file"`
+
+	// RateLimit defines the number of requests per second each client IP can
+	// make, 0 meaning no limit.
+	RateLimit float64 `mapstructure:"rate-limit"`
+
+	// RateLimitBurst defines the number of requests each client IP can make at
+	// once, 0 meaning the rate limit rounded up.
+	RateLimitBurst uint `mapstructure:"rate-limit-burst"`
+
+	// APIKeys defines the API keys allowed to make write requests, i.e. with
+	// another method than GET, HEAD or OPTIONS, such as broadcasting txs to
+	// /txs. The key is given in the X-API-Key header. Write requests aren't
+	// restricted if empty.
+	APIKeys []string `mapstructure:"api-keys"`
+
+	// LogRequests defines if each request is logged, at the info level.
+	LogRequests bool `mapstructure:"log-requests"`
+}
+
+// GRPCConfig defines configuration for the gRPC server.
+type GRPCConfig struct {
+	// Enable defines if the gRPC server should be enabled.
+	Enable bool `mapstructure:"enable"`
+
+	// Address defines the gRPC server address to bind to.
+	Address string `mapstructure:"address"`
+
+	// MaxRecvMsgSize defines the max message size in bytes the server can receive.
+	MaxRecvMsgSize int `mapstructure:"max-recv-msg-size"`
+
+	// MaxSendMsgSize defines the max message size in bytes the server can send.
+	MaxSendMsgSize int `mapstructure:"max-send-msg-size"`
+}
+
+// StateSyncConfig defines the state sync snapshot configuration.
+type StateSyncConfig struct {
+	// SnapshotInterval sets the interval at which state sync snapshots are taken.
+	// 0 disables snapshots. Must be a multiple of PruningKeepEvery.
+	SnapshotInterval uint64 `mapstructure:"snapshot-interval"`
+
+	// SnapshotKeepRecent sets the number of recent state sync snapshots to keep.
+	// 0 keeps all snapshots.
+	SnapshotKeepRecent uint32 `mapstructure:"snapshot-keep-recent"`
+}
+
+// StreamingConfig defines the configuration of the file streaming service,
+// which writes the ABCI messages of each block along with the state changes of
+// the listened stores to files.
+type StreamingConfig struct {
+	// Keys defines the store keys whose state changes are streamed. "*" streams
+	// all the stores and an empty list disables streaming.
+	Keys []string `mapstructure:"keys"`
+
+	// WriteDir defines the directory the streaming files are written to.
+	WriteDir string `mapstructure:"write-dir"`
+
+	// FilePrefix defines the prefix of the streaming file names.
+	FilePrefix string `mapstructure:"file-prefix"`
 }
 
 // Config defines the server's top level configuration
@@ -82,6 +174,9 @@ type Config struct {
 	// Telemetry defines the application telemetry configuration
 	Telemetry telemetry.Config `mapstructure:"telemetry"`
 	API       APIConfig        `mapstructure:"api"`
+	GRPC      GRPCConfig       `mapstructure:"grpc"`
+	StateSync StateSyncConfig  `mapstructure:"state-sync"`
+	Streaming StreamingConfig  `mapstructure:"streaming"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -123,8 +218,9 @@ func DefaultConfig() *Config {
 			PruningInterval:   "0",
 		},
 		Telemetry: telemetry.Config{
-			Enabled:      false,
-			GlobalLabels: [][]string{},
+			Enabled:         false,
+			GlobalLabels:    [][]string{},
+			TracingExporter: telemetry.SpanExporterStdout,
 		},
 		API: APIConfig{
 			Enable:             false,
@@ -133,6 +229,20 @@ func DefaultConfig() *Config {
 			MaxOpenConnections: 1000,
 			RPCReadTimeout:     10,
 			RPCMaxBodyBytes:    1000000,
+			APIKeys:            []string{},
+		},
+		GRPC: GRPCConfig{
+			Enable:         false,
+			Address:        DefaultGRPCAddress,
+			MaxRecvMsgSize: DefaultGRPCMaxRecvMsgSize,
+			MaxSendMsgSize: DefaultGRPCMaxSendMsgSize,
+		},
+		StateSync: StateSyncConfig{
+			SnapshotInterval:   0,
+			SnapshotKeepRecent: 2,
+		},
+		Streaming: StreamingConfig{
+			Keys: []string{},
 		},
 	}
 }
@@ -158,6 +268,14 @@ func GetConfig(v *viper.Viper) Config {
 			PruningInterval:   v.GetString("pruning-interval"),
 			HaltHeight:        v.GetUint64("halt-height"),
 			HaltTime:          v.GetUint64("halt-time"),
+
+			ParallelDeliverTx:        v.GetBool("parallel-deliver-tx"),
+			ParallelDeliverTxWorkers: v.GetUint("parallel-deliver-tx-workers"),
+
+			QueryGasLimit: v.GetUint64("query-gas-limit"),
+			QueryTimeout:  v.GetDuration("query-timeout"),
+
+			DebugDeliverTx: v.GetBool("debug-deliver-tx"),
 		},
 		Telemetry: telemetry.Config{
 			ServiceName:             v.GetString("telemetry.service-name"),
@@ -167,6 +285,11 @@ func GetConfig(v *viper.Viper) Config {
 			EnableServiceLabel:      v.GetBool("telemetry.enable-service-label"),
 			PrometheusRetentionTime: v.GetInt64("telemetry.prometheus-retention-time"),
 			GlobalLabels:            globalLabels,
+			EnableTxMetrics:         v.GetBool("telemetry.enable-tx-metrics"),
+			EnableModuleMetrics:     v.GetBool("telemetry.enable-module-metrics"),
+			EnableTracing:           v.GetBool("telemetry.enable-tracing"),
+			TracingExporter:         v.GetString("telemetry.tracing-exporter"),
+			TracingFile:             v.GetString("telemetry.tracing-file"),
 		},
 		API: APIConfig{
 			Enable:             v.GetBool("api.enable"),
@@ -176,6 +299,27 @@ func GetConfig(v *viper.Viper) Config {
 			RPCWriteTimeout:    v.GetUint("api.rpc-write-timeout"),
 			RPCMaxBodyBytes:    v.GetUint("api.rpc-max-body-bytes"),
 			EnableUnsafeCORS:   v.GetBool("api.enabled-unsafe-cors"),
+			TLSCertFile:        v.GetString("api.tls-cert-file"),
+			TLSKeyFile:         v.GetString("api.tls-key-file"),
+			RateLimit:          v.GetFloat64("api.rate-limit"),
+			RateLimitBurst:     v.GetUint("api.rate-limit-burst"),
+			APIKeys:            v.GetStringSlice("api.api-keys"),
+			LogRequests:        v.GetBool("api.log-requests"),
+		},
+		GRPC: GRPCConfig{
+			Enable:         v.GetBool("grpc.enable"),
+			Address:        v.GetString("grpc.address"),
+			MaxRecvMsgSize: v.GetInt("grpc.max-recv-msg-size"),
+			MaxSendMsgSize: v.GetInt("grpc.max-send-msg-size"),
+		},
+		StateSync: StateSyncConfig{
+			SnapshotInterval:   v.GetUint64("state-sync.snapshot-interval"),
+			SnapshotKeepRecent: v.GetUint32("state-sync.snapshot-keep-recent"),
+		},
+		Streaming: StreamingConfig{
+			Keys:       v.GetStringSlice("streaming.keys"),
+			WriteDir:   v.GetString("streaming.write-dir"),
+			FilePrefix: v.GetString("streaming.file-prefix"),
 		},
 	}
 }
@@ -1,8 +1,13 @@
 package config
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -18,3 +23,74 @@ func TestSetMinimumFees(t *testing.T) {
 	cfg.SetMinGasPrices(sdk.DecCoins{sdk.NewInt64DecCoin("foo", 5)})
 	require.Equal(t, "5.000000000000000000foo", cfg.MinGasPrices)
 }
+
+func TestStreamingConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "config")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	cfg := DefaultConfig()
+	cfg.Streaming = StreamingConfig{Keys: []string{"acc", "bank"}, WriteDir: "/tmp/streaming", FilePrefix: "node0-"}
+	configPath := filepath.Join(dir, "app.toml")
+	WriteConfigFile(configPath, cfg)
+
+	v := viper.New()
+	v.SetConfigFile(configPath)
+	require.NoError(t, v.ReadInConfig())
+	require.Equal(t, cfg.Streaming, GetConfig(v).Streaming)
+
+	WriteConfigFile(configPath, DefaultConfig())
+	require.NoError(t, v.ReadInConfig())
+	require.Empty(t, GetConfig(v).Streaming.Keys)
+}
+
+func TestQueryLimitsConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "config")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	cfg := DefaultConfig()
+	cfg.QueryGasLimit = 3000000
+	cfg.QueryTimeout = 5 * time.Second
+	configPath := filepath.Join(dir, "app.toml")
+	WriteConfigFile(configPath, cfg)
+
+	v := viper.New()
+	v.SetConfigFile(configPath)
+	require.NoError(t, v.ReadInConfig())
+	require.Equal(t, uint64(3000000), GetConfig(v).QueryGasLimit)
+	require.Equal(t, 5*time.Second, GetConfig(v).QueryTimeout)
+
+	// queries are unlimited by default
+	WriteConfigFile(configPath, DefaultConfig())
+	require.NoError(t, v.ReadInConfig())
+	require.Zero(t, GetConfig(v).QueryGasLimit)
+	require.Zero(t, GetConfig(v).QueryTimeout)
+}
+
+func TestAPIConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "config")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	cfg := DefaultConfig()
+	cfg.API.TLSCertFile = "/tmp/api.crt"
+	cfg.API.TLSKeyFile = "/tmp/api.key"
+	cfg.API.RateLimit = 2.5
+	cfg.API.RateLimitBurst = 10
+	cfg.API.APIKeys = []string{"key1", "key2"}
+	cfg.API.LogRequests = true
+	configPath := filepath.Join(dir, "app.toml")
+	WriteConfigFile(configPath, cfg)
+
+	v := viper.New()
+	v.SetConfigFile(configPath)
+	require.NoError(t, v.ReadInConfig())
+	require.Equal(t, cfg.API, GetConfig(v).API)
+
+	// write requests are open by default
+	WriteConfigFile(configPath, DefaultConfig())
+	require.NoError(t, v.ReadInConfig())
+	require.Empty(t, GetConfig(v).API.APIKeys)
+	require.Zero(t, GetConfig(v).API.RateLimit)
+}
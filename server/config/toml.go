@@ -47,6 +47,30 @@ halt-time = {{ .BaseConfig.HaltTime }}
 # InterBlockCache enables inter-block caching.
 inter-block-cache = {{ .BaseConfig.InterBlockCache }}
 
+# ParallelDeliverTx enables the speculative parallel execution of the txs of
+# each block. Txs which conflict with the txs before them in the block are
+# re-executed in order, so that the resulting state is the same as with serial
+# execution. Only the txs whose messages the app allows to run in parallel are
+# executed speculatively.
+parallel-deliver-tx = {{ .BaseConfig.ParallelDeliverTx }}
+
+# ParallelDeliverTxWorkers is the number of txs executed concurrently when
+# parallel-deliver-tx is enabled (0 for one per CPU).
+parallel-deliver-tx-workers = {{ .BaseConfig.ParallelDeliverTxWorkers }}
+
+# QueryGasLimit defines the maximum gas a custom or gRPC query can consume
+# (0 for no limit). Queries exceeding it fail with an out of gas error.
+query-gas-limit = {{ .BaseConfig.QueryGasLimit }}
+
+# QueryTimeout defines the maximum duration of a custom or gRPC query, e.g.
+# "5s" (0 for no limit). Queries exceeding it fail with a query timeout error.
+query-timeout = "{{ .BaseConfig.QueryTimeout }}"
+
+# DebugDeliverTx enables the debug mode of DeliverTx, in which the gas
+# consumption by descriptor and the gas, events and data of each message of a
+# tx are reported in the info of its response, as they are in simulations.
+debug-deliver-tx = {{ .BaseConfig.DebugDeliverTx }}
+
 ###############################################################################
 ###                         Telemetry Configuration                         ###
 ###############################################################################
@@ -82,6 +106,30 @@ global-labels = [{{ range $k, $v := .Telemetry.GlobalLabels }}
   ["{{index $v 0 }}", "{{ index $v 1}}"],{{ end }}
 ]
 
+# EnableTxMetrics enables the Prometheus histograms of the latency and gas used
+# of the txs by message type URL and module route, along with the counters of
+# the txs rejected by the AnteHandler by error code. It requires the Prometheus
+# sink to be enabled.
+enable-tx-metrics = {{ .Telemetry.EnableTxMetrics }}
+
+# EnableModuleMetrics enables the Prometheus histograms of the latency of the
+# BeginBlock and EndBlock of each module. It requires the Prometheus sink to be
+# enabled.
+enable-module-metrics = {{ .Telemetry.EnableModuleMetrics }}
+
+# EnableTracing enables the tracing of the blocks, with spans for BeginBlock,
+# each DeliverTx, with its AnteHandler decorators and message handlers, EndBlock
+# and Commit, and of the queries. It is independent of the metrics.
+enable-tracing = {{ .Telemetry.EnableTracing }}
+
+# TracingExporter defines the exporter of the spans, "stdout" or "file", which
+# write each span as a line of JSON, or one registered by the application.
+tracing-exporter = "{{ .Telemetry.TracingExporter }}"
+
+# TracingFile defines the file to which the "file" exporter appends the spans,
+# data/spans.json in the home directory by default.
+tracing-file = "{{ .Telemetry.TracingFile }}"
+
 ###############################################################################
 ###                           API Configuration                             ###
 ###############################################################################
@@ -111,6 +159,80 @@ rpc-max-body-bytes = {{ .API.RPCMaxBodyBytes }}
 
 # EnableUnsafeCORS defines if CORS should be enabled (unsafe - use it at your own risk)
 enabled-unsafe-cors = {{ .API.EnableUnsafeCORS }}
+
+# TLSCertFile and TLSKeyFile define the certificate and private key files the
+# API server serves HTTPS with. It serves HTTP if they're empty.
+tls-cert-file = "{{ .API.TLSCertFile }}"
+tls-key-file = "{{ .API.TLSKeyFile }}"
+
+# RateLimit defines the number of requests per second each client IP can make
+# (0 for no limit). Requests exceeding it fail with 429 Too Many Requests.
+rate-limit = {{ .API.RateLimit }}
+
+# RateLimitBurst defines the number of requests each client IP can make at once
+# (0 for the rate limit rounded up).
+rate-limit-burst = {{ .API.RateLimitBurst }}
+
+# APIKeys defines the API keys allowed to make write requests, i.e. with another
+# method than GET, HEAD or OPTIONS, such as broadcasting txs to /txs. The key is
+# given in the X-API-Key header. Write requests aren't restricted if empty.
+api-keys = [{{ range .API.APIKeys }}"{{ . }}", {{ end }}]
+
+# LogRequests defines if each request is logged, at the info level of the
+# api-server module (e.g. log_level = "api-server:info,*:error" in config.toml).
+log-requests = {{ .API.LogRequests }}
+
+###############################################################################
+###                           gRPC Configuration                            ###
+###############################################################################
+
+[grpc]
+
+# Enable defines if the gRPC server should be enabled.
+enable = {{ .GRPC.Enable }}
+
+# Address defines the gRPC server address to bind to.
+address = "{{ .GRPC.Address }}"
+
+# MaxRecvMsgSize defines the max message size in bytes the server can receive.
+max-recv-msg-size = {{ .GRPC.MaxRecvMsgSize }}
+
+# MaxSendMsgSize defines the max message size in bytes the server can send.
+max-send-msg-size = {{ .GRPC.MaxSendMsgSize }}
+
+###############################################################################
+###                        State Sync Configuration                         ###
+###############################################################################
+
+# State sync snapshots allow other nodes to rapidly join the network without replaying historical
+# blocks, instead downloading and applying a snapshot of the application state at a given height.
+[state-sync]
+
+# snapshot-interval specifies the block interval at which local state sync snapshots are
+# taken (0 to disable). Must be a multiple of pruning-keep-every.
+snapshot-interval = {{ .StateSync.SnapshotInterval }}
+
+# snapshot-keep-recent specifies the number of recent snapshots to keep and serve (0 to keep all).
+snapshot-keep-recent = {{ .StateSync.SnapshotKeepRecent }}
+
+###############################################################################
+###                         Streaming Configuration                         ###
+###############################################################################
+
+# The streaming service writes the ABCI messages of each block, along with the state
+# changes they cause to the listened stores, to length-prefixed protobuf files.
+[streaming]
+
+# keys defines the store keys whose state changes are streamed ("*" for all the stores).
+# Streaming is disabled if empty.
+keys = [{{ range .Streaming.Keys }}"{{ . }}", {{ end }}]
+
+# write-dir defines the directory the streaming files are written to. Defaults to
+# data/streaming in the node home directory.
+write-dir = "{{ .Streaming.WriteDir }}"
+
+# file-prefix defines the prefix of the streaming file names.
+file-prefix = "{{ .Streaming.FilePrefix }}"
 `
 
 var configTemplate *template.Template
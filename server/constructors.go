@@ -6,6 +6,9 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/gogo/protobuf/grpc"
+	"github.com/spf13/viper"
+	"github.com/syndtr/goleveldb/leveldb/opt"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
 	tmtypes "github.com/tendermint/tendermint/types"
@@ -34,6 +37,18 @@ type (
 		abci.Application
 
 		RegisterAPIRoutes(*api.Server)
+
+		// RegisterGRPCServer registers the application's gRPC services with the
+		// provided gRPC server.
+		RegisterGRPCServer(grpc.Server)
+
+		// CommitMultiStore returns the multi-store the application state is
+		// committed to.
+		CommitMultiStore() sdk.CommitMultiStore
+
+		// Close releases the resources of the application, e.g. its span
+		// tracer, once the node stopped.
+		Close() error
 	}
 
 	// AppCreator is a function that allows us to lazily initialize an
@@ -51,6 +66,31 @@ func openDB(rootDir string) (dbm.DB, error) {
 	return db, err
 }
 
+// openReadOnlyDB opens the application database in read-only mode. LevelDB
+// databases written by any backend can be read with goleveldb.
+func openReadOnlyDB(rootDir string) (dbm.DB, error) {
+	dataDir := filepath.Join(rootDir, "data")
+	return dbm.NewGoLevelDBWithOpts("application", dataDir, &opt.Options{ReadOnly: true})
+}
+
+// offlineAppOptions returns a copy of the options of the node for the apps of
+// offline commands, like replay and state-diff, with the state sync snapshots,
+// the streaming, the tracing and the parallel DeliverTx execution disabled, so
+// that these apps don't write to the files of the node nor start workers.
+func offlineAppOptions(v *viper.Viper) *viper.Viper {
+	opts := viper.New()
+	for _, key := range v.AllKeys() {
+		opts.Set(key, v.Get(key))
+	}
+
+	opts.Set(FlagStateSyncSnapshotInterval, 0)
+	opts.Set(FlagStreamingKeys, []string{})
+	opts.Set(FlagTracing, false)
+	opts.Set(FlagParallelDeliverTx, false)
+
+	return opts
+}
+
 func openTraceWriter(traceWriterFile string) (w io.Writer, err error) {
 	if traceWriterFile != "" {
 		w, err = os.OpenFile(
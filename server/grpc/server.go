@@ -0,0 +1,56 @@
+package grpc
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+
+	"github.com/cosmos/cosmos-sdk/server/config"
+)
+
+// Application defines the subset of the server Application interface required
+// to register the application's gRPC services.
+type Application interface {
+	RegisterGRPCServer(gogogrpc.Server)
+}
+
+// StartGRPCServer starts a gRPC server on the address provided by the given
+// configuration. Every gRPC service registered by the application is served
+// along with the gRPC reflection service. The process is non-blocking, so an
+// external signal handler must be used to stop the returned server.
+func StartGRPCServer(app Application, cfg config.GRPCConfig) (*grpc.Server, error) {
+	grpcSrv := grpc.NewServer(
+		grpc.MaxRecvMsgSize(cfg.MaxRecvMsgSize),
+		grpc.MaxSendMsgSize(cfg.MaxSendMsgSize),
+	)
+	app.RegisterGRPCServer(grpcSrv)
+
+	// reflection allows consumers to build dynamic clients that can query any
+	// application without relying on its packages at compile time
+	reflection.Register(grpcSrv)
+
+	listener, err := net.Listen("tcp", cfg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	errCh := make(chan error)
+
+	go func() {
+		if err := grpcSrv.Serve(listener); err != nil {
+			errCh <- fmt.Errorf("failed to serve gRPC: %w", err)
+		}
+	}()
+
+	select {
+	case err := <-errCh:
+		return nil, err
+	case <-time.After(5 * time.Second): // assume server started successfully
+	}
+
+	return grpcSrv, nil
+}
@@ -0,0 +1,147 @@
+package grpc_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	rpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+type IntegrationTestSuite struct {
+	suite.Suite
+
+	cfg     network.Config
+	network *network.Network
+	conn    *grpc.ClientConn
+}
+
+func (s *IntegrationTestSuite) SetupSuite() {
+	s.T().Log("setting up integration test suite")
+
+	cfg := network.DefaultConfig()
+	cfg.NumValidators = 1
+
+	s.cfg = cfg
+	s.network = network.New(s.T(), cfg)
+
+	_, err := s.network.WaitForHeight(2)
+	s.Require().NoError(err)
+
+	val := s.network.Validators[0]
+	s.conn, err = grpc.Dial(val.AppConfig.GRPC.Address, grpc.WithInsecure())
+	s.Require().NoError(err)
+}
+
+func (s *IntegrationTestSuite) TearDownSuite() {
+	s.T().Log("tearing down integration test suite")
+	s.conn.Close()
+	s.network.Cleanup()
+}
+
+func (s *IntegrationTestSuite) TestGRPCServer_Balance() {
+	val := s.network.Validators[0]
+	queryClient := banktypes.NewQueryClient(s.conn)
+
+	res, err := queryClient.Balance(
+		context.Background(),
+		&banktypes.QueryBalanceRequest{Address: val.Address, Denom: s.cfg.BondDenom},
+	)
+	s.Require().NoError(err)
+	s.Require().Equal(
+		sdk.NewCoin(s.cfg.BondDenom, s.cfg.StakingTokens.Sub(s.cfg.BondedTokens)),
+		*res.Balance,
+	)
+
+	allRes, err := queryClient.AllBalances(
+		context.Background(),
+		&banktypes.QueryAllBalancesRequest{Address: val.Address},
+	)
+	s.Require().NoError(err)
+	s.Require().Equal(
+		sdk.NewCoins(
+			sdk.NewCoin(fmt.Sprintf("%stoken", val.Moniker), s.cfg.AccountTokens),
+			sdk.NewCoin(s.cfg.BondDenom, s.cfg.StakingTokens.Sub(s.cfg.BondedTokens)),
+		),
+		allRes.Balances,
+	)
+}
+
+func (s *IntegrationTestSuite) TestGRPCServer_BlockHeightHeader() {
+	val := s.network.Validators[0]
+	req := &banktypes.QueryBalanceRequest{Address: val.Address, Denom: s.cfg.BondDenom}
+
+	testCases := []struct {
+		name      string
+		client    banktypes.QueryClient
+		reqHeight string
+	}{
+		{"gRPC server, pinned height", banktypes.NewQueryClient(s.conn), "1"},
+		{"gRPC server, latest height", banktypes.NewQueryClient(s.conn), ""},
+		{"client context, pinned height", banktypes.NewQueryClient(val.ClientCtx), "1"},
+		{"client context, latest height", banktypes.NewQueryClient(val.ClientCtx), ""},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			ctx := context.Background()
+			if tc.reqHeight != "" {
+				ctx = metadata.AppendToOutgoingContext(ctx, grpctypes.GRPCBlockHeightHeader, tc.reqHeight)
+			}
+
+			var header metadata.MD
+			_, err := tc.client.Balance(ctx, req, grpc.Header(&header))
+			s.Require().NoError(err)
+
+			heights := header.Get(grpctypes.GRPCBlockHeightHeader)
+			s.Require().Len(heights, 1)
+
+			if tc.reqHeight != "" {
+				s.Require().Equal(tc.reqHeight, heights[0])
+			} else {
+				height, err := strconv.ParseInt(heights[0], 10, 64)
+				s.Require().NoError(err)
+				s.Require().GreaterOrEqual(height, int64(2))
+			}
+		})
+	}
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), grpctypes.GRPCBlockHeightHeader, "-1")
+	_, err := banktypes.NewQueryClient(s.conn).Balance(ctx, req)
+	s.Require().Error(err)
+}
+
+func (s *IntegrationTestSuite) TestGRPCServer_Reflection() {
+	// test server reflection
+	reflectClient := rpb.NewServerReflectionClient(s.conn)
+	stream, err := reflectClient.ServerReflectionInfo(context.Background(), grpc.WaitForReady(true))
+	s.Require().NoError(err)
+	s.Require().NoError(stream.Send(&rpb.ServerReflectionRequest{
+		MessageRequest: &rpb.ServerReflectionRequest_ListServices{},
+	}))
+
+	res, err := stream.Recv()
+	s.Require().NoError(err)
+
+	services := res.GetListServicesResponse().Service
+	serviceNames := make([]string, len(services))
+	for i, svc := range services {
+		serviceNames[i] = svc.Name
+	}
+
+	s.Require().Contains(serviceNames, "cosmos.bank.Query")
+}
+
+func TestIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(IntegrationTestSuite))
+}
@@ -0,0 +1,40 @@
+package server
+
+import (
+	"bytes"
+
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+)
+
+// blockTxsApp is implemented by the apps which can execute the txs of a block
+// in parallel, e.g. the ones embedding a BaseApp.
+type blockTxsApp interface {
+	SetBlockTxsProvider(provider baseapp.BlockTxsProvider)
+}
+
+// blockStore is the part of Tendermint's BlockStore used to provide the txs
+// of a block.
+type blockStore interface {
+	LoadBlock(height int64) *tmtypes.Block
+}
+
+// newBlockTxsProvider returns a BlockTxsProvider loading the txs of a block
+// from the node's block store. Tendermint saves a block before applying it,
+// so its txs are available at BeginBlock.
+func newBlockTxsProvider(store blockStore) baseapp.BlockTxsProvider {
+	return func(height int64, hash []byte) ([][]byte, bool) {
+		block := store.LoadBlock(height)
+		if block == nil || !bytes.Equal(block.Hash(), hash) {
+			return nil, false
+		}
+
+		txs := make([][]byte, len(block.Txs))
+		for i, tx := range block.Txs {
+			txs[i] = tx
+		}
+
+		return txs, true
+	}
+}
@@ -0,0 +1,31 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+type mockBlockStore map[int64]*tmtypes.Block
+
+func (s mockBlockStore) LoadBlock(height int64) *tmtypes.Block {
+	return s[height]
+}
+
+func TestBlockTxsProvider(t *testing.T) {
+	block := tmtypes.MakeBlock(2, []tmtypes.Tx{[]byte("tx1"), []byte("tx2")}, nil, nil)
+	provider := newBlockTxsProvider(mockBlockStore{2: block})
+
+	txs, ok := provider(2, block.Hash())
+	require.True(t, ok)
+	require.Equal(t, [][]byte{[]byte("tx1"), []byte("tx2")}, txs)
+
+	// unknown block
+	_, ok = provider(3, block.Hash())
+	require.False(t, ok)
+
+	// other block at the same height
+	_, ok = provider(2, []byte("other hash"))
+	require.False(t, ok)
+}
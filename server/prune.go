@@ -0,0 +1,110 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+	"github.com/syndtr/goleveldb/leveldb/util"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// PruneCmd returns a command deleting the versions of the app state which the
+// given pruning options don't keep from an existing application database.
+func PruneCmd(appCreator AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prune",
+		Short: "Prune the app state versions of the application database",
+		Long: `Prune the versions of the app state stored in the application database, as if the
+given pruning options had been used since the first height, and compact the
+database afterwards. The node must be stopped.
+
+The pruning options are given as for the start command. The most recent versions
+and the versions at every 'pruning-keep-every' heights are kept, whereas the
+pruning interval is ignored.
+`,
+		PreRunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := GetServerContextFromCmd(cmd)
+			serverCtx.Viper.BindPFlags(cmd.Flags())
+
+			_, err := GetPruningOptionsFromFlags(pruneAppOptions{serverCtx.Viper})
+			return err
+		},
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := GetServerContextFromCmd(cmd)
+
+			opts, err := GetPruningOptionsFromFlags(pruneAppOptions{serverCtx.Viper})
+			if err != nil {
+				return err
+			}
+
+			db, err := openDB(serverCtx.Config.RootDir)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			goLevelDB, canCompact := db.(*dbm.GoLevelDB)
+
+			app := appCreator(serverCtx.Logger, db, nil, serverCtx.Viper)
+
+			cms, ok := app.CommitMultiStore().(*rootmulti.Store)
+			if !ok {
+				return fmt.Errorf("unexpected multi-store type %T", app.CommitMultiStore())
+			}
+
+			pruned, err := cms.PruneVersions(opts)
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "Pruned %d versions\n", len(pruned))
+
+			if !canCompact {
+				fmt.Fprintf(cmd.ErrOrStderr(), "Skipped the compaction of the application database, which is not supported by %T\n", db)
+				return nil
+			}
+
+			if err := compactDB(goLevelDB); err != nil {
+				return fmt.Errorf("failed to compact the application database: %w", err)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagPruning, storetypes.PruningOptionDefault, "Pruning strategy (default|nothing|everything|custom)")
+	cmd.Flags().Uint64(FlagPruningKeepRecent, 0, "Number of recent heights to keep on disk (ignored if pruning is not 'custom')")
+	cmd.Flags().Uint64(FlagPruningKeepEvery, 0, "Offset heights to keep on disk after 'keep-every' (ignored if pruning is not 'custom')")
+	cmd.Flags().Uint64(FlagPruningInterval, 0, "Height interval at which pruned heights are removed from disk (ignored if pruning is not 'custom')")
+
+	return cmd
+}
+
+// pruneAppOptions are the AppOptions of the prune command. As the pruning
+// interval is ignored, it is set to a valid value for the given keep-every
+// setting, so that the custom pruning options pass their validation.
+type pruneAppOptions struct {
+	AppOptions
+}
+
+func (o pruneAppOptions) Get(key string) interface{} {
+	if key != FlagPruningInterval {
+		return o.AppOptions.Get(key)
+	}
+
+	if cast.ToUint64(o.AppOptions.Get(FlagPruningKeepEvery)) == 1 {
+		return uint64(0)
+	}
+
+	return uint64(1)
+}
+
+// compactDB compacts the whole key range of a goleveldb database, to reclaim
+// the disk space of the deleted keys. Other databases can't be compacted.
+func compactDB(db *dbm.GoLevelDB) error {
+	return db.DB().CompactRange(util.Range{})
+}
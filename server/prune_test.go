@@ -0,0 +1,72 @@
+package server
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func TestCompactDB(t *testing.T) {
+	dir, err := ioutil.TempDir("", "prune")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	db, err := dbm.NewGoLevelDB("application", dir)
+	require.NoError(t, err)
+	defer db.Close()
+
+	for i := 0; i < 100; i++ {
+		require.NoError(t, db.Set([]byte(fmt.Sprintf("key%d", i)), []byte("value")))
+	}
+
+	for i := 0; i < 50; i++ {
+		require.NoError(t, db.Delete([]byte(fmt.Sprintf("key%d", i))))
+	}
+
+	require.NoError(t, compactDB(db))
+
+	value, err := db.Get([]byte("key99"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), value)
+
+	has, err := db.Has([]byte("key0"))
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestPruneAppOptions(t *testing.T) {
+	testCases := []struct {
+		name      string
+		keepEvery uint64
+		interval  uint64
+	}{
+		{"pruning everything", 0, 0},
+		{"pruning nothing", 1, 0},
+		{"pruning", 100, 10},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			v := viper.New()
+			v.Set(FlagPruning, storetypes.PruningOptionCustom)
+			v.Set(FlagPruningKeepRecent, 100)
+			v.Set(FlagPruningKeepEvery, tc.keepEvery)
+			v.Set(FlagPruningInterval, tc.interval)
+
+			// the pruning interval is ignored
+			opts, err := GetPruningOptionsFromFlags(pruneAppOptions{v})
+			require.NoError(t, err)
+			require.Equal(t, uint64(100), opts.KeepRecent)
+			require.Equal(t, tc.keepEvery, opts.KeepEvery)
+		})
+	}
+}
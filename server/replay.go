@@ -0,0 +1,380 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	abcicli "github.com/tendermint/tendermint/abci/client"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmcfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/node"
+	"github.com/tendermint/tendermint/proxy"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/store"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	snapshottypes "github.com/cosmos/cosmos-sdk/store/snapshots/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	flagReplayTo        = "to"
+	flagReplayTraceFile = "trace-file"
+)
+
+// ReplayCmd returns a command re-executing the blocks of the local block store
+// from a given height, to find the block at which the app hash diverges.
+func ReplayCmd(appCreator AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay [from-height]",
+		Short: "Replay the blocks of the block store and stop at the first app hash mismatch",
+		Long: `Replay the blocks of the block store from the given height through the app,
+with KVStore tracing enabled, and compare the resulting app hashes with the ones
+recorded in the blocks.
+
+The app state before the given height is restored from the application database
+into a temporary one, so that the state of the node is left untouched. The node
+must be stopped.
+
+When an app hash differs from the recorded one, the replay stops and the commit
+hashes of the sub-stores and the key-value pairs written by the block are
+printed, and the trace of the block is written to the trace file.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := GetServerContextFromCmd(cmd)
+			cfg := serverCtx.Config
+
+			from, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			if from < 1 {
+				return fmt.Errorf("invalid height: %d", from)
+			}
+
+			to, _ := cmd.Flags().GetInt64(flagReplayTo)
+
+			traceFile, _ := cmd.Flags().GetString(flagReplayTraceFile)
+			if traceFile == "" {
+				traceFile = filepath.Join(cfg.RootDir, "data", "replay.trace")
+			}
+
+			dir, err := ioutil.TempDir("", "replay")
+			if err != nil {
+				return err
+			}
+			defer os.RemoveAll(dir)
+
+			app, err := openReplayApp(appCreator, serverCtx, dir, from-1)
+			if err != nil {
+				return err
+			}
+
+			blockStoreDB, err := node.DefaultDBProvider(&node.DBContext{ID: "blockstore", Config: cfg})
+			if err != nil {
+				return err
+			}
+			defer blockStoreDB.Close()
+
+			stateDB, err := node.DefaultDBProvider(&node.DBContext{ID: "state", Config: cfg})
+			if err != nil {
+				return err
+			}
+			defer stateDB.Close()
+
+			mismatch, err := replayBlocks(app, store.NewBlockStore(blockStoreDB), stateDB, from, to, serverCtx.Logger)
+			if err != nil {
+				return err
+			}
+
+			if mismatch == nil {
+				fmt.Fprintln(cmd.OutOrStdout(), "Replayed the blocks without app hash mismatch")
+				return nil
+			}
+
+			printAppHashMismatch(cmd.OutOrStdout(), mismatch)
+
+			if err := ioutil.WriteFile(traceFile, mismatch.trace, 0644); err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "\nThe trace of the block was written to %s\n", traceFile)
+
+			return fmt.Errorf("app hash mismatch at height %d", mismatch.height)
+		},
+	}
+
+	cmd.Flags().Int64(flagReplayTo, 0, "The last height to replay, the height of the block store if 0")
+	cmd.Flags().String(flagReplayTraceFile, "", "The file to write the trace of the mismatching block to, data/replay.trace in the home directory if empty")
+
+	return cmd
+}
+
+// replayApp is an app whose KVStore traces and writes to the committed
+// stores are recorded.
+type replayApp struct {
+	abci.Application
+
+	cms      *rootmulti.Store
+	trace    *bytes.Buffer
+	listener *replayListener
+}
+
+// newReplayApp returns a replayApp given an app, its multi-store and the
+// buffer it writes its KVStore traces to. It must be called before the app
+// begins a block, for the writes of the block to be recorded.
+func newReplayApp(app abci.Application, cms *rootmulti.Store, trace *bytes.Buffer) *replayApp {
+	listener := &replayListener{}
+	for _, key := range cms.StoreKeysByName() {
+		cms.AddListeners(key, []storetypes.WriteListener{listener})
+	}
+
+	return &replayApp{Application: app, cms: cms, trace: trace, listener: listener}
+}
+
+// openReplayApp returns a replayApp using a new database in the given
+// directory, with the state of the application database at the given height.
+// The state at height 0 is initialized from the genesis file.
+func openReplayApp(appCreator AppCreator, ctx *Context, dir string, height int64) (*replayApp, error) {
+	db, err := sdk.NewLevelDB("application", dir)
+	if err != nil {
+		return nil, err
+	}
+
+	trace := new(bytes.Buffer)
+	app := appCreator(ctx.Logger, db, trace, offlineAppOptions(ctx.Viper))
+
+	cms, ok := app.CommitMultiStore().(*rootmulti.Store)
+	if !ok {
+		return nil, fmt.Errorf("unexpected multi-store type %T", app.CommitMultiStore())
+	}
+
+	rApp := newReplayApp(app, cms, trace)
+
+	if height == 0 {
+		req, err := initChainRequest(ctx.Config)
+		if err != nil {
+			return nil, err
+		}
+
+		rApp.InitChain(req)
+
+		return rApp, nil
+	}
+
+	sourceDB, err := openDB(ctx.Config.RootDir)
+	if err != nil {
+		return nil, err
+	}
+	defer sourceDB.Close()
+
+	// The application database is read through a multi-store mounting the
+	// stores of the replay app, rather than through a second app, which would
+	// lock the resources of the node, like its snapshot store, once again.
+	source := rootmulti.NewStore(sourceDB)
+	for _, key := range cms.StoreKeysByName() {
+		source.MountStoreWithDB(key, cms.GetCommitKVStore(key).GetStoreType(), nil)
+	}
+
+	if err := source.LoadLatestVersion(); err != nil {
+		return nil, err
+	}
+
+	chunks, err := source.Snapshot(uint64(height), snapshottypes.CurrentFormat)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cms.Restore(uint64(height), snapshottypes.CurrentFormat, chunks, nil); err != nil {
+		return nil, err
+	}
+
+	return rApp, nil
+}
+
+// initChainRequest returns the InitChain request made by Tendermint for the
+// genesis file of the node.
+func initChainRequest(cfg *tmcfg.Config) (abci.RequestInitChain, error) {
+	genDoc, err := tmtypes.GenesisDocFromFile(cfg.GenesisFile())
+	if err != nil {
+		return abci.RequestInitChain{}, err
+	}
+
+	validators := make([]*tmtypes.Validator, len(genDoc.Validators))
+	for i, val := range genDoc.Validators {
+		validators[i] = tmtypes.NewValidator(val.PubKey, val.Power)
+	}
+
+	return abci.RequestInitChain{
+		Time:            genDoc.GenesisTime,
+		ChainId:         genDoc.ChainID,
+		ConsensusParams: tmtypes.TM2PB.ConsensusParams(genDoc.ConsensusParams),
+		Validators:      tmtypes.TM2PB.ValidatorUpdates(tmtypes.NewValidatorSet(validators)),
+		AppStateBytes:   genDoc.AppState,
+	}, nil
+}
+
+// appHashMismatch describes the block whose app hash differs from the
+// recorded one.
+type appHashMismatch struct {
+	height   int64
+	expected []byte
+	got      []byte
+
+	// commit hashes of the sub-stores by name
+	storeHashes map[string][]byte
+
+	// key-value pairs written by the block, sorted by store
+	changes []storetypes.StoreKVPair
+
+	// KVStore trace of the block
+	trace []byte
+}
+
+// replayBlocks executes the blocks of the block store from the given height
+// up to the to height, or the height of the block store if to is 0, and
+// compares their app hashes with the recorded ones. The app hash of a block is
+// recorded in the header of the next block, or in the state for the last
+// block. The replay stops at the first mismatch, which is returned.
+func replayBlocks(
+	app *replayApp, bs *store.BlockStore, stateDB dbm.DB, from, to int64, logger log.Logger,
+) (*appHashMismatch, error) {
+	if to == 0 || to > bs.Height() {
+		to = bs.Height()
+	}
+
+	if from > to {
+		return nil, fmt.Errorf("no block to replay from height %d to height %d", from, to)
+	}
+
+	state := sm.LoadState(stateDB)
+	proxyApp := proxy.NewAppConnConsensus(abcicli.NewLocalClient(nil, app))
+
+	for height := from; height <= to; height++ {
+		block := bs.LoadBlock(height)
+		if block == nil {
+			return nil, fmt.Errorf("block at height %d not found", height)
+		}
+
+		app.trace.Reset()
+		app.listener.changes = nil
+
+		appHash, err := sm.ExecCommitBlock(proxyApp, block, logger, stateDB)
+		if err != nil {
+			return nil, err
+		}
+
+		var expected []byte
+
+		switch {
+		case height < bs.Height():
+			expected = bs.LoadBlockMeta(height + 1).Header.AppHash
+
+		case height == state.LastBlockHeight:
+			expected = state.AppHash
+
+		default:
+			logger.Info("replayed block without recorded app hash", "height", height, "app_hash", fmt.Sprintf("%X", appHash))
+			continue
+		}
+
+		if !bytes.Equal(appHash, expected) {
+			changes := app.listener.changes
+			sort.SliceStable(changes, func(i, j int) bool {
+				return changes[i].StoreKey < changes[j].StoreKey
+			})
+
+			return &appHashMismatch{
+				height:      height,
+				expected:    expected,
+				got:         appHash,
+				storeHashes: storeCommitHashes(app.cms),
+				changes:     changes,
+				trace:       append([]byte{}, app.trace.Bytes()...),
+			}, nil
+		}
+
+		logger.Info("replayed block", "height", height, "app_hash", fmt.Sprintf("%X", appHash))
+	}
+
+	return nil, nil
+}
+
+// storeCommitHashes returns the last commit hashes of the committed
+// sub-stores by name.
+func storeCommitHashes(cms *rootmulti.Store) map[string][]byte {
+	hashes := make(map[string][]byte)
+
+	for name, key := range cms.StoreKeysByName() {
+		store := cms.GetCommitKVStore(key)
+
+		switch store.GetStoreType() {
+		case storetypes.StoreTypeTransient, storetypes.StoreTypeMemory:
+			continue
+		}
+
+		hashes[name] = store.LastCommitID().Hash
+	}
+
+	return hashes
+}
+
+// printAppHashMismatch prints the commit hashes of the sub-stores and the
+// key-value pairs written by the mismatching block.
+func printAppHashMismatch(w io.Writer, mismatch *appHashMismatch) {
+	fmt.Fprintf(w, "App hash mismatch at height %d: expected %X, got %X\n", mismatch.height, mismatch.expected, mismatch.got)
+
+	names := make([]string, 0, len(mismatch.storeHashes))
+	for name := range mismatch.storeHashes {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	fmt.Fprintln(w, "\nStore commit hashes:")
+
+	for _, name := range names {
+		fmt.Fprintf(w, "%s %X\n", name, mismatch.storeHashes[name])
+	}
+
+	fmt.Fprintln(w, "\nKey-value pairs written by the block:")
+
+	for _, change := range mismatch.changes {
+		if change.Delete {
+			fmt.Fprintf(w, "%s delete %X\n", change.StoreKey, change.Key)
+		} else {
+			fmt.Fprintf(w, "%s set %X = %X\n", change.StoreKey, change.Key, change.Value)
+		}
+	}
+}
+
+// replayListener is a WriteListener recording the key-value pairs written to
+// the committed stores.
+type replayListener struct {
+	changes []storetypes.StoreKVPair
+}
+
+// OnWrite implements the WriteListener interface.
+func (l *replayListener) OnWrite(storeKey storetypes.StoreKey, key []byte, value []byte, delete bool) error {
+	l.changes = append(l.changes, storetypes.StoreKVPair{
+		StoreKey: storeKey.Name(),
+		Delete:   delete,
+		Key:      key,
+		Value:    value,
+	})
+
+	return nil
+}
@@ -0,0 +1,234 @@
+package server
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	abcicli "github.com/tendermint/tendermint/abci/client"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/proxy"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/store"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/server/api"
+	"github.com/cosmos/cosmos-sdk/server/mock"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func newReplayTestApp(t *testing.T, genesis string) *replayApp {
+	dir, err := ioutil.TempDir("", "replay")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	app, err := mock.NewApp(dir, log.NewNopLogger())
+	require.NoError(t, err)
+
+	trace := new(bytes.Buffer)
+	bapp := app.(*baseapp.BaseApp)
+	bapp.SetCommitMultiStoreTracer(trace)
+
+	rApp := newReplayApp(bapp, bapp.CommitMultiStore().(*rootmulti.Store), trace)
+	rApp.InitChain(abci.RequestInitChain{AppStateBytes: []byte(genesis)})
+
+	return rApp
+}
+
+// setupReplayChain executes blocks through an app initialized with the given
+// genesis, and saves them and the resulting states.
+func setupReplayChain(t *testing.T, genesis string, height int64) (*store.BlockStore, dbm.DB) {
+	app := newReplayTestApp(t, genesis)
+	proxyApp := proxy.NewAppConnConsensus(abcicli.NewLocalClient(nil, app))
+
+	vals, _ := tmtypes.RandValidatorSet(1, 10)
+	stateDB := dbm.NewMemDB()
+	bs := store.NewBlockStore(dbm.NewMemDB())
+
+	state := sm.State{
+		ChainID:                          "test-chain",
+		NextValidators:                   vals,
+		Validators:                       vals,
+		LastValidators:                   vals,
+		LastHeightValidatorsChanged:      1,
+		ConsensusParams:                  *tmtypes.DefaultConsensusParams(),
+		LastHeightConsensusParamsChanged: 1,
+	}
+	sm.SaveState(stateDB, state)
+
+	lastCommit := &tmtypes.Commit{}
+
+	for h := int64(1); h <= height; h++ {
+		txs := []tmtypes.Tx{[]byte(fmt.Sprintf("key%d=value%d", h, h))}
+		block := tmtypes.MakeBlock(h, txs, lastCommit, nil)
+		block.ChainID = state.ChainID
+		block.AppHash = state.AppHash
+
+		partSet := block.MakePartSet(tmtypes.BlockPartSizeBytes)
+		blockID := tmtypes.BlockID{Hash: block.Hash(), PartsHeader: partSet.Header()}
+		lastCommit = tmtypes.NewCommit(h, 0, blockID, []tmtypes.CommitSig{tmtypes.NewCommitSigAbsent()})
+		bs.SaveBlock(block, partSet, lastCommit)
+
+		appHash, err := sm.ExecCommitBlock(proxyApp, block, log.NewNopLogger(), stateDB)
+		require.NoError(t, err)
+
+		state.LastBlockHeight = h
+		state.LastBlockID = blockID
+		state.AppHash = appHash
+		sm.SaveState(stateDB, state)
+	}
+
+	return bs, stateDB
+}
+
+func TestReplayBlocks(t *testing.T) {
+	genesis := `{"values": [{"key": "hello", "value": "goodbye"}]}`
+	bs, stateDB := setupReplayChain(t, genesis, 4)
+
+	// the blocks are replayed without mismatch with the same genesis
+	app := newReplayTestApp(t, genesis)
+
+	mismatch, err := replayBlocks(app, bs, stateDB, 1, 0, log.NewNopLogger())
+	require.NoError(t, err)
+	require.Nil(t, mismatch)
+	require.Equal(t, int64(4), app.cms.LastCommitID().Version)
+
+	// the replay stops at the first block with a different app hash
+	app = newReplayTestApp(t, `{"values": [{"key": "hello", "value": "hi"}]}`)
+
+	mismatch, err = replayBlocks(app, bs, stateDB, 1, 3, log.NewNopLogger())
+	require.NoError(t, err)
+	require.NotNil(t, mismatch)
+	require.Equal(t, int64(1), mismatch.height)
+	require.Equal(t, bs.LoadBlockMeta(2).Header.AppHash.Bytes(), mismatch.expected)
+	require.Equal(t, app.cms.LastCommitID().Hash, mismatch.got)
+
+	mainStore := app.cms.GetCommitKVStore(app.cms.StoreKeysByName()["main"])
+	require.Equal(t, map[string][]byte{"main": mainStore.LastCommitID().Hash}, mismatch.storeHashes)
+	require.Contains(t, string(mismatch.trace), base64.StdEncoding.EncodeToString([]byte("key1")))
+
+	keys := make([]string, len(mismatch.changes))
+	for i, change := range mismatch.changes {
+		require.Equal(t, "main", change.StoreKey)
+		keys[i] = string(change.Key)
+	}
+
+	require.ElementsMatch(t, []string{"hello", "key1"}, keys)
+
+	out := new(bytes.Buffer)
+	printAppHashMismatch(out, mismatch)
+	require.Contains(t, out.String(), "App hash mismatch at height 1")
+	require.Contains(t, out.String(), fmt.Sprintf("main set %X = %X", "key1", "value1"))
+
+	// the blocks must be in the block store
+	_, err = replayBlocks(app, bs, stateDB, 5, 6, log.NewNopLogger())
+	require.Error(t, err)
+}
+
+// replayTestApp is a mock Application without API routes.
+type replayTestApp struct {
+	*baseapp.BaseApp
+}
+
+func (replayTestApp) RegisterAPIRoutes(*api.Server) {}
+
+// replayTestAppCreator returns an AppCreator of mock apps, recording the
+// options of the created apps.
+func replayTestAppCreator(appOpts *[]AppOptions) AppCreator {
+	return func(logger log.Logger, db dbm.DB, traceStore io.Writer, opts AppOptions) Application {
+		*appOpts = append(*appOpts, opts)
+
+		key := sdk.NewKVStoreKey("main")
+		txDecoder := func(bz []byte) (sdk.Tx, error) {
+			kv := strings.SplitN(string(bz), "=", 2)
+			return mock.NewTx(kv[0], kv[1]), nil
+		}
+
+		app := baseapp.NewBaseApp("kvstore", logger, db, txDecoder)
+		app.SetCommitMultiStoreTracer(traceStore)
+		app.MountStores(key)
+		app.SetInitChainer(mock.InitChainer(key))
+		app.Router().AddRoute(sdk.NewRoute("kvstore", mock.KVStoreHandler(key)))
+
+		if err := app.LoadLatestVersion(); err != nil {
+			panic(err)
+		}
+
+		return replayTestApp{app}
+	}
+}
+
+func TestOpenReplayApp(t *testing.T) {
+	home, err := ioutil.TempDir("", "replay")
+	require.NoError(t, err)
+	defer os.RemoveAll(home)
+
+	var appOpts []AppOptions
+	appCreator := replayTestAppCreator(&appOpts)
+
+	// commit blocks to the application database of the node
+	db, err := openDB(home)
+	require.NoError(t, err)
+
+	app := appCreator(log.NewNopLogger(), db, nil, viper.New()).(replayTestApp)
+	app.InitChain(abci.RequestInitChain{AppStateBytes: []byte(`{"values": [{"key": "hello", "value": "goodbye"}]}`)})
+
+	var commitIDs []sdk.CommitID
+	for h := int64(1); h <= 3; h++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: h}})
+		res := app.DeliverTx(abci.RequestDeliverTx{Tx: []byte(fmt.Sprintf("key%d=value%d", h, h))})
+		require.True(t, res.IsOK(), res.Log)
+		app.EndBlock(abci.RequestEndBlock{Height: h})
+		app.Commit()
+
+		commitIDs = append(commitIDs, app.LastCommitID())
+	}
+
+	require.NoError(t, db.Close())
+
+	serverCtx := NewDefaultContext()
+	serverCtx.Config.SetRoot(home)
+	serverCtx.Viper.Set(FlagStateSyncSnapshotInterval, 10)
+	serverCtx.Viper.Set(FlagStreamingKeys, []string{"main"})
+	serverCtx.Viper.Set(FlagTracing, true)
+	serverCtx.Viper.Set(FlagPruning, "nothing")
+
+	dir, err := ioutil.TempDir("", "replay")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	appOpts = nil
+
+	rApp, err := openReplayApp(appCreator, serverCtx, dir, 2)
+	require.NoError(t, err)
+
+	// the state is restored from the application database at the height,
+	// without creating another app
+	require.Equal(t, commitIDs[1], rApp.cms.LastCommitID())
+
+	store := rApp.cms.GetKVStore(rApp.cms.StoreKeysByName()["main"])
+	require.Equal(t, []byte("goodbye"), store.Get([]byte("hello")))
+	require.Equal(t, []byte("value2"), store.Get([]byte("key2")))
+	require.Nil(t, store.Get([]byte("key3")))
+
+	// the replay app doesn't write to the files of the node
+	require.Len(t, appOpts, 1)
+	require.Equal(t, 0, appOpts[0].Get(FlagStateSyncSnapshotInterval))
+	require.Empty(t, appOpts[0].Get(FlagStreamingKeys))
+	require.Equal(t, false, appOpts[0].Get(FlagTracing))
+	require.Equal(t, "nothing", appOpts[0].Get(FlagPruning))
+
+	// the node options are left untouched
+	require.Equal(t, 10, serverCtx.Viper.Get(FlagStateSyncSnapshotInterval))
+}
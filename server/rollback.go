@@ -0,0 +1,167 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	tmcfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/node"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/store"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// RollbackCmd returns a command reverting the app and Tendermint states by one
+// height, to recover from a bad binary committing a wrong app hash.
+func RollbackCmd(appCreator AppCreator) *cobra.Command {
+	return &cobra.Command{
+		Use:   "rollback",
+		Short: "Rollback the app and Tendermint states by one height",
+		Long: `Rollback the app and Tendermint states by one height.
+
+A rollback recovers from an incorrect application state transition, once
+Tendermint has persisted an incorrect app hash and can no longer make progress.
+The state at height n is overwritten by the state at height n - 1. No blocks are
+removed, so the transactions of block n are executed again once the node is
+restarted, e.g. with a fixed binary.
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := GetServerContextFromCmd(cmd)
+			cfg := serverCtx.Config
+
+			db, err := openDB(cfg.RootDir)
+			if err != nil {
+				return err
+			}
+
+			app := appCreator(serverCtx.Logger, db, nil, serverCtx.Viper)
+
+			height, hash, err := rollbackTendermintState(cfg)
+			if err != nil {
+				return fmt.Errorf("failed to rollback tendermint state: %w", err)
+			}
+
+			if err := app.CommitMultiStore().RollbackToVersion(height); err != nil {
+				return fmt.Errorf("failed to rollback to version: %w", err)
+			}
+
+			fmt.Printf("Rolled back state to height %d and hash %X\n", height, hash)
+			return nil
+		},
+	}
+}
+
+// rollbackTendermintState opens the block and state stores of the node and
+// rolls back its state by one height. It returns the height and app hash of
+// the resulting state.
+func rollbackTendermintState(cfg *tmcfg.Config) (int64, []byte, error) {
+	blockStoreDB, err := node.DefaultDBProvider(&node.DBContext{ID: "blockstore", Config: cfg})
+	if err != nil {
+		return -1, nil, err
+	}
+	defer blockStoreDB.Close()
+
+	stateDB, err := node.DefaultDBProvider(&node.DBContext{ID: "state", Config: cfg})
+	if err != nil {
+		return -1, nil, err
+	}
+	defer stateDB.Close()
+
+	return rollbackState(store.NewBlockStore(blockStoreDB), stateDB)
+}
+
+// rollbackBlockStore is the part of Tendermint's BlockStore used to roll back
+// the state.
+type rollbackBlockStore interface {
+	Height() int64
+	LoadBlockMeta(height int64) *tmtypes.BlockMeta
+}
+
+// rollbackState overwrites the state persisted in stateDB with the state at
+// the previous height, which is rebuilt from the headers of the blocks and
+// the validators and consensus params saved at that height. The app hash and
+// last results hash of a block are only known once the next block is saved,
+// so they are taken from the header of the latest block.
+func rollbackState(bs rollbackBlockStore, stateDB dbm.DB) (int64, []byte, error) {
+	invalidState := sm.LoadState(stateDB)
+	if invalidState.IsEmpty() {
+		return -1, nil, fmt.Errorf("no state found")
+	}
+
+	height := bs.Height()
+
+	// The block and the state aren't persisted atomically. If the node stopped
+	// after saving the block but before saving the state, there is nothing to
+	// roll back.
+	if height == invalidState.LastBlockHeight+1 {
+		return invalidState.LastBlockHeight, invalidState.AppHash, nil
+	}
+
+	if height != invalidState.LastBlockHeight {
+		return -1, nil, fmt.Errorf(
+			"state height (%d) is not one below or equal to block store height (%d)",
+			invalidState.LastBlockHeight, height,
+		)
+	}
+
+	rollbackHeight := invalidState.LastBlockHeight - 1
+
+	rollbackBlock := bs.LoadBlockMeta(rollbackHeight)
+	if rollbackBlock == nil {
+		return -1, nil, fmt.Errorf("block at height %d not found", rollbackHeight)
+	}
+
+	latestBlock := bs.LoadBlockMeta(invalidState.LastBlockHeight)
+	if latestBlock == nil {
+		return -1, nil, fmt.Errorf("block at height %d not found", invalidState.LastBlockHeight)
+	}
+
+	previousLastValidatorSet, err := sm.LoadValidators(stateDB, rollbackHeight)
+	if err != nil {
+		return -1, nil, err
+	}
+
+	previousParams, err := sm.LoadConsensusParams(stateDB, rollbackHeight+1)
+	if err != nil {
+		return -1, nil, err
+	}
+
+	// the validators or consensus params may have changed at the rolled back
+	// height
+	valChangeHeight := invalidState.LastHeightValidatorsChanged
+	if valChangeHeight > rollbackHeight {
+		valChangeHeight = rollbackHeight + 1
+	}
+
+	paramsChangeHeight := invalidState.LastHeightConsensusParamsChanged
+	if paramsChangeHeight > rollbackHeight {
+		paramsChangeHeight = rollbackHeight + 1
+	}
+
+	rolledBackState := sm.State{
+		Version: invalidState.Version,
+		ChainID: invalidState.ChainID,
+
+		LastBlockHeight: rollbackBlock.Header.Height,
+		LastBlockID:     rollbackBlock.BlockID,
+		LastBlockTime:   rollbackBlock.Header.Time,
+
+		NextValidators:              invalidState.Validators,
+		Validators:                  invalidState.LastValidators,
+		LastValidators:              previousLastValidatorSet,
+		LastHeightValidatorsChanged: valChangeHeight,
+
+		ConsensusParams:                  previousParams,
+		LastHeightConsensusParamsChanged: paramsChangeHeight,
+
+		LastResultsHash: latestBlock.Header.LastResultsHash,
+		AppHash:         latestBlock.Header.AppHash,
+	}
+
+	// This also saves the validators and consensus params of the next heights,
+	// which are the same as the ones already saved.
+	sm.SaveState(stateDB, rolledBackState)
+
+	return rolledBackState.LastBlockHeight, rolledBackState.AppHash, nil
+}
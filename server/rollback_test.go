@@ -0,0 +1,100 @@
+package server
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	sm "github.com/tendermint/tendermint/state"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+type mockRollbackBlockStore map[int64]*tmtypes.BlockMeta
+
+func (s mockRollbackBlockStore) Height() int64 {
+	return int64(len(s))
+}
+
+func (s mockRollbackBlockStore) LoadBlockMeta(height int64) *tmtypes.BlockMeta {
+	return s[height]
+}
+
+// setupRollbackState saves the states of the blocks up to the given height,
+// and returns the metas of these blocks.
+func setupRollbackState(stateDB dbm.DB, height int64) mockRollbackBlockStore {
+	vals, _ := tmtypes.RandValidatorSet(1, 10)
+	params := *tmtypes.DefaultConsensusParams()
+	blockStore := make(mockRollbackBlockStore)
+
+	for h := int64(0); h <= height; h++ {
+		state := sm.State{
+			ChainID:                          "test-chain",
+			LastBlockHeight:                  h,
+			NextValidators:                   vals,
+			Validators:                       vals,
+			LastValidators:                   vals,
+			LastHeightValidatorsChanged:      1,
+			ConsensusParams:                  params,
+			LastHeightConsensusParamsChanged: 1,
+			LastResultsHash:                  []byte(fmt.Sprintf("results hash %d", h)),
+			AppHash:                          []byte(fmt.Sprintf("app hash %d", h)),
+		}
+
+		if h > 0 {
+			header := tmtypes.Header{
+				ChainID:         state.ChainID,
+				Height:          h,
+				LastResultsHash: []byte(fmt.Sprintf("results hash %d", h-1)),
+				AppHash:         []byte(fmt.Sprintf("app hash %d", h-1)),
+			}
+			blockStore[h] = &tmtypes.BlockMeta{
+				BlockID: tmtypes.BlockID{Hash: header.Hash()},
+				Header:  header,
+			}
+
+			state.LastBlockID = blockStore[h].BlockID
+		}
+
+		sm.SaveState(stateDB, state)
+	}
+
+	return blockStore
+}
+
+func TestRollbackState(t *testing.T) {
+	stateDB := dbm.NewMemDB()
+	blockStore := setupRollbackState(stateDB, 10)
+	invalidState := sm.LoadState(stateDB)
+
+	height, hash, err := rollbackState(blockStore, stateDB)
+	require.NoError(t, err)
+	require.Equal(t, int64(9), height)
+
+	// the app hash of block 9 is in the header of block 10
+	require.Equal(t, []byte("app hash 9"), hash)
+
+	state := sm.LoadState(stateDB)
+	require.Equal(t, int64(9), state.LastBlockHeight)
+	require.Equal(t, blockStore[9].BlockID, state.LastBlockID)
+	require.Equal(t, []byte("app hash 9"), state.AppHash)
+	require.Equal(t, []byte("results hash 9"), state.LastResultsHash)
+	require.Equal(t, invalidState.Validators.Hash(), state.NextValidators.Hash())
+	require.Equal(t, invalidState.ConsensusParams, state.ConsensusParams)
+
+	// the state is one height below the block store
+	height, hash, err = rollbackState(blockStore, stateDB)
+	require.NoError(t, err)
+	require.Equal(t, int64(9), height)
+	require.Equal(t, []byte("app hash 9"), hash)
+	require.Equal(t, state.Bytes(), sm.LoadState(stateDB).Bytes())
+
+	// the state is two heights below the block store
+	blockStore[11] = blockStore[10]
+	_, _, err = rollbackState(blockStore, stateDB)
+	require.Error(t, err)
+
+	// there is no state
+	_, _, err = rollbackState(blockStore, dbm.NewMemDB())
+	require.Error(t, err)
+}
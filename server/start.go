@@ -17,13 +17,16 @@ import (
 	pvm "github.com/tendermint/tendermint/privval"
 	"github.com/tendermint/tendermint/proxy"
 	"github.com/tendermint/tendermint/rpc/client/local"
+	"google.golang.org/grpc"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/server/api"
 	"github.com/cosmos/cosmos-sdk/server/config"
+	servergrpc "github.com/cosmos/cosmos-sdk/server/grpc"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 )
 
 // Tendermint full-node start flags
@@ -40,10 +43,32 @@ const (
 	FlagTrace              = "trace"
 	FlagInvCheckPeriod     = "inv-check-period"
 
+	FlagParallelDeliverTx        = "parallel-deliver-tx"
+	FlagParallelDeliverTxWorkers = "parallel-deliver-tx-workers"
+
+	FlagQueryGasLimit = "query-gas-limit"
+	FlagQueryTimeout  = "query-timeout"
+
+	FlagDebugDeliverTx = "debug-deliver-tx"
+
 	FlagPruning           = "pruning"
 	FlagPruningKeepRecent = "pruning-keep-recent"
 	FlagPruningKeepEvery  = "pruning-keep-every"
 	FlagPruningInterval   = "pruning-interval"
+
+	// state sync-related flags
+	FlagStateSyncSnapshotInterval   = "state-sync.snapshot-interval"
+	FlagStateSyncSnapshotKeepRecent = "state-sync.snapshot-keep-recent"
+
+	// streaming-related flags
+	FlagStreamingKeys       = "streaming.keys"
+	FlagStreamingWriteDir   = "streaming.write-dir"
+	FlagStreamingFilePrefix = "streaming.file-prefix"
+
+	// tracing-related flags
+	FlagTracing         = "telemetry.enable-tracing"
+	FlagTracingExporter = "telemetry.tracing-exporter"
+	FlagTracingFile     = "telemetry.tracing-file"
 )
 
 // StartCmd runs the service passed in, either stand-alone or in-process with
@@ -117,6 +142,22 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Uint64(FlagPruningKeepEvery, 0, "Offset heights to keep on disk after 'keep-every' (ignored if pruning is not 'custom')")
 	cmd.Flags().Uint64(FlagPruningInterval, 0, "Height interval at which pruned heights are removed from disk (ignored if pruning is not 'custom')")
 	cmd.Flags().Uint(FlagInvCheckPeriod, 0, "Assert registered invariants every N blocks")
+	cmd.Flags().Bool(FlagParallelDeliverTx, false, "Execute the txs of each block speculatively in parallel, re-executing conflicting txs in order")
+	cmd.Flags().Uint(FlagParallelDeliverTxWorkers, 0, "Number of txs executed concurrently when parallel-deliver-tx is enabled (0 for one per CPU)")
+	cmd.Flags().Uint64(FlagQueryGasLimit, 0, "Maximum gas a custom or gRPC query can consume (0 for no limit)")
+	cmd.Flags().Duration(FlagQueryTimeout, 0, "Maximum duration of a custom or gRPC query (0 for no limit)")
+	cmd.Flags().Bool(FlagDebugDeliverTx, false, "Report the gas consumption by descriptor and the result of each message of the delivered txs in their response info")
+
+	cmd.Flags().Uint64(FlagStateSyncSnapshotInterval, 0, "Block interval at which state sync snapshots are taken (0 to disable)")
+	cmd.Flags().Uint32(FlagStateSyncSnapshotKeepRecent, 2, "Number of recent state sync snapshots to keep (0 to keep all)")
+
+	cmd.Flags().StringSlice(FlagStreamingKeys, []string{}, "Store keys whose state changes are streamed to files along with the ABCI messages of each block ('*' for all stores)")
+	cmd.Flags().String(FlagStreamingWriteDir, "", "Directory the streaming files are written to (defaults to data/streaming in the home directory)")
+	cmd.Flags().String(FlagStreamingFilePrefix, "", "Prefix of the streaming file names")
+
+	cmd.Flags().Bool(FlagTracing, false, "Trace the blocks, txs and queries, exporting their spans")
+	cmd.Flags().String(FlagTracingExporter, telemetry.SpanExporterStdout, "Exporter of the spans, 'stdout', 'file' or one registered by the application")
+	cmd.Flags().String(FlagTracingFile, "", "File the 'file' exporter appends the spans to (defaults to data/spans.json in the home directory)")
 
 	// add support for all Tendermint-specific command line options
 	tcmd.AddNodeFlags(cmd)
@@ -156,6 +197,10 @@ func startStandAlone(ctx *Context, appCreator AppCreator) error {
 		if err = svr.Stop(); err != nil {
 			tmos.Exit(err.Error())
 		}
+
+		if err = app.Close(); err != nil {
+			tmos.Exit(err.Error())
+		}
 	})
 
 	// run forever (the node will not be returned)
@@ -199,6 +244,11 @@ func startInProcess(ctx *Context, cdc codec.JSONMarshaler, appCreator AppCreator
 		return err
 	}
 
+	// provide the blocks' txs to apps executing them in parallel
+	if txsApp, ok := app.(blockTxsApp); ok {
+		txsApp.SetBlockTxsProvider(newBlockTxsProvider(tmNode.BlockStore()))
+	}
+
 	if err := tmNode.Start(); err != nil {
 		return err
 	}
@@ -237,6 +287,14 @@ func startInProcess(ctx *Context, cdc codec.JSONMarshaler, appCreator AppCreator
 		}
 	}
 
+	var grpcSrv *grpc.Server
+	if config.GRPC.Enable {
+		grpcSrv, err = servergrpc.StartGRPCServer(app, config.GRPC)
+		if err != nil {
+			return err
+		}
+	}
+
 	var cpuProfileCleanup func()
 
 	if cpuProfile := ctx.Viper.GetString(flagCPUProfile); cpuProfile != "" {
@@ -270,6 +328,14 @@ func startInProcess(ctx *Context, cdc codec.JSONMarshaler, appCreator AppCreator
 			_ = apiSrv.Close()
 		}
 
+		if grpcSrv != nil {
+			grpcSrv.Stop()
+		}
+
+		if err := app.Close(); err != nil {
+			ctx.Logger.Error("failed to close the application", "err", err)
+		}
+
 		ctx.Logger.Info("exiting...")
 	})
 
@@ -0,0 +1,226 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	tmkv "github.com/tendermint/tendermint/libs/kv"
+
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// simulationApp is implemented by the apps registering the simulation
+// StoreDecoders of their modules.
+type simulationApp interface {
+	SimulationManager() *module.SimulationManager
+}
+
+// StateDiffCmd returns a command printing the keys of the IAVL stores which
+// were added, removed or changed between two versions of the app state.
+func StateDiffCmd(appCreator AppCreator) *cobra.Command {
+	return &cobra.Command{
+		Use:   "state-diff [height-a] [height-b]",
+		Short: "Print the keys of each store which changed between two heights",
+		Long: `Print the keys of each IAVL store which were added, removed or changed between
+the versions of the app state at the two given heights. Values are decoded with
+the simulation StoreDecoder of the store's module where one exists, and printed
+in hex otherwise.
+
+The application database is opened read-only, but the node must be stopped.
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := GetServerContextFromCmd(cmd)
+
+			heightA, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			heightB, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			db, err := openReadOnlyDB(serverCtx.Config.RootDir)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			app := appCreator(serverCtx.Logger, db, nil, offlineAppOptions(serverCtx.Viper))
+
+			cms, ok := app.CommitMultiStore().(*rootmulti.Store)
+			if !ok {
+				return fmt.Errorf("unexpected multi-store type %T", app.CommitMultiStore())
+			}
+
+			var decoders sdk.StoreDecoderRegistry
+			if simApp, ok := app.(simulationApp); ok && simApp.SimulationManager() != nil {
+				decoders = simApp.SimulationManager().StoreDecoders
+			}
+
+			diffs, err := diffStoreVersions(cms, heightA, heightB)
+			if err != nil {
+				return err
+			}
+
+			printStateDiff(cmd.OutOrStdout(), diffs, decoders)
+
+			return nil
+		},
+	}
+}
+
+// storeDiff holds the key-value pairs of a store which differ between two
+// versions.
+type storeDiff struct {
+	name string
+
+	added   []tmkv.Pair
+	removed []tmkv.Pair
+
+	// pairs whose value changed, before and after the change
+	changedA []tmkv.Pair
+	changedB []tmkv.Pair
+}
+
+// diffStoreVersions returns the differences between the given versions of
+// each IAVL store of the multi-store which changed, sorted by store name.
+func diffStoreVersions(cms *rootmulti.Store, versionA, versionB int64) ([]storeDiff, error) {
+	keys := cms.StoreKeysByName()
+
+	names := make([]string, 0, len(keys))
+	for name := range keys {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	var diffs []storeDiff
+
+	for _, name := range names {
+		store, ok := cms.GetCommitKVStore(keys[name]).(*iavl.Store)
+		if !ok {
+			continue
+		}
+
+		storeA, err := store.GetImmutable(versionA)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load version %d of store %s: %w", versionA, name, err)
+		}
+
+		storeB, err := store.GetImmutable(versionB)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load version %d of store %s: %w", versionB, name, err)
+		}
+
+		diff := diffKVStores(storeA, storeB)
+		if len(diff.added) > 0 || len(diff.removed) > 0 || len(diff.changedA) > 0 {
+			diff.name = name
+			diffs = append(diffs, diff)
+		}
+	}
+
+	return diffs, nil
+}
+
+// diffKVStores iterates over both stores in order and returns the pairs of b
+// whose key isn't in a, the pairs of a whose key isn't in b, and the pairs
+// whose value differs.
+func diffKVStores(a, b storetypes.KVStore) storeDiff {
+	iterA := a.Iterator(nil, nil)
+	defer iterA.Close()
+
+	iterB := b.Iterator(nil, nil)
+	defer iterB.Close()
+
+	var diff storeDiff
+
+	for iterA.Valid() || iterB.Valid() {
+		var cmp int
+
+		switch {
+		case !iterA.Valid():
+			cmp = 1
+		case !iterB.Valid():
+			cmp = -1
+		default:
+			cmp = bytes.Compare(iterA.Key(), iterB.Key())
+		}
+
+		switch {
+		case cmp < 0:
+			diff.removed = append(diff.removed, tmkv.Pair{Key: iterA.Key(), Value: iterA.Value()})
+			iterA.Next()
+
+		case cmp > 0:
+			diff.added = append(diff.added, tmkv.Pair{Key: iterB.Key(), Value: iterB.Value()})
+			iterB.Next()
+
+		default:
+			if !bytes.Equal(iterA.Value(), iterB.Value()) {
+				diff.changedA = append(diff.changedA, tmkv.Pair{Key: iterA.Key(), Value: iterA.Value()})
+				diff.changedB = append(diff.changedB, tmkv.Pair{Key: iterB.Key(), Value: iterB.Value()})
+			}
+
+			iterA.Next()
+			iterB.Next()
+		}
+	}
+
+	return diff
+}
+
+// printStateDiff prints the differences of each store, decoding the values
+// with the store's decoder if any. The missing value of an added or removed
+// pair is passed to the decoder as an empty value.
+func printStateDiff(w io.Writer, diffs []storeDiff, decoders sdk.StoreDecoderRegistry) {
+	for _, diff := range diffs {
+		decoder := decoders[diff.name]
+
+		fmt.Fprintf(w, "Store %s: %d added, %d removed, %d changed\n",
+			diff.name, len(diff.added), len(diff.removed), len(diff.changedA))
+
+		for _, kv := range diff.added {
+			fmt.Fprintf(w, "added %X\n%s\n", kv.Key, decodeKVPairs(decoder, tmkv.Pair{Key: kv.Key}, kv))
+		}
+
+		for _, kv := range diff.removed {
+			fmt.Fprintf(w, "removed %X\n%s\n", kv.Key, decodeKVPairs(decoder, kv, tmkv.Pair{Key: kv.Key}))
+		}
+
+		for i := range diff.changedA {
+			fmt.Fprintf(w, "changed %X\n%s\n", diff.changedA[i].Key, decodeKVPairs(decoder, diff.changedA[i], diff.changedB[i]))
+		}
+
+		fmt.Fprintln(w)
+	}
+}
+
+// decodeKVPairs returns the values of two pairs decoded with the given
+// decoder, or in hex if there is no decoder or it fails to decode them.
+func decodeKVPairs(decoder func(kvA, kvB tmkv.Pair) string, kvA, kvB tmkv.Pair) (decoded string) {
+	hexValues := fmt.Sprintf("%X\n%X", kvA.Value, kvB.Value)
+
+	if decoder == nil {
+		return hexValues
+	}
+
+	// decoders panic on unexpected keys or values
+	defer func() {
+		if r := recover(); r != nil {
+			decoded = hexValues
+		}
+	}()
+
+	return decoder(kvA, kvB)
+}
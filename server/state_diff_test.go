@@ -0,0 +1,174 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmkv "github.com/tendermint/tendermint/libs/kv"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestDiffStoreVersions(t *testing.T) {
+	cms := rootmulti.NewStore(dbm.NewMemDB())
+
+	bankKey := sdk.NewKVStoreKey("bank")
+	mainKey := sdk.NewKVStoreKey("main")
+	stakingKey := sdk.NewKVStoreKey("staking")
+	transientKey := sdk.NewTransientStoreKey("transient")
+
+	cms.MountStoreWithDB(bankKey, storetypes.StoreTypeIAVL, nil)
+	cms.MountStoreWithDB(mainKey, storetypes.StoreTypeIAVL, nil)
+	cms.MountStoreWithDB(stakingKey, storetypes.StoreTypeIAVL, nil)
+	cms.MountStoreWithDB(transientKey, storetypes.StoreTypeTransient, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+
+	// version 1
+	cms.GetKVStore(mainKey).Set([]byte("a"), []byte("1"))
+	cms.GetKVStore(mainKey).Set([]byte("b"), []byte("2"))
+	cms.GetKVStore(mainKey).Set([]byte("c"), []byte("3"))
+	cms.GetKVStore(stakingKey).Set([]byte("x"), []byte("1"))
+	cms.Commit()
+
+	// version 2
+	cms.GetKVStore(mainKey).Delete([]byte("a"))
+	cms.GetKVStore(mainKey).Set([]byte("b"), []byte("20"))
+	cms.GetKVStore(mainKey).Set([]byte("d"), []byte("4"))
+	cms.GetKVStore(bankKey).Set([]byte("y"), []byte("1"))
+	cms.GetKVStore(transientKey).Set([]byte("t"), []byte("1"))
+	cms.Commit()
+
+	diffs, err := diffStoreVersions(cms, 1, 2)
+	require.NoError(t, err)
+
+	// the unchanged and non-IAVL stores are left out
+	require.Equal(t, []storeDiff{
+		{
+			name:  "bank",
+			added: []tmkv.Pair{{Key: []byte("y"), Value: []byte("1")}},
+		},
+		{
+			name:     "main",
+			added:    []tmkv.Pair{{Key: []byte("d"), Value: []byte("4")}},
+			removed:  []tmkv.Pair{{Key: []byte("a"), Value: []byte("1")}},
+			changedA: []tmkv.Pair{{Key: []byte("b"), Value: []byte("2")}},
+			changedB: []tmkv.Pair{{Key: []byte("b"), Value: []byte("20")}},
+		},
+	}, diffs)
+
+	// the versions can be given in any order
+	diffs, err = diffStoreVersions(cms, 2, 1)
+	require.NoError(t, err)
+	require.Len(t, diffs, 2)
+	require.Equal(t, []tmkv.Pair{{Key: []byte("y"), Value: []byte("1")}}, diffs[0].removed)
+
+	// the versions must exist
+	_, err = diffStoreVersions(cms, 1, 3)
+	require.Error(t, err)
+}
+
+func TestPrintStateDiff(t *testing.T) {
+	diffs := []storeDiff{
+		{
+			name:  "bank",
+			added: []tmkv.Pair{{Key: []byte("y"), Value: []byte("1")}},
+		},
+		{
+			name:     "main",
+			removed:  []tmkv.Pair{{Key: []byte("a"), Value: []byte("1")}},
+			changedA: []tmkv.Pair{{Key: []byte("b"), Value: []byte("2")}},
+			changedB: []tmkv.Pair{{Key: []byte("b"), Value: []byte("20")}},
+		},
+	}
+
+	decoders := sdk.StoreDecoderRegistry{
+		"main": func(kvA, kvB tmkv.Pair) string {
+			if bytes.Equal(kvA.Key, []byte("a")) {
+				panic("invalid key")
+			}
+
+			return fmt.Sprintf("%s\n%s", kvA.Value, kvB.Value)
+		},
+	}
+
+	out := new(bytes.Buffer)
+	printStateDiff(out, diffs, decoders)
+
+	expected := fmt.Sprintf(`Store bank: 1 added, 0 removed, 0 changed
+added %X
+
+%X
+
+Store main: 0 added, 1 removed, 1 changed
+removed %X
+%X
+
+changed %X
+2
+20
+
+`, "y", "1", "a", "1", "b")
+
+	require.Equal(t, expected, out.String())
+}
+
+func TestStateDiffCmd(t *testing.T) {
+	home, err := ioutil.TempDir("", "state-diff")
+	require.NoError(t, err)
+	defer os.RemoveAll(home)
+
+	var appOpts []AppOptions
+	appCreator := replayTestAppCreator(&appOpts)
+
+	// commit blocks to the application database of the node
+	db, err := openDB(home)
+	require.NoError(t, err)
+
+	app := appCreator(log.NewNopLogger(), db, nil, viper.New()).(replayTestApp)
+	app.InitChain(abci.RequestInitChain{AppStateBytes: []byte(`{"values": []}`)})
+
+	for h := int64(1); h <= 2; h++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: h}})
+		res := app.DeliverTx(abci.RequestDeliverTx{Tx: []byte(fmt.Sprintf("key%d=value%d", h, h))})
+		require.True(t, res.IsOK(), res.Log)
+		app.EndBlock(abci.RequestEndBlock{Height: h})
+		app.Commit()
+	}
+
+	require.NoError(t, db.Close())
+
+	serverCtx := NewDefaultContext()
+	serverCtx.Config.SetRoot(home)
+	serverCtx.Viper.Set(FlagStateSyncSnapshotInterval, 10)
+	serverCtx.Viper.Set(FlagStreamingKeys, []string{"main"})
+	serverCtx.Viper.Set(FlagTracing, true)
+	serverCtx.Viper.Set(FlagParallelDeliverTx, true)
+
+	appOpts = nil
+
+	cmd := StateDiffCmd(appCreator)
+	output := new(bytes.Buffer)
+	cmd.SetOut(output)
+	cmd.SetArgs([]string{"1", "2"})
+	require.NoError(t, cmd.ExecuteContext(context.WithValue(context.Background(), ServerContextKey, serverCtx)))
+
+	require.Equal(t, fmt.Sprintf("Store main: 1 added, 0 removed, 0 changed\nadded %X\n\n%X\n\n", "key2", "value2"), output.String())
+
+	// the app reading the database doesn't write to the files of the node
+	require.Len(t, appOpts, 1)
+	require.Equal(t, 0, appOpts[0].Get(FlagStateSyncSnapshotInterval))
+	require.Empty(t, appOpts[0].Get(FlagStreamingKeys))
+	require.Equal(t, false, appOpts[0].Get(FlagTracing))
+	require.Equal(t, false, appOpts[0].Get(FlagParallelDeliverTx))
+}
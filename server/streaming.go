@@ -0,0 +1,28 @@
+package server
+
+import (
+	"path/filepath"
+
+	"github.com/spf13/cast"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/store/streaming/file"
+)
+
+// GetStreamingServiceFromFlags parses command flags and returns the file
+// StreamingService they configure. It returns nil if no store key is
+// configured, i.e. if streaming is disabled.
+func GetStreamingServiceFromFlags(appOpts AppOptions) (baseapp.StreamingService, error) {
+	keys := cast.ToStringSlice(appOpts.Get(FlagStreamingKeys))
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	writeDir := cast.ToString(appOpts.Get(FlagStreamingWriteDir))
+	if writeDir == "" {
+		writeDir = filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), "data", "streaming")
+	}
+
+	return file.NewStreamingService(writeDir, cast.ToString(appOpts.Get(FlagStreamingFilePrefix)), keys)
+}
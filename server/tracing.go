@@ -0,0 +1,26 @@
+package server
+
+import (
+	"path/filepath"
+
+	"github.com/spf13/cast"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// GetSpanTracerFromFlags parses command flags and returns the Tracer they
+// configure. It returns nil if tracing is disabled.
+func GetSpanTracerFromFlags(appOpts AppOptions) (*telemetry.Tracer, error) {
+	cfg := telemetry.Config{
+		EnableTracing:   cast.ToBool(appOpts.Get(FlagTracing)),
+		TracingExporter: cast.ToString(appOpts.Get(FlagTracingExporter)),
+		TracingFile:     cast.ToString(appOpts.Get(FlagTracingFile)),
+	}
+
+	if cfg.TracingExporter == telemetry.SpanExporterFile && cfg.TracingFile == "" {
+		cfg.TracingFile = filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), "data", "spans.json")
+	}
+
+	return telemetry.NewTracerFromConfig(cfg)
+}
@@ -179,6 +179,8 @@ func AddCommands(rootCmd *cobra.Command, appCreator AppCreator, appExport AppExp
 	rootCmd.AddCommand(
 		StartCmd(appCreator),
 		UnsafeResetAllCmd(),
+		RollbackCmd(appCreator),
+		PruneCmd(appCreator),
 		flags.LineBreak,
 		tendermintCmd,
 		ExportCmd(appExport),
@@ -0,0 +1,158 @@
+package simapp
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// parallelStatsLogger is a Logger recording the number of txs whose
+// speculative result was applied by the parallel DeliverTx execution.
+type parallelStatsLogger struct {
+	log.Logger
+	applied int
+}
+
+func (l *parallelStatsLogger) Debug(msg string, keyvals ...interface{}) {
+	if msg != "parallel DeliverTx" {
+		return
+	}
+
+	for i := 0; i+1 < len(keyvals); i += 2 {
+		if keyvals[i] == "applied" {
+			l.applied += keyvals[i+1].(int)
+		}
+	}
+}
+
+func (l *parallelStatsLogger) With(...interface{}) log.Logger {
+	return l
+}
+
+// initParallelTestApp initializes the chain of an app with the given genesis
+// accounts and balances.
+func initParallelTestApp(t *testing.T, app *SimApp, genAccs []authtypes.GenesisAccount, balances []banktypes.Balance) {
+	genesisState := NewDefaultGenesisState()
+	genesisState[authtypes.ModuleName] = app.Codec().MustMarshalJSON(authtypes.NewGenesisState(authtypes.DefaultParams(), genAccs))
+
+	totalSupply := sdk.NewCoins()
+	for _, b := range balances {
+		totalSupply = totalSupply.Add(b.Coins...)
+	}
+
+	bankGenesis := banktypes.NewGenesisState(banktypes.DefaultGenesisState().Params, balances, totalSupply)
+	genesisState[banktypes.ModuleName] = app.Codec().MustMarshalJSON(bankGenesis)
+
+	stateBytes, err := codec.MarshalJSONIndent(app.Codec(), genesisState)
+	require.NoError(t, err)
+
+	// the blocks have no gas limit
+	consensusParams := *DefaultConsensusParams
+	consensusParams.Block = &abci.BlockParams{MaxBytes: DefaultConsensusParams.Block.MaxBytes, MaxGas: -1}
+
+	app.InitChain(abci.RequestInitChain{
+		Validators:      []abci.ValidatorUpdate{},
+		ConsensusParams: &consensusParams,
+		AppStateBytes:   stateBytes,
+	})
+	app.Commit()
+}
+
+func TestParallelDeliverTxDeterminism(t *testing.T) {
+	const (
+		senders = 40
+		height  = 3
+	)
+
+	txGen := MakeEncodingConfig().TxGenerator
+	fee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+
+	// each sender sends coins to its own recipient, paying fees, so that its
+	// txs are independent of the others
+	var (
+		privs    []crypto.PrivKey
+		genAccs  []authtypes.GenesisAccount
+		balances []banktypes.Balance
+	)
+
+	for i := 0; i < 2*senders; i++ {
+		priv := secp256k1.GenPrivKey()
+		addr := sdk.AccAddress(priv.PubKey().Address())
+
+		privs = append(privs, priv)
+		genAccs = append(genAccs, authtypes.NewBaseAccount(addr, nil, uint64(i), 0))
+		balances = append(balances, banktypes.Balance{
+			Address: addr,
+			Coins:   sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000)),
+		})
+	}
+
+	blocks := make([][][]byte, height)
+
+	for h := range blocks {
+		for i := 0; i < senders; i++ {
+			from := sdk.AccAddress(privs[i].PubKey().Address())
+			to := sdk.AccAddress(privs[senders+i].PubKey().Address())
+			msg := banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(100+h))))
+
+			tx, err := helpers.GenTx(txGen, []sdk.Msg{msg}, fee, helpers.DefaultGenTxGas, "", []uint64{uint64(i)}, []uint64{uint64(h)}, privs[i])
+			require.NoError(t, err)
+
+			txBytes, err := txGen.TxEncoder()(tx)
+			require.NoError(t, err)
+
+			blocks[h] = append(blocks[h], txBytes)
+		}
+	}
+
+	logger := &parallelStatsLogger{Logger: log.NewNopLogger()}
+
+	serialApp := NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome, 1)
+	parallelApp := NewSimApp(logger, dbm.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome, 1, baseapp.SetParallelDeliverTx(4))
+	parallelApp.SetBlockTxsProvider(func(h int64, _ []byte) ([][]byte, bool) {
+		return blocks[h-2], true
+	})
+
+	initParallelTestApp(t, serialApp, genAccs, balances)
+	initParallelTestApp(t, parallelApp, genAccs, balances)
+
+	for i, txs := range blocks {
+		header := abci.Header{Height: int64(i) + 2}
+		serialApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+		parallelApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+		for _, tx := range txs {
+			req := abci.RequestDeliverTx{Tx: tx}
+			res := serialApp.DeliverTx(req)
+			require.True(t, res.IsOK(), res.Log)
+			require.Equal(t, res, parallelApp.DeliverTx(req))
+		}
+
+		req := abci.RequestEndBlock{Height: header.Height}
+		require.Equal(t, serialApp.EndBlock(req), parallelApp.EndBlock(req))
+		require.Equal(t, serialApp.Commit().Data, parallelApp.Commit().Data, fmt.Sprintf("app hash mismatch at height %d", header.Height))
+	}
+
+	// the fees of the last block were credited to the fee collector
+	ctx := parallelApp.NewContext(true, abci.Header{})
+	feeCollector := parallelApp.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	require.False(t, parallelApp.BankKeeper.GetAllBalances(ctx, feeCollector).IsZero())
+	require.Empty(t, parallelApp.BankKeeper.GetAllDeferredCoins(ctx))
+
+	// the speculative results of all the txs were used, as they're
+	// independent
+	require.Equal(t, senders*height, logger.applied)
+}
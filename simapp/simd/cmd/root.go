@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"io"
 	"os"
+	"path/filepath"
 
 	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
@@ -23,6 +24,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/store/snapshots"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
 	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
@@ -72,6 +74,12 @@ func Execute() error {
 func init() {
 	authclient.Codec = encodingConfig.Marshaler
 
+	debugCmd := debug.Cmd()
+	debugCmd.AddCommand(
+		server.ReplayCmd(newApp),
+		server.StateDiffCmd(newApp),
+	)
+
 	rootCmd.AddCommand(
 		genutilcli.InitCmd(simapp.ModuleBasics, simapp.DefaultNodeHome),
 		genutilcli.CollectGenTxsCmd(banktypes.GenesisBalancesIterator{}),
@@ -81,7 +89,7 @@ func init() {
 		AddGenesisAccountCmd(),
 		cli.NewCompletionCmd(rootCmd, true),
 		testnetCmd(simapp.ModuleBasics, banktypes.GenesisBalancesIterator{}),
-		debug.Cmd(),
+		debugCmd,
 	)
 
 	server.AddCommands(rootCmd, newApp, exportAppStateAndTMValidators)
@@ -163,16 +171,57 @@ func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts server.A
 		panic(err)
 	}
 
-	return simapp.NewSimApp(
-		logger, db, traceStore, true, skipUpgradeHeights,
-		cast.ToString(appOpts.Get(flags.FlagHome)),
-		cast.ToUint(appOpts.Get(server.FlagInvCheckPeriod)),
+	snapshotDir := filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), "data", "snapshots")
+	snapshotDB, err := sdk.NewLevelDB("metadata", snapshotDir)
+	if err != nil {
+		panic(err)
+	}
+	snapshotStore, err := snapshots.NewStore(snapshotDB, snapshotDir)
+	if err != nil {
+		panic(err)
+	}
+
+	baseappOptions := []func(*baseapp.BaseApp){
 		baseapp.SetPruning(pruningOpts),
 		baseapp.SetMinGasPrices(cast.ToString(appOpts.Get(server.FlagMinGasPrices))),
 		baseapp.SetHaltHeight(cast.ToUint64(appOpts.Get(server.FlagHaltHeight))),
 		baseapp.SetHaltTime(cast.ToUint64(appOpts.Get(server.FlagHaltTime))),
+		baseapp.SetQueryGasLimit(cast.ToUint64(appOpts.Get(server.FlagQueryGasLimit))),
+		baseapp.SetQueryTimeout(cast.ToDuration(appOpts.Get(server.FlagQueryTimeout))),
+		baseapp.SetDebugDeliverTx(cast.ToBool(appOpts.Get(server.FlagDebugDeliverTx))),
 		baseapp.SetInterBlockCache(cache),
 		baseapp.SetTrace(cast.ToBool(appOpts.Get(server.FlagTrace))),
+		baseapp.SetSnapshotStore(snapshotStore),
+		baseapp.SetSnapshotInterval(cast.ToUint64(appOpts.Get(server.FlagStateSyncSnapshotInterval))),
+		baseapp.SetSnapshotKeepRecent(cast.ToUint32(appOpts.Get(server.FlagStateSyncSnapshotKeepRecent))),
+	}
+
+	streamingService, err := server.GetStreamingServiceFromFlags(appOpts)
+	if err != nil {
+		panic(err)
+	}
+	if streamingService != nil {
+		baseappOptions = append(baseappOptions, baseapp.SetStreamingService(streamingService))
+	}
+
+	spanTracer, err := server.GetSpanTracerFromFlags(appOpts)
+	if err != nil {
+		panic(err)
+	}
+	if spanTracer != nil {
+		baseappOptions = append(baseappOptions, baseapp.SetSpanTracer(spanTracer))
+	}
+
+	if cast.ToBool(appOpts.Get(server.FlagParallelDeliverTx)) {
+		workers := cast.ToInt(appOpts.Get(server.FlagParallelDeliverTxWorkers))
+		baseappOptions = append(baseappOptions, baseapp.SetParallelDeliverTx(workers))
+	}
+
+	return simapp.NewSimApp(
+		logger, db, traceStore, true, skipUpgradeHeights,
+		cast.ToString(appOpts.Get(flags.FlagHome)),
+		cast.ToUint(appOpts.Get(server.FlagInvCheckPeriod)),
+		baseappOptions...,
 	)
 }
 
@@ -1,13 +1,29 @@
 package cachekv
 
 import (
-	"container/list"
+	"bytes"
 	"errors"
 
+	"github.com/google/btree"
 	tmkv "github.com/tendermint/tendermint/libs/kv"
-	dbm "github.com/tendermint/tm-db"
 )
 
+// item is a key/value pair of the sorted cache, ordered by key.
+type item struct {
+	tmkv.Pair
+}
+
+var _ btree.Item = (*item)(nil)
+
+func newItem(key, value []byte) *item {
+	return &item{tmkv.Pair{Key: key, Value: value}}
+}
+
+// Less implements btree.Item.
+func (i *item) Less(other btree.Item) bool {
+	return bytes.Compare(i.Key, other.(*item).Key) < 0
+}
+
 // Iterates over iterKVCache items.
 // if key is nil, means it was deleted.
 // Implements Iterator.
@@ -17,23 +33,23 @@ type memIterator struct {
 	ascending  bool
 }
 
-func newMemIterator(start, end []byte, items *list.List, ascending bool) *memIterator {
+func newMemIterator(start, end []byte, items *btree.BTree, ascending bool) *memIterator {
 	itemsInDomain := make([]*tmkv.Pair, 0)
 
-	var entered bool
-
-	for e := items.Front(); e != nil; e = e.Next() {
-		item := e.Value.(*tmkv.Pair)
-		if !dbm.IsKeyInDomain(item.Key, start, end) {
-			if entered {
-				break
-			}
-
-			continue
-		}
+	appendItem := func(i btree.Item) bool {
+		itemsInDomain = append(itemsInDomain, &i.(*item).Pair)
+		return true
+	}
 
-		itemsInDomain = append(itemsInDomain, item)
-		entered = true
+	switch {
+	case start != nil && end != nil:
+		items.AscendRange(newItem(start, nil), newItem(end, nil), appendItem)
+	case start != nil:
+		items.AscendGreaterOrEqual(newItem(start, nil), appendItem)
+	case end != nil:
+		items.AscendLessThan(newItem(end, nil), appendItem)
+	default:
+		items.Ascend(appendItem)
 	}
 
 	return &memIterator{
@@ -1,14 +1,11 @@
 package cachekv
 
 import (
-	"bytes"
-	"container/list"
 	"io"
 	"sort"
 	"sync"
 
-	tmkv "github.com/tendermint/tendermint/libs/kv"
-	dbm "github.com/tendermint/tm-db"
+	"github.com/google/btree"
 
 	"github.com/cosmos/cosmos-sdk/store/tracekv"
 	"github.com/cosmos/cosmos-sdk/store/types"
@@ -23,12 +20,22 @@ type cValue struct {
 	dirty   bool
 }
 
+// bTreeDegree is the degree of the btree holding the sorted cache. It is the
+// same as the one used by tm-db's MemDB.
+const bTreeDegree = 32
+
 // Store wraps an in-memory cache around an underlying types.KVStore.
+//
+// Dirty keys are first recorded in unsortedCache, and are only inserted into
+// sortedCache, a btree ordered by key, by the next iterator. This keeps Set
+// cheap while making each iterator cost O(k log n + m), k being the number of
+// keys written since the previous iterator and m the number of cached items
+// in the iterated domain.
 type Store struct {
 	mtx           sync.Mutex
 	cache         map[string]*cValue
 	unsortedCache map[string]struct{}
-	sortedCache   *list.List // always ascending sorted
+	sortedCache   *btree.BTree // always ascending sorted
 	parent        types.KVStore
 }
 
@@ -38,7 +45,7 @@ func NewStore(parent types.KVStore) *Store {
 	return &Store{
 		cache:         make(map[string]*cValue),
 		unsortedCache: make(map[string]struct{}),
-		sortedCache:   list.New(),
+		sortedCache:   btree.New(bTreeDegree),
 		parent:        parent,
 	}
 }
@@ -131,7 +138,7 @@ func (store *Store) Write() {
 	// Clear the cache
 	store.cache = make(map[string]*cValue)
 	store.unsortedCache = make(map[string]struct{})
-	store.sortedCache = list.New()
+	store.sortedCache = btree.New(bTreeDegree)
 }
 
 //----------------------------------------
@@ -172,52 +179,20 @@ func (store *Store) iterator(start, end []byte, ascending bool) types.Iterator {
 		parent = store.parent.ReverseIterator(start, end)
 	}
 
-	store.dirtyItems(start, end)
+	store.dirtyItems()
 	cache = newMemIterator(start, end, store.sortedCache, ascending)
 
 	return newCacheMergeIterator(parent, cache, ascending)
 }
 
-// Constructs a slice of dirty items, to use w/ memIterator.
-func (store *Store) dirtyItems(start, end []byte) {
-	unsorted := make([]*tmkv.Pair, 0)
-
+// dirtyItems inserts the keys written since the last call into the sorted
+// cache, replacing the items of keys which were already sorted.
+func (store *Store) dirtyItems() {
 	for key := range store.unsortedCache {
-		cacheValue := store.cache[key]
-
-		if dbm.IsKeyInDomain([]byte(key), start, end) {
-			unsorted = append(unsorted, &tmkv.Pair{Key: []byte(key), Value: cacheValue.value})
-
-			delete(store.unsortedCache, key)
-		}
+		store.sortedCache.ReplaceOrInsert(newItem([]byte(key), store.cache[key].value))
 	}
 
-	sort.Slice(unsorted, func(i, j int) bool {
-		return bytes.Compare(unsorted[i].Key, unsorted[j].Key) < 0
-	})
-
-	for e := store.sortedCache.Front(); e != nil && len(unsorted) != 0; {
-		uitem := unsorted[0]
-		sitem := e.Value.(*tmkv.Pair)
-		comp := bytes.Compare(uitem.Key, sitem.Key)
-
-		switch comp {
-		case -1:
-			unsorted = unsorted[1:]
-
-			store.sortedCache.InsertBefore(uitem, e)
-		case 1:
-			e = e.Next()
-		case 0:
-			unsorted = unsorted[1:]
-			e.Value = uitem
-			e = e.Next()
-		}
-	}
-
-	for _, kvp := range unsorted {
-		store.sortedCache.PushBack(kvp)
-	}
+	store.unsortedCache = make(map[string]struct{})
 }
 
 //----------------------------------------
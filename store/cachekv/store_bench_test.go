@@ -9,6 +9,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/store/cachekv"
 	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/types"
 )
 
 func benchmarkCacheKVStoreIterator(numKVs int, b *testing.B) {
@@ -44,3 +45,72 @@ func BenchmarkCacheKVStoreIterator1000(b *testing.B)   { benchmarkCacheKVStoreIt
 func BenchmarkCacheKVStoreIterator10000(b *testing.B)  { benchmarkCacheKVStoreIterator(10000, b) }
 func BenchmarkCacheKVStoreIterator50000(b *testing.B)  { benchmarkCacheKVStoreIterator(50000, b) }
 func BenchmarkCacheKVStoreIterator100000(b *testing.B) { benchmarkCacheKVStoreIterator(100000, b) }
+
+// benchmarkNestedIterator mimics EndBlockers iterating over a
+// queue and, for each entry, writing to and iterating over another prefix of
+// the same store.
+func benchmarkNestedIterator(numKVs int, b *testing.B) {
+	mem := dbadapter.Store{DB: dbm.NewMemDB()}
+	cstore := cachekv.NewStore(mem)
+	outerPrefix, innerPrefix := []byte{0x01}, []byte{0x02}
+	value := make([]byte, 32)
+
+	for i := 0; i < numKVs; i++ {
+		key := make([]byte, 32)
+		_, _ = rand.Read(key)
+
+		cstore.Set(append(outerPrefix, key...), value)
+	}
+
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		iter := cstore.Iterator(outerPrefix, types.PrefixEndBytes(outerPrefix))
+
+		for i := 0; iter.Valid(); iter.Next() {
+			// keep a bounded number of keys under the inner prefix
+			cstore.Set(append(innerPrefix, byte(i%16)), value)
+			i++
+
+			inner := cstore.Iterator(innerPrefix, types.PrefixEndBytes(innerPrefix))
+			inner.Close()
+		}
+
+		iter.Close()
+	}
+}
+
+func BenchmarkCacheKVStoreNestedIterator100(b *testing.B)  { benchmarkNestedIterator(100, b) }
+func BenchmarkCacheKVStoreNestedIterator1000(b *testing.B) { benchmarkNestedIterator(1000, b) }
+func BenchmarkCacheKVStoreNestedIterator5000(b *testing.B) { benchmarkNestedIterator(5000, b) }
+
+// benchmarkSetAndIterate interleaves writes with iterations over
+// a small range, so that every iterator has to sort freshly written keys into
+// the cache.
+func benchmarkSetAndIterate(numKVs int, b *testing.B) {
+	value := make([]byte, 32)
+	keys := make([][]byte, numKVs)
+
+	for i := 0; i < numKVs; i++ {
+		keys[i] = make([]byte, 32)
+		_, _ = rand.Read(keys[i])
+	}
+
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		mem := dbadapter.Store{DB: dbm.NewMemDB()}
+		cstore := cachekv.NewStore(mem)
+
+		for _, key := range keys {
+			cstore.Set(key, value)
+
+			iter := cstore.Iterator(key, types.PrefixEndBytes(key))
+			iter.Close()
+		}
+	}
+}
+
+func BenchmarkCacheKVStoreSetAndIterate100(b *testing.B)  { benchmarkSetAndIterate(100, b) }
+func BenchmarkCacheKVStoreSetAndIterate1000(b *testing.B) { benchmarkSetAndIterate(1000, b) }
+func BenchmarkCacheKVStoreSetAndIterate5000(b *testing.B) { benchmarkSetAndIterate(5000, b) }
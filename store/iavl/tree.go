@@ -24,9 +24,11 @@ type (
 		SaveVersion() ([]byte, int64, error)
 		DeleteVersion(version int64) error
 		DeleteVersions(versions ...int64) error
+		LoadVersionForOverwriting(targetVersion int64) (int64, error)
 		Version() int64
 		Hash() []byte
 		VersionExists(version int64) bool
+		AvailableVersions() []int
 		GetVersioned(key []byte, version int64) (int64, []byte)
 		GetVersionedWithProof(key []byte, version int64) ([]byte, *iavl.RangeProof, error)
 		GetImmutable(version int64) (*iavl.ImmutableTree, error)
@@ -60,10 +62,18 @@ func (it *immutableTree) DeleteVersions(_ ...int64) error {
 	panic("cannot call 'DeleteVersions' on an immutable IAVL tree")
 }
 
+func (it *immutableTree) LoadVersionForOverwriting(_ int64) (int64, error) {
+	panic("cannot call 'LoadVersionForOverwriting' on an immutable IAVL tree")
+}
+
 func (it *immutableTree) VersionExists(version int64) bool {
 	return it.Version() == version
 }
 
+func (it *immutableTree) AvailableVersions() []int {
+	return []int{int(it.Version())}
+}
+
 func (it *immutableTree) GetVersioned(key []byte, version int64) (int64, []byte) {
 	if it.Version() != version {
 		return -1, nil
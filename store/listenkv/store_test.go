@@ -0,0 +1,107 @@
+package listenkv_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"testing"
+
+	protoio "github.com/gogo/protobuf/io"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/listenkv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func bz(s string) []byte { return []byte(s) }
+
+func keyFmt(i int) []byte { return bz(fmt.Sprintf("key%0.8d", i)) }
+func valFmt(i int) []byte { return bz(fmt.Sprintf("value%0.8d", i)) }
+
+var testStoreKey = types.NewKVStoreKey("listen_test")
+
+func newEmptyListenKVStore(buf *bytes.Buffer) *listenkv.Store {
+	memDB := dbadapter.Store{DB: dbm.NewMemDB()}
+	listener := types.NewStoreKVPairWriteListener(buf)
+
+	return listenkv.NewStore(memDB, testStoreKey, []types.WriteListener{listener})
+}
+
+func readKVPairs(t *testing.T, buf *bytes.Buffer) []types.StoreKVPair {
+	reader := protoio.NewDelimitedReader(buf, 1024)
+
+	var kvPairs []types.StoreKVPair
+	for {
+		var kvPair types.StoreKVPair
+		err := reader.ReadMsg(&kvPair)
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		kvPairs = append(kvPairs, kvPair)
+	}
+
+	return kvPairs
+}
+
+func TestListenKVStoreSetDelete(t *testing.T) {
+	var buf bytes.Buffer
+	store := newEmptyListenKVStore(&buf)
+
+	store.Set(keyFmt(1), valFmt(1))
+	store.Set(keyFmt(2), valFmt(2))
+	store.Delete(keyFmt(1))
+
+	require.Nil(t, store.Get(keyFmt(1)))
+	require.Equal(t, valFmt(2), store.Get(keyFmt(2)))
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: testStoreKey.Name(), Key: keyFmt(1), Value: valFmt(1)},
+		{StoreKey: testStoreKey.Name(), Key: keyFmt(2), Value: valFmt(2)},
+		{StoreKey: testStoreKey.Name(), Delete: true, Key: keyFmt(1)},
+	}, readKVPairs(t, &buf))
+}
+
+func TestListenKVStoreReads(t *testing.T) {
+	var buf bytes.Buffer
+	store := newEmptyListenKVStore(&buf)
+	store.Set(keyFmt(1), valFmt(1))
+	buf.Reset()
+
+	store.Get(keyFmt(1))
+	store.Has(keyFmt(1))
+
+	iter := store.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		iter.Key()
+		iter.Value()
+	}
+	iter.Close()
+
+	require.Zero(t, buf.Len(), "reads must not be notified to the listeners")
+}
+
+func TestListenKVStoreCacheWrap(t *testing.T) {
+	var buf bytes.Buffer
+	store := newEmptyListenKVStore(&buf)
+
+	cache := store.CacheWrap().(types.CacheKVStore)
+	cache.Set(keyFmt(1), valFmt(1))
+	cache.Set(keyFmt(2), valFmt(2))
+	cache.Delete(keyFmt(2))
+	require.Zero(t, buf.Len(), "cached writes must not be notified before they are written")
+
+	cache.Write()
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: testStoreKey.Name(), Key: keyFmt(1), Value: valFmt(1)},
+		{StoreKey: testStoreKey.Name(), Delete: true, Key: keyFmt(2)},
+	}, readKVPairs(t, &buf))
+}
+
+func TestListenKVStoreGetStoreType(t *testing.T) {
+	memDB := dbadapter.Store{DB: dbm.NewMemDB()}
+	store := listenkv.NewStore(memDB, testStoreKey, nil)
+	require.Equal(t, memDB.GetStoreType(), store.GetStoreType())
+}
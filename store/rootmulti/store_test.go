@@ -1,15 +1,20 @@
 package rootmulti
 
 import (
+	"encoding/binary"
+	"errors"
 	"fmt"
+	"math/rand"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/store/iavl"
 	sdkmaps "github.com/cosmos/cosmos-sdk/store/rootmulti/internal/maps"
+	snapshottypes "github.com/cosmos/cosmos-sdk/store/snapshots/types"
 	"github.com/cosmos/cosmos-sdk/store/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -518,6 +523,217 @@ func TestMultiStore_PruningRestart(t *testing.T) {
 	}
 }
 
+func TestMultiStore_PruneVersions(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, types.NewPruningOptions(0, 5, 11))
+	require.NoError(t, ms.LoadLatestVersion())
+
+	for i := int64(0); i < 10; i++ {
+		ms.Commit()
+	}
+
+	// the heights left to prune by the running options are not deleted yet
+	require.Equal(t, []int64{1, 2, 3, 4, 6, 7, 8, 9}, ms.pruneHeights)
+
+	// "restart" with the versions being deleted offline
+	ms = newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	pruned, err := ms.PruneVersions(types.NewPruningOptions(2, 3, 0))
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 2, 4, 5, 7}, pruned)
+
+	for _, v := range []int64{3, 6, 8, 9, 10} {
+		_, err := ms.CacheMultiStoreWithVersion(v)
+		require.NoError(t, err, "expected no error when loading height: %d", v)
+	}
+
+	for _, v := range pruned {
+		_, err := ms.CacheMultiStoreWithVersion(v)
+		require.Error(t, err, "expected error when loading height: %d", v)
+	}
+
+	// the heights left to prune which still exist are kept
+	ph, err := getPruningHeights(db)
+	require.NoError(t, err)
+	require.Equal(t, []int64{3, 6, 8, 9}, ph)
+	require.Equal(t, ph, ms.pruneHeights)
+
+	// pruning again deletes nothing
+	pruned, err = ms.PruneVersions(types.NewPruningOptions(2, 3, 0))
+	require.NoError(t, err)
+	require.Empty(t, pruned)
+}
+
+func TestMultiStore_Rollback(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	commitIDs := make([]types.CommitID, 5)
+	for i := range commitIDs {
+		ms.getStoreByName("store1").(types.KVStore).Set([]byte("key"), []byte(fmt.Sprintf("value%d", i+1)))
+		commitIDs[i] = ms.Commit()
+	}
+
+	require.Error(t, ms.RollbackToVersion(0))
+	require.Error(t, ms.RollbackToVersion(6))
+
+	require.NoError(t, ms.RollbackToVersion(3))
+	checkStore(t, ms, commitIDs[2], ms.LastCommitID())
+	require.Equal(t, []byte("value3"), ms.getStoreByName("store1").(types.KVStore).Get([]byte("key")))
+
+	_, err := getCommitInfo(db, 4)
+	require.Error(t, err)
+
+	// the rolled back versions are overwritten by the next commits
+	ms = newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, ms.LoadLatestVersion())
+	checkStore(t, ms, commitIDs[2], ms.LastCommitID())
+
+	ms.getStoreByName("store1").(types.KVStore).Set([]byte("key"), []byte("fixed"))
+	commitID := ms.Commit()
+	require.Equal(t, int64(4), commitID.Version)
+	require.NotEqual(t, commitIDs[3], commitID)
+
+	_, err = ms.CacheMultiStoreWithVersion(5)
+	require.Error(t, err)
+}
+
+func TestMultiStore_Listeners(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	listenedKey := ms.keysByName["store1"]
+	otherKey := ms.keysByName["store2"]
+	listener := &recordingListener{}
+	require.False(t, ms.ListeningEnabled(listenedKey))
+	ms.AddListeners(listenedKey, []types.WriteListener{listener})
+	require.True(t, ms.ListeningEnabled(listenedKey))
+	require.False(t, ms.ListeningEnabled(otherKey))
+
+	cacheMulti := ms.CacheMultiStore()
+	cacheMulti.GetKVStore(listenedKey).Set([]byte("key1"), []byte("value1"))
+	cacheMulti.GetKVStore(listenedKey).Delete([]byte("key2"))
+	cacheMulti.GetKVStore(otherKey).Set([]byte("key3"), []byte("value3"))
+	require.Empty(t, listener.kvPairs, "cached writes must not be notified before they are written")
+
+	cacheMulti.Write()
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: "store1", Key: []byte("key1"), Value: []byte("value1")},
+		{StoreKey: "store1", Delete: true, Key: []byte("key2")},
+	}, listener.kvPairs)
+
+	listener.kvPairs = nil
+	ms.GetKVStore(listenedKey).Set([]byte("key4"), []byte("value4"))
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: "store1", Key: []byte("key4"), Value: []byte("value4")},
+	}, listener.kvPairs)
+}
+
+type recordingListener struct {
+	kvPairs []types.StoreKVPair
+}
+
+func (l *recordingListener) OnWrite(storeKey types.StoreKey, key []byte, value []byte, delete bool) error {
+	l.kvPairs = append(l.kvPairs, types.StoreKVPair{StoreKey: storeKey.Name(), Delete: delete, Key: key, Value: value})
+	return nil
+}
+
+func TestMultistoreSnapshot_Errors(t *testing.T) {
+	store := newMultiStoreWithGeneratedData(dbm.NewMemDB(), 4, 4)
+
+	testcases := map[string]struct {
+		height     uint64
+		format     uint32
+		expectType error
+	}{
+		"0 height":       {0, snapshottypes.CurrentFormat, nil},
+		"0 format":       {1, 0, snapshottypes.ErrUnknownFormat},
+		"unknown height": {9, snapshottypes.CurrentFormat, nil},
+		"unknown format": {1, 9, snapshottypes.ErrUnknownFormat},
+	}
+	for name, tc := range testcases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			_, err := store.Snapshot(tc.height, tc.format)
+			require.Error(t, err)
+			if tc.expectType != nil {
+				require.True(t, errors.Is(err, tc.expectType))
+			}
+		})
+	}
+}
+
+func TestMultistoreRestore_Errors(t *testing.T) {
+	store := newMultiStoreWithMixedMounts(dbm.NewMemDB())
+
+	testcases := map[string]struct {
+		height     uint64
+		format     uint32
+		expectType error
+	}{
+		"0 height":       {0, snapshottypes.CurrentFormat, nil},
+		"0 format":       {1, 0, snapshottypes.ErrUnknownFormat},
+		"unknown format": {1, 9, snapshottypes.ErrUnknownFormat},
+	}
+	for name, tc := range testcases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := store.Restore(tc.height, tc.format, nil, nil)
+			require.Error(t, err)
+			if tc.expectType != nil {
+				require.True(t, errors.Is(err, tc.expectType))
+			}
+		})
+	}
+}
+
+func TestMultistoreSnapshotRestore(t *testing.T) {
+	source := newMultiStoreWithMixedMountsAndBasicData(dbm.NewMemDB())
+	target := newMultiStoreWithMixedMounts(dbm.NewMemDB())
+	version := uint64(source.LastCommitID().Version)
+	require.EqualValues(t, 3, version)
+
+	chunks, err := source.Snapshot(version, snapshottypes.CurrentFormat)
+	require.NoError(t, err)
+	ready := make(chan struct{})
+	err = target.Restore(version, snapshottypes.CurrentFormat, chunks, ready)
+	require.NoError(t, err)
+	assert.EqualValues(t, struct{}{}, <-ready)
+
+	assert.Equal(t, source.LastCommitID(), target.LastCommitID())
+	for key, sourceStore := range source.stores {
+		targetStore := target.getStoreByName(key.Name()).(types.CommitKVStore)
+		switch sourceStore.GetStoreType() {
+		case types.StoreTypeTransient:
+			assert.False(t, targetStore.Iterator(nil, nil).Valid(),
+				"transient store %v not empty", key.Name())
+		default:
+			assertStoresEqual(t, sourceStore, targetStore, "store %q not equal", key.Name())
+		}
+	}
+}
+
+func TestMultistoreSnapshotRestore_Generated(t *testing.T) {
+	source := newMultiStoreWithGeneratedData(dbm.NewMemDB(), 3, 500)
+	target := newMultiStoreWithGeneratedData(dbm.NewMemDB(), 3, 0)
+	require.NoError(t, target.LoadLatestVersion())
+	version := uint64(source.LastCommitID().Version)
+
+	chunks, err := source.Snapshot(version, snapshottypes.CurrentFormat)
+	require.NoError(t, err)
+	err = target.Restore(version, snapshottypes.CurrentFormat, chunks, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, source.LastCommitID(), target.LastCommitID())
+	for key, sourceStore := range source.stores {
+		targetStore := target.getStoreByName(key.Name()).(types.CommitKVStore)
+		assertStoresEqual(t, sourceStore, targetStore, "store %q not equal", key.Name())
+	}
+}
+
 //-----------------------------------------------------------------------
 // utils
 
@@ -532,6 +748,100 @@ func newMultiStoreWithMounts(db dbm.DB, pruningOpts types.PruningOptions) *Store
 	return store
 }
 
+func newMultiStoreWithMixedMounts(db dbm.DB) *Store {
+	store := NewStore(db)
+	store.MountStoreWithDB(types.NewKVStoreKey("iavl1"), types.StoreTypeIAVL, nil)
+	store.MountStoreWithDB(types.NewKVStoreKey("iavl2"), types.StoreTypeIAVL, nil)
+	store.MountStoreWithDB(types.NewKVStoreKey("iavl3"), types.StoreTypeIAVL, nil)
+	store.MountStoreWithDB(types.NewTransientStoreKey("trans1"), types.StoreTypeTransient, nil)
+	if err := store.LoadLatestVersion(); err != nil {
+		panic(err)
+	}
+
+	return store
+}
+
+func newMultiStoreWithMixedMountsAndBasicData(db dbm.DB) *Store {
+	store := newMultiStoreWithMixedMounts(db)
+	store1 := store.getStoreByName("iavl1").(types.CommitKVStore)
+	store2 := store.getStoreByName("iavl2").(types.CommitKVStore)
+	trans1 := store.getStoreByName("trans1").(types.KVStore)
+
+	store1.Set([]byte("a"), []byte{1})
+	store1.Set([]byte("b"), []byte{1})
+	store2.Set([]byte("X"), []byte{255})
+	store2.Set([]byte("A"), []byte{101})
+	trans1.Set([]byte("x1"), []byte{91})
+	store.Commit()
+
+	store1.Set([]byte("b"), []byte{2})
+	store1.Set([]byte("c"), []byte{3})
+	store2.Set([]byte("B"), []byte{102})
+	store.Commit()
+
+	store2.Set([]byte("C"), []byte{103})
+	store2.Delete([]byte("X"))
+	trans1.Set([]byte("x2"), []byte{92})
+	store.Commit()
+
+	return store
+}
+
+func newMultiStoreWithGeneratedData(db dbm.DB, stores uint8, storeKeys uint64) *Store {
+	multiStore := NewStore(db)
+	r := rand.New(rand.NewSource(49872768940)) // Fixed seed for deterministic tests
+
+	keys := []*types.KVStoreKey{}
+	for i := uint8(0); i < stores; i++ {
+		key := types.NewKVStoreKey(fmt.Sprintf("store%v", i))
+		multiStore.MountStoreWithDB(key, types.StoreTypeIAVL, nil)
+		keys = append(keys, key)
+	}
+	if err := multiStore.LoadLatestVersion(); err != nil {
+		panic(err)
+	}
+
+	for _, key := range keys {
+		store := multiStore.stores[key].(*iavl.Store)
+		for i := uint64(0); i < storeKeys; i++ {
+			k := make([]byte, 8)
+			v := make([]byte, 1024)
+			binary.BigEndian.PutUint64(k, i)
+			_, err := r.Read(v)
+			if err != nil {
+				panic(err)
+			}
+			store.Set(k, v)
+		}
+	}
+
+	multiStore.Commit()
+	if err := multiStore.LoadLatestVersion(); err != nil {
+		panic(err)
+	}
+
+	return multiStore
+}
+
+func assertStoresEqual(t *testing.T, expect, actual types.CommitKVStore, msgAndArgs ...interface{}) {
+	assert.Equal(t, expect.LastCommitID(), actual.LastCommitID())
+	expectIter := expect.Iterator(nil, nil)
+	expectMap := map[string][]byte{}
+	for ; expectIter.Valid(); expectIter.Next() {
+		expectMap[string(expectIter.Key())] = expectIter.Value()
+	}
+	require.NoError(t, expectIter.Error())
+
+	actualIter := actual.Iterator(nil, nil)
+	actualMap := map[string][]byte{}
+	for ; actualIter.Valid(); actualIter.Next() {
+		actualMap[string(actualIter.Key())] = actualIter.Value()
+	}
+	require.NoError(t, actualIter.Error())
+
+	assert.Equal(t, expectMap, actualMap, msgAndArgs...)
+}
+
 func newMultiStoreWithModifiedMounts(db dbm.DB, pruningOpts types.PruningOptions) (*Store, *types.StoreUpgrades) {
 	store := NewStore(db)
 	store.pruningOpts = pruningOpts
@@ -0,0 +1,88 @@
+package rwset
+
+import (
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// RWSet records the keys read, the domains iterated and the keys written
+// through the stores wrapped with it. It is not safe for concurrent use.
+type RWSet struct {
+	reads   map[types.StoreKey]map[string]struct{}
+	domains map[types.StoreKey][]domain
+	writes  map[types.StoreKey]map[string]struct{}
+}
+
+// domain is the [start, end) domain of an iterator, nil meaning unbounded.
+type domain struct {
+	start, end []byte
+}
+
+// NewRWSet returns a new empty RWSet.
+func NewRWSet() *RWSet {
+	return &RWSet{
+		reads:   make(map[types.StoreKey]map[string]struct{}),
+		domains: make(map[types.StoreKey][]domain),
+		writes:  make(map[types.StoreKey]map[string]struct{}),
+	}
+}
+
+// AddRead records a read of key in the store mounted under storeKey.
+func (rw *RWSet) AddRead(storeKey types.StoreKey, key []byte) {
+	addKey(rw.reads, storeKey, key)
+}
+
+// AddIterate records an iteration over the [start, end) domain of the store
+// mounted under storeKey.
+func (rw *RWSet) AddIterate(storeKey types.StoreKey, start, end []byte) {
+	rw.domains[storeKey] = append(rw.domains[storeKey], domain{start: start, end: end})
+}
+
+// AddWrite records a write, or a delete, of key in the store mounted under
+// storeKey.
+func (rw *RWSet) AddWrite(storeKey types.StoreKey, key []byte) {
+	addKey(rw.writes, storeKey, key)
+}
+
+// AddWrites records all the writes of other.
+func (rw *RWSet) AddWrites(other *RWSet) {
+	for storeKey, keys := range other.writes {
+		for key := range keys {
+			addKey(rw.writes, storeKey, []byte(key))
+		}
+	}
+}
+
+// HasReadConflict returns true if a key written in written was read, or is
+// within a domain iterated, in rw. If it returns false, every read made
+// through rw returned the same result whether or not the writes of written
+// were applied first.
+func (rw *RWSet) HasReadConflict(written *RWSet) bool {
+	for storeKey, keys := range written.writes {
+		reads := rw.reads[storeKey]
+		domains := rw.domains[storeKey]
+
+		for key := range keys {
+			if _, ok := reads[key]; ok {
+				return true
+			}
+
+			for _, d := range domains {
+				if dbm.IsKeyInDomain([]byte(key), d.start, d.end) {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+func addKey(keys map[types.StoreKey]map[string]struct{}, storeKey types.StoreKey, key []byte) {
+	if keys[storeKey] == nil {
+		keys[storeKey] = make(map[string]struct{})
+	}
+
+	keys[storeKey][string(key)] = struct{}{}
+}
@@ -0,0 +1,106 @@
+package rwset_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/cachemulti"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/rwset"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var (
+	storeKey1 = types.NewKVStoreKey("store1")
+	storeKey2 = types.NewKVStoreKey("store2")
+)
+
+func TestHasReadConflict(t *testing.T) {
+	rw := rwset.NewRWSet()
+	rw.AddRead(storeKey1, []byte("a"))
+	rw.AddIterate(storeKey1, []byte("m"), []byte("p"))
+	rw.AddIterate(storeKey2, nil, []byte("c"))
+	rw.AddWrite(storeKey1, []byte("z"))
+
+	testCases := []struct {
+		name     string
+		storeKey types.StoreKey
+		key      string
+		conflict bool
+	}{
+		{"read key", storeKey1, "a", true},
+		{"read key in other store", storeKey2, "a", true},
+		{"key in iterated domain", storeKey1, "n", true},
+		{"domain start", storeKey1, "m", true},
+		{"domain end", storeKey1, "p", false},
+		{"key in unbounded domain", storeKey2, "", true},
+		{"written key", storeKey1, "z", false},
+		{"untouched key", storeKey1, "b", false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			written := rwset.NewRWSet()
+			written.AddWrite(tc.storeKey, []byte(tc.key))
+			require.Equal(t, tc.conflict, rw.HasReadConflict(written))
+		})
+	}
+
+	written := rwset.NewRWSet()
+	require.False(t, rw.HasReadConflict(written))
+
+	// writes are accumulated
+	other := rwset.NewRWSet()
+	other.AddWrite(storeKey1, []byte("n"))
+	written.AddWrites(other)
+	require.True(t, rw.HasReadConflict(written))
+}
+
+func TestMultiStore(t *testing.T) {
+	parent := cachemulti.NewStore(dbm.NewMemDB(), map[types.StoreKey]types.CacheWrapper{
+		storeKey1: dbadapter.Store{DB: dbm.NewMemDB()},
+	}, nil, nil, nil)
+	parent.GetKVStore(storeKey1).Set([]byte("a"), []byte("1"))
+
+	rw := rwset.NewRWSet()
+	ms := rwset.NewMultiStore(parent, rw)
+
+	// accesses to branches are recorded too
+	branch := ms.CacheMultiStore()
+	store := branch.GetKVStore(storeKey1)
+	require.Equal(t, []byte("1"), store.Get([]byte("a")))
+	store.Set([]byte("b"), []byte("2"))
+
+	iter := store.Iterator([]byte("c"), nil)
+	iter.Close()
+
+	written := rwset.NewRWSet()
+	written.AddWrite(storeKey1, []byte("a"))
+	require.True(t, rw.HasReadConflict(written))
+
+	written = rwset.NewRWSet()
+	written.AddWrite(storeKey1, []byte("d"))
+	require.True(t, rw.HasReadConflict(written))
+
+	// b was only written
+	written = rwset.NewRWSet()
+	written.AddWrite(storeKey1, []byte("b"))
+	require.False(t, rw.HasReadConflict(written))
+
+	other := rwset.NewRWSet()
+	other.AddWrites(rw)
+
+	reader := rwset.NewRWSet()
+	reader.AddRead(storeKey1, []byte("b"))
+	require.True(t, reader.HasReadConflict(other))
+
+	// writes only reach the parent once the branches are written
+	require.Nil(t, parent.GetKVStore(storeKey1).Get([]byte("b")))
+	branch.Write()
+	ms.Write()
+	require.Equal(t, []byte("2"), parent.GetKVStore(storeKey1).Get([]byte("b")))
+}
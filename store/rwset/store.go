@@ -0,0 +1,161 @@
+package rwset
+
+import (
+	"io"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var _ types.KVStore = &Store{}
+
+// Store implements the KVStore interface, recording the keys accessed through
+// it in a RWSet. Reads are recorded even if they are served from a cache the
+// tx wrote to, which only makes the recorded read set larger than needed.
+type Store struct {
+	parent         types.KVStore
+	parentStoreKey types.StoreKey
+	rwSet          *RWSet
+}
+
+// NewStore returns a reference to a new rwset Store given a parent KVStore
+// implementation, the StoreKey it is mounted under and the RWSet to record
+// the accesses in.
+func NewStore(parent types.KVStore, parentStoreKey types.StoreKey, rwSet *RWSet) *Store {
+	return &Store{parent: parent, parentStoreKey: parentStoreKey, rwSet: rwSet}
+}
+
+// Get implements the KVStore interface. It records the read and delegates
+// the Get call to the parent KVStore.
+func (s *Store) Get(key []byte) []byte {
+	s.rwSet.AddRead(s.parentStoreKey, key)
+	return s.parent.Get(key)
+}
+
+// Set implements the KVStore interface. It records the write and delegates
+// the Set call to the parent KVStore.
+func (s *Store) Set(key []byte, value []byte) {
+	s.rwSet.AddWrite(s.parentStoreKey, key)
+	s.parent.Set(key, value)
+}
+
+// Delete implements the KVStore interface. It records the write and delegates
+// the Delete call to the parent KVStore.
+func (s *Store) Delete(key []byte) {
+	s.rwSet.AddWrite(s.parentStoreKey, key)
+	s.parent.Delete(key)
+}
+
+// Has implements the KVStore interface. It records the read and delegates the
+// Has call to the parent KVStore.
+func (s *Store) Has(key []byte) bool {
+	s.rwSet.AddRead(s.parentStoreKey, key)
+	return s.parent.Has(key)
+}
+
+// Iterator implements the KVStore interface. It records the iterated domain
+// and delegates the Iterator call to the parent KVStore.
+func (s *Store) Iterator(start, end []byte) types.Iterator {
+	s.rwSet.AddIterate(s.parentStoreKey, start, end)
+	return s.parent.Iterator(start, end)
+}
+
+// ReverseIterator implements the KVStore interface. It records the iterated
+// domain and delegates the ReverseIterator call to the parent KVStore.
+func (s *Store) ReverseIterator(start, end []byte) types.Iterator {
+	s.rwSet.AddIterate(s.parentStoreKey, start, end)
+	return s.parent.ReverseIterator(start, end)
+}
+
+// GetStoreType implements the KVStore interface. It returns the underlying
+// KVStore type.
+func (s *Store) GetStoreType() types.StoreType {
+	return s.parent.GetStoreType()
+}
+
+// CacheWrap implements the KVStore interface. It cache-wraps the Store so that
+// the accesses which miss the cache are still recorded.
+func (s *Store) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements the KVStore interface.
+func (s *Store) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
+
+var _ types.CacheMultiStore = MultiStore{}
+
+// MultiStore implements the CacheMultiStore interface. The KVStores it returns,
+// and the ones of the CacheMultiStores branched off it, record their accesses
+// in the same RWSet.
+type MultiStore struct {
+	parent types.CacheMultiStore
+	rwSet  *RWSet
+}
+
+// NewMultiStore returns a new MultiStore given a parent CacheMultiStore and
+// the RWSet to record the accesses to its stores in.
+func NewMultiStore(parent types.CacheMultiStore, rwSet *RWSet) MultiStore {
+	return MultiStore{parent: parent, rwSet: rwSet}
+}
+
+// GetStoreType implements the MultiStore interface.
+func (ms MultiStore) GetStoreType() types.StoreType {
+	return ms.parent.GetStoreType()
+}
+
+// CacheWrap implements the MultiStore interface.
+func (ms MultiStore) CacheWrap() types.CacheWrap {
+	return ms.CacheMultiStore()
+}
+
+// CacheWrapWithTrace implements the MultiStore interface.
+func (ms MultiStore) CacheWrapWithTrace(_ io.Writer, _ types.TraceContext) types.CacheWrap {
+	return ms.CacheWrap()
+}
+
+// CacheMultiStore implements the MultiStore interface. It branches the parent
+// CacheMultiStore, recording the accesses to the branch in the same RWSet.
+func (ms MultiStore) CacheMultiStore() types.CacheMultiStore {
+	return NewMultiStore(ms.parent.CacheMultiStore(), ms.rwSet)
+}
+
+// CacheMultiStoreWithVersion implements the MultiStore interface. Accesses to
+// past versions aren't recorded.
+func (ms MultiStore) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStore, error) {
+	return ms.parent.CacheMultiStoreWithVersion(version)
+}
+
+// GetStore implements the MultiStore interface.
+func (ms MultiStore) GetStore(key types.StoreKey) types.Store {
+	return ms.GetKVStore(key)
+}
+
+// GetKVStore implements the MultiStore interface. It wraps the parent's
+// KVStore so that the accesses to it are recorded.
+func (ms MultiStore) GetKVStore(key types.StoreKey) types.KVStore {
+	return NewStore(ms.parent.GetKVStore(key), key, ms.rwSet)
+}
+
+// TracingEnabled implements the MultiStore interface.
+func (ms MultiStore) TracingEnabled() bool {
+	return ms.parent.TracingEnabled()
+}
+
+// SetTracer implements the MultiStore interface.
+func (ms MultiStore) SetTracer(w io.Writer) types.MultiStore {
+	return NewMultiStore(ms.parent.SetTracer(w).(types.CacheMultiStore), ms.rwSet)
+}
+
+// SetTracingContext implements the MultiStore interface.
+func (ms MultiStore) SetTracingContext(tc types.TraceContext) types.MultiStore {
+	return NewMultiStore(ms.parent.SetTracingContext(tc).(types.CacheMultiStore), ms.rwSet)
+}
+
+// Write implements the CacheMultiStore interface. It writes the parent
+// CacheMultiStore.
+func (ms MultiStore) Write() {
+	ms.parent.Write()
+}
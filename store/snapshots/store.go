@@ -0,0 +1,371 @@
+package snapshots
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/snapshots/types"
+)
+
+const (
+	// keyPrefixSnapshot is the prefix for snapshot database keys
+	keyPrefixSnapshot byte = 0x01
+)
+
+// Store is a snapshot store, containing snapshot metadata and binary chunks.
+type Store struct {
+	db  dbm.DB
+	dir string
+
+	mtx    sync.Mutex
+	saving map[uint64]bool // heights currently being saved
+}
+
+// NewStore creates a new snapshot store, storing snapshot metadata in the given
+// database and binary chunks in the given directory.
+func NewStore(db dbm.DB, dir string) (*Store, error) {
+	if dir == "" {
+		return nil, errors.New("snapshot directory not given")
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create snapshot directory %q: %w", dir, err)
+	}
+
+	return &Store{
+		db:     db,
+		dir:    dir,
+		saving: make(map[uint64]bool),
+	}, nil
+}
+
+// Delete deletes a snapshot.
+func (s *Store) Delete(height uint64, format uint32) error {
+	s.mtx.Lock()
+	saving := s.saving[height]
+	s.mtx.Unlock()
+
+	if saving {
+		return fmt.Errorf("snapshot for height %v format %v is currently being saved", height, format)
+	}
+
+	if err := s.db.DeleteSync(encodeKey(height, format)); err != nil {
+		return fmt.Errorf("failed to delete snapshot for height %v format %v: %w", height, format, err)
+	}
+
+	if err := os.RemoveAll(s.pathSnapshot(height, format)); err != nil {
+		return fmt.Errorf("failed to delete snapshot chunks for height %v format %v: %w", height, format, err)
+	}
+
+	return nil
+}
+
+// Get fetches snapshot info from the database. It returns nil if the snapshot
+// does not exist.
+func (s *Store) Get(height uint64, format uint32) (*types.Snapshot, error) {
+	bz, err := s.db.Get(encodeKey(height, format))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch snapshot metadata for height %v format %v: %w", height, format, err)
+	}
+	if bz == nil {
+		return nil, nil
+	}
+
+	snapshot := &types.Snapshot{}
+	if err := proto.Unmarshal(bz, snapshot); err != nil {
+		return nil, fmt.Errorf("failed to decode snapshot metadata for height %v format %v: %w", height, format, err)
+	}
+	if snapshot.Metadata.ChunkHashes == nil {
+		snapshot.Metadata.ChunkHashes = [][]byte{}
+	}
+
+	return snapshot, nil
+}
+
+// GetLatest fetches the latest snapshot from the database, if any.
+func (s *Store) GetLatest() (*types.Snapshot, error) {
+	iter, err := s.db.ReverseIterator(encodeKey(0, 0), encodeKey(math.MaxUint64, math.MaxUint32))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find latest snapshot: %w", err)
+	}
+	defer iter.Close()
+
+	var snapshot *types.Snapshot
+	if iter.Valid() {
+		snapshot = &types.Snapshot{}
+		if err := proto.Unmarshal(iter.Value(), snapshot); err != nil {
+			return nil, fmt.Errorf("failed to decode latest snapshot: %w", err)
+		}
+	}
+
+	return snapshot, iter.Error()
+}
+
+// List lists snapshots, in reverse order (newest first).
+func (s *Store) List() ([]*types.Snapshot, error) {
+	iter, err := s.db.ReverseIterator(encodeKey(0, 0), encodeKey(math.MaxUint64, math.MaxUint32))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list snapshots: %w", err)
+	}
+	defer iter.Close()
+
+	snapshots := make([]*types.Snapshot, 0)
+	for ; iter.Valid(); iter.Next() {
+		snapshot := &types.Snapshot{}
+		if err := proto.Unmarshal(iter.Value(), snapshot); err != nil {
+			return nil, fmt.Errorf("failed to decode snapshot info: %w", err)
+		}
+
+		snapshots = append(snapshots, snapshot)
+	}
+
+	return snapshots, iter.Error()
+}
+
+// Load loads a snapshot (both metadata and binary chunks). The chunks must be consumed and closed.
+// Returns nil if the snapshot does not exist.
+func (s *Store) Load(height uint64, format uint32) (*types.Snapshot, <-chan io.ReadCloser, error) {
+	snapshot, err := s.Get(height, format)
+	if err != nil || snapshot == nil {
+		return nil, nil, err
+	}
+
+	ch := make(chan io.ReadCloser)
+	go func() {
+		defer close(ch)
+
+		for i := uint32(0); i < snapshot.Chunks; i++ {
+			pr, pw := io.Pipe()
+			ch <- pr
+
+			chunk, err := s.loadChunkFile(height, format, i)
+			if err != nil {
+				pw.CloseWithError(err)
+				return
+			}
+
+			_, err = io.Copy(pw, chunk)
+			chunk.Close()
+			if err != nil {
+				pw.CloseWithError(err)
+				return
+			}
+
+			pw.Close()
+		}
+	}()
+
+	return snapshot, ch, nil
+}
+
+// LoadChunk loads a chunk from disk, or returns nil if it does not exist. The caller must call
+// Close() on it when done.
+func (s *Store) LoadChunk(height uint64, format uint32, chunk uint32) (io.ReadCloser, error) {
+	file, err := os.Open(s.pathChunk(height, format, chunk))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+
+	return file, err
+}
+
+// loadChunkFile loads a chunk from disk, and errors if it does not exist.
+func (s *Store) loadChunkFile(height uint64, format uint32, chunk uint32) (io.ReadCloser, error) {
+	return os.Open(s.pathChunk(height, format, chunk))
+}
+
+// Prune removes old snapshots. The given number of most recent heights (regardless of format) are
+// retained. It returns the number of snapshots pruned.
+func (s *Store) Prune(retain uint32) (uint64, error) {
+	iter, err := s.db.ReverseIterator(encodeKey(0, 0), encodeKey(math.MaxUint64, math.MaxUint32))
+	if err != nil {
+		return 0, fmt.Errorf("failed to prune snapshots: %w", err)
+	}
+	defer iter.Close()
+
+	pruned := uint64(0)
+	prunedHeights := make(map[uint64]bool)
+	skip := make(map[uint64]bool)
+
+	for ; iter.Valid(); iter.Next() {
+		height, format, err := decodeKey(iter.Key())
+		if err != nil {
+			return 0, err
+		}
+
+		if skip[height] || uint32(len(skip)) < retain {
+			skip[height] = true
+			continue
+		}
+
+		if err := s.Delete(height, format); err != nil {
+			return 0, err
+		}
+
+		pruned++
+		prunedHeights[height] = true
+	}
+
+	// Since Delete() deletes a specific format, while we want to prune a height, we clean up
+	// the height directory as well.
+	for height := range prunedHeights {
+		if err := os.RemoveAll(s.pathHeight(height)); err != nil {
+			return 0, fmt.Errorf("failed to remove snapshot directory for height %v: %w", height, err)
+		}
+	}
+
+	return pruned, iter.Error()
+}
+
+// Save saves a snapshot to disk, returning it.
+func (s *Store) Save(height uint64, format uint32, chunks <-chan io.ReadCloser) (*types.Snapshot, error) {
+	defer DrainChunks(chunks)
+
+	if height == 0 {
+		return nil, errors.New("snapshot height cannot be 0")
+	}
+
+	s.mtx.Lock()
+	saving := s.saving[height]
+	s.saving[height] = true
+	s.mtx.Unlock()
+
+	if saving {
+		return nil, fmt.Errorf("a snapshot for height %v is already being saved", height)
+	}
+	defer func() {
+		s.mtx.Lock()
+		delete(s.saving, height)
+		s.mtx.Unlock()
+	}()
+
+	exists, err := s.db.Has(encodeKey(height, format))
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, fmt.Errorf("snapshot already exists for height %v format %v", height, format)
+	}
+
+	snapshot := &types.Snapshot{
+		Height: height,
+		Format: format,
+	}
+
+	dir := s.pathSnapshot(height, format)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create snapshot directory %q: %w", dir, err)
+	}
+
+	index := uint32(0)
+	snapshotHasher := sha256.New()
+	chunkHasher := sha256.New()
+
+	for chunkBody := range chunks {
+		chunkHasher.Reset()
+
+		if err := s.saveChunk(chunkBody, height, format, index, io.MultiWriter(chunkHasher, snapshotHasher)); err != nil {
+			return nil, err
+		}
+
+		snapshot.Metadata.ChunkHashes = append(snapshot.Metadata.ChunkHashes, chunkHasher.Sum(nil))
+		index++
+	}
+
+	snapshot.Chunks = index
+	snapshot.Hash = snapshotHasher.Sum(nil)
+
+	if err := s.saveSnapshot(snapshot); err != nil {
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
+// saveChunk writes a single chunk to disk, additionally copying its contents
+// to the given hasher.
+func (s *Store) saveChunk(chunkBody io.ReadCloser, height uint64, format uint32, index uint32, hasher io.Writer) error {
+	defer chunkBody.Close()
+
+	path := s.pathChunk(height, format, index)
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create snapshot chunk file %q: %w", path, err)
+	}
+	defer file.Close()
+
+	if _, err := io.Copy(io.MultiWriter(file, hasher), chunkBody); err != nil {
+		return fmt.Errorf("failed to generate snapshot chunk %v: %w", index, err)
+	}
+
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("failed to close snapshot chunk %v: %w", index, err)
+	}
+
+	return chunkBody.Close()
+}
+
+// saveSnapshot saves snapshot metadata to the database.
+func (s *Store) saveSnapshot(snapshot *types.Snapshot) error {
+	value, err := proto.Marshal(snapshot)
+	if err != nil {
+		return fmt.Errorf("failed to encode snapshot metadata: %w", err)
+	}
+
+	if err := s.db.SetSync(encodeKey(snapshot.Height, snapshot.Format), value); err != nil {
+		return fmt.Errorf("failed to store snapshot metadata: %w", err)
+	}
+
+	return nil
+}
+
+// pathHeight generates the path to a height, containing multiple snapshot formats.
+func (s *Store) pathHeight(height uint64) string {
+	return filepath.Join(s.dir, strconv.FormatUint(height, 10))
+}
+
+// pathSnapshot generates a snapshot path, as a specific format under a height.
+func (s *Store) pathSnapshot(height uint64, format uint32) string {
+	return filepath.Join(s.pathHeight(height), strconv.FormatUint(uint64(format), 10))
+}
+
+// pathChunk generates a snapshot chunk path.
+func (s *Store) pathChunk(height uint64, format uint32, chunk uint32) string {
+	return filepath.Join(s.pathSnapshot(height, format), strconv.FormatUint(uint64(chunk), 10))
+}
+
+// decodeKey decodes a snapshot key.
+func decodeKey(k []byte) (uint64, uint32, error) {
+	if len(k) != 13 {
+		return 0, 0, fmt.Errorf("invalid snapshot key with length %v", len(k))
+	}
+	if k[0] != keyPrefixSnapshot {
+		return 0, 0, fmt.Errorf("invalid snapshot key prefix %x", k[0])
+	}
+
+	height := binary.BigEndian.Uint64(k[1:9])
+	format := binary.BigEndian.Uint32(k[9:13])
+
+	return height, format, nil
+}
+
+// encodeKey encodes a snapshot key.
+func encodeKey(height uint64, format uint32) []byte {
+	k := make([]byte, 13)
+	k[0] = keyPrefixSnapshot
+	binary.BigEndian.PutUint64(k[1:9], height)
+	binary.BigEndian.PutUint32(k[9:13], format)
+
+	return k
+}
@@ -0,0 +1,26 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SnapshotCodespace is the codespace for all errors defined in this package
+const SnapshotCodespace = "snapshots"
+
+var (
+	// ErrUnknownFormat is returned when an unknown format is used.
+	ErrUnknownFormat = sdkerrors.Register(SnapshotCodespace, 2, "unknown snapshot format")
+
+	// ErrChunkHashMismatch is returned when chunk hash verification failed.
+	ErrChunkHashMismatch = sdkerrors.Register(SnapshotCodespace, 3, "chunk hash verification failed")
+
+	// ErrInvalidMetadata is returned when the snapshot metadata is invalid.
+	ErrInvalidMetadata = sdkerrors.Register(SnapshotCodespace, 4, "invalid snapshot metadata")
+
+	// ErrInvalidSnapshot is returned when a snapshot is invalid or malformed.
+	ErrInvalidSnapshot = sdkerrors.Register(SnapshotCodespace, 5, "invalid snapshot")
+
+	// ErrAppHashMismatch is returned when a restored snapshot does not match
+	// the expected application hash.
+	ErrAppHashMismatch = sdkerrors.Register(SnapshotCodespace, 6, "restored app hash mismatch")
+)
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"bytes"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Validate performs basic validation of the snapshot, ensuring the chunk
+// count matches the metadata chunk hashes.
+func (s Snapshot) Validate() error {
+	if s.Height == 0 {
+		return sdkerrors.Wrap(ErrInvalidSnapshot, "height cannot be 0")
+	}
+	if s.Format == 0 {
+		return sdkerrors.Wrap(ErrInvalidSnapshot, "format cannot be 0")
+	}
+	if s.Chunks == 0 {
+		return sdkerrors.Wrap(ErrInvalidSnapshot, "no chunks")
+	}
+	if len(s.Hash) == 0 {
+		return sdkerrors.Wrap(ErrInvalidSnapshot, "hash cannot be empty")
+	}
+	if len(s.Metadata.ChunkHashes) != int(s.Chunks) {
+		return sdkerrors.Wrapf(ErrInvalidMetadata, "snapshot has %v chunk hashes, but %v chunks",
+			uint32(len(s.Metadata.ChunkHashes)), s.Chunks)
+	}
+
+	return nil
+}
+
+// Equal returns true if the snapshot is equal to the given one.
+func (s Snapshot) Equal(o Snapshot) bool {
+	if s.Height != o.Height || s.Format != o.Format || s.Chunks != o.Chunks ||
+		!bytes.Equal(s.Hash, o.Hash) || len(s.Metadata.ChunkHashes) != len(o.Metadata.ChunkHashes) {
+		return false
+	}
+
+	for i, hash := range s.Metadata.ChunkHashes {
+		if !bytes.Equal(hash, o.Metadata.ChunkHashes[i]) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,133 @@
+package file
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	protoio "github.com/gogo/protobuf/io"
+	"github.com/gogo/protobuf/proto"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// WildcardStoreKey is the store key name which enables streaming for all the
+// stores of the application.
+const WildcardStoreKey = "*"
+
+var _ baseapp.StreamingService = (*StreamingService)(nil)
+
+// StreamingService is a baseapp.StreamingService that writes the data of each
+// block to files in a directory. Each file holds length-prefixed protobuf
+// messages (see gogo/protobuf/io.NewDelimitedWriter):
+//
+//   - {prefix}block-{N}-begin: the RequestBeginBlock and ResponseBeginBlock
+//   - {prefix}block-{N}-tx-{i}: the RequestDeliverTx and ResponseDeliverTx of
+//     the i-th tx of the block
+//   - {prefix}block-{N}-end: the RequestEndBlock and ResponseEndBlock
+//   - {prefix}block-{N}-changeset: a StoreKVPair for each Set and Delete
+//     committed to the listened stores, written once the block is committed
+//
+// The BaseApp calls the hooks of a StreamingService sequentially, it is not
+// safe for concurrent use.
+type StreamingService struct {
+	writeDir   string
+	filePrefix string
+	storeKeys  map[string]bool
+
+	listener    *types.StoreKVPairWriteListener
+	changeSet   *bytes.Buffer
+	blockHeight int64
+	txIndex     int64
+}
+
+// NewStreamingService creates a new StreamingService which writes to writeDir
+// the data of the stores with the given store key names. The WildcardStoreKey
+// enables streaming for all the stores.
+func NewStreamingService(writeDir, filePrefix string, storeKeyNames []string) (*StreamingService, error) {
+	if err := os.MkdirAll(writeDir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create write directory %s: %w", writeDir, err)
+	}
+
+	storeKeys := make(map[string]bool, len(storeKeyNames))
+	for _, name := range storeKeyNames {
+		storeKeys[name] = true
+	}
+
+	changeSet := new(bytes.Buffer)
+
+	return &StreamingService{
+		writeDir:   writeDir,
+		filePrefix: filePrefix,
+		storeKeys:  storeKeys,
+		listener:   types.NewStoreKVPairWriteListener(changeSet),
+		changeSet:  changeSet,
+	}, nil
+}
+
+// Listeners implements baseapp.StreamingService. The same listener is
+// returned for all the streamed stores so that the change set holds the writes
+// of every store in the order they are committed.
+func (s *StreamingService) Listeners(key sdk.StoreKey) []types.WriteListener {
+	if !s.storeKeys[WildcardStoreKey] && !s.storeKeys[key.Name()] {
+		return nil
+	}
+
+	return []types.WriteListener{s.listener}
+}
+
+// ListenBeginBlock implements baseapp.ABCIListener. It writes the BeginBlock
+// request and response to the begin file of the block.
+func (s *StreamingService) ListenBeginBlock(_ sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	s.blockHeight = req.Header.Height
+	s.txIndex = 0
+
+	return s.writeFile(s.fileName("begin"), &req, &res)
+}
+
+// ListenDeliverTx implements baseapp.ABCIListener. It writes the DeliverTx
+// request and response to the file of the tx.
+func (s *StreamingService) ListenDeliverTx(_ sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	name := s.fileName(fmt.Sprintf("tx-%d", s.txIndex))
+	s.txIndex++
+
+	return s.writeFile(name, &req, &res)
+}
+
+// ListenEndBlock implements baseapp.ABCIListener. It writes the EndBlock
+// request and response to the end file of the block.
+func (s *StreamingService) ListenEndBlock(_ sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	return s.writeFile(s.fileName("end"), &req, &res)
+}
+
+// ListenCommit implements baseapp.ABCIListener. It writes the state changes
+// committed by the block to the changeset file of the block.
+func (s *StreamingService) ListenCommit(_ sdk.Context, _ abci.ResponseCommit) error {
+	defer s.changeSet.Reset()
+
+	return ioutil.WriteFile(filepath.Join(s.writeDir, s.fileName("changeset")), s.changeSet.Bytes(), 0600)
+}
+
+// fileName returns the name of the given file of the current block.
+func (s *StreamingService) fileName(suffix string) string {
+	return fmt.Sprintf("%sblock-%d-%s", s.filePrefix, s.blockHeight, suffix)
+}
+
+// writeFile writes the length-prefixed msgs to the file with the given name.
+func (s *StreamingService) writeFile(name string, msgs ...proto.Message) error {
+	var buf bytes.Buffer
+	writer := protoio.NewDelimitedWriter(&buf)
+
+	for _, msg := range msgs {
+		if err := writer.WriteMsg(msg); err != nil {
+			return err
+		}
+	}
+
+	return ioutil.WriteFile(filepath.Join(s.writeDir, name), buf.Bytes(), 0600)
+}
@@ -0,0 +1,100 @@
+package file
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	protoio "github.com/gogo/protobuf/io"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	accKey  = sdk.NewKVStoreKey("acc")
+	bankKey = sdk.NewKVStoreKey("bank")
+	govKey  = sdk.NewKVStoreKey("gov")
+)
+
+func TestListeners(t *testing.T) {
+	dir, err := ioutil.TempDir("", "streaming")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	s, err := NewStreamingService(dir, "", []string{"acc", "bank"})
+	require.NoError(t, err)
+	require.Len(t, s.Listeners(accKey), 1)
+	require.Len(t, s.Listeners(bankKey), 1)
+	require.Empty(t, s.Listeners(govKey))
+
+	s, err = NewStreamingService(dir, "", []string{WildcardStoreKey})
+	require.NoError(t, err)
+	require.Len(t, s.Listeners(govKey), 1)
+}
+
+func TestStreamingService(t *testing.T) {
+	dir, err := ioutil.TempDir("", "streaming")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	s, err := NewStreamingService(dir, "test-", []string{"acc", "bank"})
+	require.NoError(t, err)
+	ctx := sdk.Context{}
+
+	reqBeginBlock := abci.RequestBeginBlock{Header: abci.Header{Height: 5}}
+	resBeginBlock := abci.ResponseBeginBlock{Events: []abci.Event{{Type: "begin"}}}
+	require.NoError(t, s.ListenBeginBlock(ctx, reqBeginBlock, resBeginBlock))
+
+	reqDeliverTx1 := abci.RequestDeliverTx{Tx: []byte("tx1")}
+	resDeliverTx1 := abci.ResponseDeliverTx{Code: 1, Log: "failed"}
+	require.NoError(t, s.ListenDeliverTx(ctx, reqDeliverTx1, resDeliverTx1))
+	reqDeliverTx2 := abci.RequestDeliverTx{Tx: []byte("tx2")}
+	resDeliverTx2 := abci.ResponseDeliverTx{GasUsed: 10}
+	require.NoError(t, s.ListenDeliverTx(ctx, reqDeliverTx2, resDeliverTx2))
+
+	reqEndBlock := abci.RequestEndBlock{Height: 5}
+	resEndBlock := abci.ResponseEndBlock{Events: []abci.Event{{Type: "end"}}}
+	require.NoError(t, s.ListenEndBlock(ctx, reqEndBlock, resEndBlock))
+
+	// the store writes are notified when the block is committed
+	require.NoError(t, s.Listeners(accKey)[0].OnWrite(accKey, []byte("key1"), []byte("value1"), false))
+	require.NoError(t, s.Listeners(bankKey)[0].OnWrite(bankKey, []byte("key2"), nil, true))
+	require.NoError(t, s.ListenCommit(ctx, abci.ResponseCommit{Data: []byte("hash")}))
+
+	requireFileMsgs(t, filepath.Join(dir, "test-block-5-begin"), &reqBeginBlock, &resBeginBlock)
+	requireFileMsgs(t, filepath.Join(dir, "test-block-5-tx-0"), &reqDeliverTx1, &resDeliverTx1)
+	requireFileMsgs(t, filepath.Join(dir, "test-block-5-tx-1"), &reqDeliverTx2, &resDeliverTx2)
+	requireFileMsgs(t, filepath.Join(dir, "test-block-5-end"), &reqEndBlock, &resEndBlock)
+	requireFileMsgs(t, filepath.Join(dir, "test-block-5-changeset"),
+		&types.StoreKVPair{StoreKey: "acc", Key: []byte("key1"), Value: []byte("value1")},
+		&types.StoreKVPair{StoreKey: "bank", Delete: true, Key: []byte("key2")},
+	)
+
+	t.Log("verify the change set is reset for the next block")
+	require.NoError(t, s.ListenBeginBlock(ctx, abci.RequestBeginBlock{Header: abci.Header{Height: 6}}, abci.ResponseBeginBlock{}))
+	require.NoError(t, s.ListenCommit(ctx, abci.ResponseCommit{}))
+	requireFileMsgs(t, filepath.Join(dir, "test-block-6-changeset"))
+}
+
+// requireFileMsgs checks that the file holds the expected length-prefixed msgs
+func requireFileMsgs(t *testing.T, path string, expected ...proto.Message) {
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	reader := protoio.NewDelimitedReader(f, 1<<20)
+	for _, exp := range expected {
+		msg := proto.Clone(exp)
+		msg.Reset()
+		require.NoError(t, reader.ReadMsg(msg))
+		require.Equal(t, exp, msg)
+	}
+
+	require.Equal(t, io.EOF, reader.ReadMsg(&types.StoreKVPair{}))
+}
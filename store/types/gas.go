@@ -150,6 +150,58 @@ func (g *infiniteGasMeter) String() string {
 	return fmt.Sprintf("InfiniteGasMeter:\n  consumed: %d", g.consumed)
 }
 
+// RecordingGasMeter is a GasMeter recording the gas consumed by descriptor,
+// before consuming it with the GasMeter it wraps.
+type RecordingGasMeter struct {
+	GasMeter
+
+	consumed map[string]Gas
+}
+
+// NewRecordingGasMeter returns a reference to a new RecordingGasMeter wrapping
+// the given GasMeter.
+func NewRecordingGasMeter(gasMeter GasMeter) *RecordingGasMeter {
+	return &RecordingGasMeter{
+		GasMeter: gasMeter,
+		consumed: make(map[string]Gas),
+	}
+}
+
+// WithGasMeter returns a RecordingGasMeter wrapping the given GasMeter, which
+// records the gas it consumes along with the gas recorded by g, so that the
+// recording isn't lost when the GasMeter of a Context is replaced.
+func (g *RecordingGasMeter) WithGasMeter(gasMeter GasMeter) *RecordingGasMeter {
+	return &RecordingGasMeter{
+		GasMeter: gasMeter,
+		consumed: g.consumed,
+	}
+}
+
+func (g *RecordingGasMeter) ConsumeGas(amount Gas, descriptor string) {
+	consumed, overflow := addUint64Overflow(g.consumed[descriptor], amount)
+	if overflow {
+		consumed = math.MaxUint64
+	}
+
+	g.consumed[descriptor] = consumed
+	g.GasMeter.ConsumeGas(amount, descriptor)
+}
+
+// GasConsumedByDescriptor returns the gas consumed by descriptor, including
+// the gas consumed past the limit of the wrapped GasMeter.
+func (g *RecordingGasMeter) GasConsumedByDescriptor() map[string]Gas {
+	consumed := make(map[string]Gas, len(g.consumed))
+	for descriptor, gas := range g.consumed {
+		consumed[descriptor] = gas
+	}
+
+	return consumed
+}
+
+func (g *RecordingGasMeter) String() string {
+	return fmt.Sprintf("RecordingGasMeter:\n  %s", g.GasMeter.String())
+}
+
 // GasConfig defines gas cost for each operation on KVStores
 type GasConfig struct {
 	HasCost          Gas
@@ -102,3 +102,28 @@ func TestTransientGasConfig(t *testing.T) {
 		IterNextCostFlat: 30,
 	})
 }
+
+func TestRecordingGasMeter(t *testing.T) {
+	t.Parallel()
+	meter := NewRecordingGasMeter(NewGasMeter(100))
+	meter.ConsumeGas(10, "read")
+	meter.ConsumeGas(20, "write")
+	meter.ConsumeGas(30, "read")
+	require.Equal(t, uint64(60), meter.GasConsumed())
+	require.Equal(t, map[string]Gas{"read": 40, "write": 20}, meter.GasConsumedByDescriptor())
+
+	// the records are shared with the meter wrapping another gas meter
+	meter2 := meter.WithGasMeter(NewInfiniteGasMeter())
+	meter2.ConsumeGas(5, "write")
+	require.Equal(t, uint64(5), meter2.GasConsumed())
+	require.Equal(t, uint64(60), meter.GasConsumed())
+	require.Equal(t, map[string]Gas{"read": 40, "write": 25}, meter.GasConsumedByDescriptor())
+
+	// the gas consumed past the limit is recorded
+	require.Panics(t, func() { meter.ConsumeGas(50, "iterate") })
+	require.Equal(t, Gas(50), meter.GasConsumedByDescriptor()["iterate"])
+
+	// the returned records are a copy
+	meter.GasConsumedByDescriptor()["read"] = 0
+	require.Equal(t, Gas(40), meter.GasConsumedByDescriptor()["read"])
+}
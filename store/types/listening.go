@@ -0,0 +1,44 @@
+package types
+
+import (
+	"io"
+
+	protoio "github.com/gogo/protobuf/io"
+)
+
+// WriteListener interface for streaming data out from a listenkv.Store
+type WriteListener interface {
+	// OnWrite is called for each Set or Delete on a listened KVStore. The
+	// storeKey indicates the source KVStore, to facilitate using the same
+	// WriteListener across separate KVStores. delete is true for a Delete, in
+	// which case value is nil.
+	OnWrite(storeKey StoreKey, key []byte, value []byte, delete bool) error
+}
+
+// StoreKVPairWriteListener is used to configure listening to a KVStore by
+// writing out length-prefixed protobuf encoded StoreKVPairs to an underlying
+// io.Writer
+type StoreKVPairWriteListener struct {
+	writer protoio.WriteCloser
+}
+
+var _ WriteListener = (*StoreKVPairWriteListener)(nil)
+
+// NewStoreKVPairWriteListener creates a StoreKVPairWriteListener with a
+// provided io.Writer
+func NewStoreKVPairWriteListener(w io.Writer) *StoreKVPairWriteListener {
+	return &StoreKVPairWriteListener{writer: protoio.NewDelimitedWriter(w)}
+}
+
+// OnWrite satisfies the WriteListener interface by writing length-prefixed
+// protobuf encoded StoreKVPairs
+func (wl *StoreKVPairWriteListener) OnWrite(storeKey StoreKey, key []byte, value []byte, delete bool) error {
+	kvPair := &StoreKVPair{
+		StoreKey: storeKey.Name(),
+		Delete:   delete,
+		Key:      key,
+		Value:    value,
+	}
+
+	return wl.writer.WriteMsg(kvPair)
+}
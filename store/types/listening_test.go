@@ -0,0 +1,29 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	protoio "github.com/gogo/protobuf/io"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStoreKVPairWriteListener(t *testing.T) {
+	var buf bytes.Buffer
+	listener := NewStoreKVPairWriteListener(&buf)
+	storeKey := NewKVStoreKey("acc")
+
+	require.NoError(t, listener.OnWrite(storeKey, []byte("key1"), []byte("value1"), false))
+	require.NoError(t, listener.OnWrite(storeKey, []byte("key2"), nil, true))
+
+	reader := protoio.NewDelimitedReader(&buf, 1024)
+	expected := []StoreKVPair{
+		{StoreKey: "acc", Key: []byte("key1"), Value: []byte("value1")},
+		{StoreKey: "acc", Delete: true, Key: []byte("key2")},
+	}
+	for _, exp := range expected {
+		var kvPair StoreKVPair
+		require.NoError(t, reader.ReadMsg(&kvPair))
+		require.Equal(t, exp, kvPair)
+	}
+}
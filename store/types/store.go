@@ -7,6 +7,8 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmkv "github.com/tendermint/tendermint/libs/kv"
 	dbm "github.com/tendermint/tm-db"
+
+	snapshottypes "github.com/cosmos/cosmos-sdk/store/snapshots/types"
 )
 
 type Store interface {
@@ -130,6 +132,7 @@ type CacheMultiStore interface {
 type CommitMultiStore interface {
 	Committer
 	MultiStore
+	snapshottypes.Snapshotter
 
 	// Mount a store of type using the given db.
 	// If db == nil, the new store will use the CommitMultiStore db.
@@ -161,9 +164,22 @@ type CommitMultiStore interface {
 	// undefined.
 	LoadVersion(ver int64) error
 
+	// RollbackToVersion deletes the versions after the given one and sets it
+	// as the latest persisted version, which is then loaded.
+	RollbackToVersion(ver int64) error
+
 	// Set an inter-block (persistent) cache that maintains a mapping from
 	// StoreKeys to CommitKVStores.
 	SetInterBlockCache(MultiStorePersistentCache)
+
+	// ListeningEnabled returns if listening is enabled for the KVStore
+	// belonging to the provided StoreKey.
+	ListeningEnabled(key StoreKey) bool
+
+	// AddListeners adds WriteListeners for the KVStore belonging to the
+	// provided StoreKey. They are notified of every Set and Delete which is
+	// written to the committed store.
+	AddListeners(key StoreKey, listeners []WriteListener)
 }
 
 //---------subsp-------------------------------
@@ -52,6 +52,29 @@ type Config struct {
 	// Example:
 	// [["chain_id", "cosmoshub-1"]]
 	GlobalLabels [][]string `mapstructure:"global-labels"`
+
+	// EnableTxMetrics enables the Prometheus histograms of the latency and gas
+	// used of the txs by message type URL and module route, along with the
+	// counters of the txs rejected by the AnteHandler by error code. It requires
+	// the Prometheus sink to be enabled.
+	EnableTxMetrics bool `mapstructure:"enable-tx-metrics"`
+
+	// EnableModuleMetrics enables the Prometheus histograms of the latency of
+	// the BeginBlock and EndBlock of each module. It requires the Prometheus sink
+	// to be enabled.
+	EnableModuleMetrics bool `mapstructure:"enable-module-metrics"`
+
+	// EnableTracing enables the tracing of the blocks, txs and queries, which
+	// is independent of the metrics.
+	EnableTracing bool `mapstructure:"enable-tracing"`
+
+	// TracingExporter defines the exporter of the spans, "stdout", "file", or
+	// one registered with RegisterSpanExporter.
+	TracingExporter string `mapstructure:"tracing-exporter"`
+
+	// TracingFile defines the file to which the "file" exporter appends the
+	// spans.
+	TracingFile string `mapstructure:"tracing-file"`
 }
 
 // Metrics defines a wrapper around application telemetry functionality. It allows
@@ -93,6 +116,8 @@ func New(cfg Config) (*Metrics, error) {
 	m := &Metrics{memSink: memSink}
 	fanout := metrics.FanoutSink{memSink}
 
+	txMetrics, moduleMetrics = nil, nil
+
 	if cfg.PrometheusRetentionTime > 0 {
 		m.prometheusEnabled = true
 		prometheusOpts := metricsprom.PrometheusOpts{
@@ -105,6 +130,18 @@ func New(cfg Config) (*Metrics, error) {
 		}
 
 		fanout = append(fanout, promSink)
+
+		if cfg.EnableTxMetrics {
+			if err := registerTxMetrics(); err != nil {
+				return nil, err
+			}
+		}
+
+		if cfg.EnableModuleMetrics {
+			if err := registerModuleMetrics(); err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	if _, err := metrics.NewGlobal(metricsConf, fanout); err != nil {
@@ -0,0 +1,173 @@
+package telemetry
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Prometheus metric label name constants
+const (
+	MetricLabelNameMode       = "mode"
+	MetricLabelNameMsgTypeURL = "msg_type_url"
+	MetricLabelNameRoute      = "route"
+	MetricLabelNameCodespace  = "codespace"
+	MetricLabelNameCode       = "code"
+	MetricLabelNameBlocker    = "blocker"
+)
+
+var (
+	// latencyBuckets range from 100µs to ~26s
+	latencyBuckets = prometheus.ExponentialBuckets(0.0001, 4, 10)
+
+	// gasBuckets range from 1,000 to ~262M gas
+	gasBuckets = prometheus.ExponentialBuckets(1000, 4, 10)
+)
+
+type (
+	// txCollectors defines the Prometheus metrics of the txs.
+	txCollectors struct {
+		latency      *prometheus.HistogramVec
+		gasUsed      *prometheus.HistogramVec
+		anteRejected *prometheus.CounterVec
+	}
+
+	// moduleCollectors defines the Prometheus metrics of the modules.
+	moduleCollectors struct {
+		blockerLatency *prometheus.HistogramVec
+	}
+)
+
+var (
+	// txMetrics is nil when the tx metrics are disabled.
+	txMetrics *txCollectors
+
+	// moduleMetrics is nil when the module metrics are disabled.
+	moduleMetrics *moduleCollectors
+)
+
+// registerTxMetrics registers the Prometheus metrics of the txs.
+func registerTxMetrics() error {
+	latency, err := register(prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:        "tx_msg_latency_seconds",
+		Help:        "Latency of the txs by mode, message type URL and module route.",
+		Buckets:     latencyBuckets,
+		ConstLabels: constLabels(),
+	}, []string{MetricLabelNameMode, MetricLabelNameMsgTypeURL, MetricLabelNameRoute}))
+	if err != nil {
+		return err
+	}
+
+	gasUsed, err := register(prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:        "tx_msg_gas_used",
+		Help:        "Gas used by the txs by mode, message type URL and module route.",
+		Buckets:     gasBuckets,
+		ConstLabels: constLabels(),
+	}, []string{MetricLabelNameMode, MetricLabelNameMsgTypeURL, MetricLabelNameRoute}))
+	if err != nil {
+		return err
+	}
+
+	anteRejected, err := register(prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name:        "tx_ante_rejected_total",
+		Help:        "Number of txs rejected by the AnteHandler by mode and error code.",
+		ConstLabels: constLabels(),
+	}, []string{MetricLabelNameMode, MetricLabelNameCodespace, MetricLabelNameCode}))
+	if err != nil {
+		return err
+	}
+
+	txMetrics = &txCollectors{
+		latency:      latency.(*prometheus.HistogramVec),
+		gasUsed:      gasUsed.(*prometheus.HistogramVec),
+		anteRejected: anteRejected.(*prometheus.CounterVec),
+	}
+
+	return nil
+}
+
+// registerModuleMetrics registers the Prometheus metrics of the modules.
+func registerModuleMetrics() error {
+	blockerLatency, err := register(prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:        "module_blocker_latency_seconds",
+		Help:        "Latency of the BeginBlock and EndBlock of each module.",
+		Buckets:     latencyBuckets,
+		ConstLabels: constLabels(),
+	}, []string{MetricLabelNameBlocker, MetricLabelNameModule}))
+	if err != nil {
+		return err
+	}
+
+	moduleMetrics = &moduleCollectors{
+		blockerLatency: blockerLatency.(*prometheus.HistogramVec),
+	}
+
+	return nil
+}
+
+// register registers a Prometheus collector, or returns the equivalent one
+// already registered.
+func register(collector prometheus.Collector) (prometheus.Collector, error) {
+	if err := prometheus.Register(collector); err != nil {
+		var are prometheus.AlreadyRegisteredError
+		if !errors.As(err, &are) {
+			return nil, err
+		}
+
+		return are.ExistingCollector, nil
+	}
+
+	return collector, nil
+}
+
+// constLabels returns the global labels (if any) as constant Prometheus labels.
+func constLabels() prometheus.Labels {
+	labels := make(prometheus.Labels, len(globalLabels))
+	for _, gl := range globalLabels {
+		labels[gl.Name] = gl.Value
+	}
+
+	return labels
+}
+
+// TxMetricsEnabled returns true if the Prometheus metrics of the txs are
+// enabled.
+func TxMetricsEnabled() bool {
+	return txMetrics != nil
+}
+
+// ObserveTx records the latency since start and the gas used by a tx executed
+// in the given mode, labelled by the type URL and route of one of its messages.
+// It's a no-op if the tx metrics are disabled.
+func ObserveTx(mode, msgTypeURL, route string, start time.Time, gasUsed uint64) {
+	if txMetrics == nil {
+		return
+	}
+
+	txMetrics.latency.WithLabelValues(mode, msgTypeURL, route).Observe(time.Since(start).Seconds())
+	txMetrics.gasUsed.WithLabelValues(mode, msgTypeURL, route).Observe(float64(gasUsed))
+}
+
+// IncrAnteRejected increments the number of txs executed in the given mode
+// that were rejected by the AnteHandler with the given error code. It's a no-op
+// if the tx metrics are disabled.
+func IncrAnteRejected(mode, codespace string, code uint32) {
+	if txMetrics == nil {
+		return
+	}
+
+	txMetrics.anteRejected.WithLabelValues(mode, codespace, strconv.FormatUint(uint64(code), 10)).Inc()
+}
+
+// ObserveModuleBlocker records the latency since start of the given blocker,
+// i.e. MetricKeyBeginBlocker or MetricKeyEndBlocker, of a module. It's a no-op
+// if the module metrics are disabled.
+func ObserveModuleBlocker(blocker, module string, start time.Time) {
+	if moduleMetrics == nil {
+		return
+	}
+
+	moduleMetrics.blockerLatency.WithLabelValues(blocker, module).Observe(time.Since(start).Seconds())
+}
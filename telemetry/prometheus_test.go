@@ -0,0 +1,65 @@
+package telemetry
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/expfmt"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetrics_TxAndModuleRequirePrometheus(t *testing.T) {
+	_, err := New(Config{
+		Enabled:             true,
+		EnableTxMetrics:     true,
+		EnableModuleMetrics: true,
+	})
+	require.NoError(t, err)
+	require.False(t, TxMetricsEnabled())
+	require.Nil(t, moduleMetrics)
+
+	// observing disabled metrics is a no-op
+	ObserveTx("deliver", "/cosmos.bank.MsgSend", "bank", time.Now(), 50000)
+	IncrAnteRejected("check", "sdk", 5)
+	ObserveModuleBlocker(MetricKeyBeginBlocker, "mint", time.Now())
+}
+
+func TestMetrics_TxAndModule(t *testing.T) {
+	defer func() { txMetrics, moduleMetrics = nil, nil }()
+
+	require.NoError(t, registerTxMetrics())
+	require.NoError(t, registerModuleMetrics())
+	require.True(t, TxMetricsEnabled())
+
+	start := time.Now()
+	ObserveTx("deliver", "/cosmos.bank.MsgSend", "bank", start, 50000)
+	IncrAnteRejected("check", "sdk", 5)
+	IncrAnteRejected("check", "sdk", 5)
+	ObserveModuleBlocker(MetricKeyBeginBlocker, "mint", start)
+
+	out := gatherPrometheus(t)
+	require.Contains(t, out, `tx_msg_latency_seconds_count{mode="deliver",msg_type_url="/cosmos.bank.MsgSend",route="bank"} 1`)
+	require.Contains(t, out, `tx_msg_gas_used_sum{mode="deliver",msg_type_url="/cosmos.bank.MsgSend",route="bank"} 50000`)
+	require.Contains(t, out, `tx_ante_rejected_total{code="5",codespace="sdk",mode="check"} 2`)
+	require.Contains(t, out, `module_blocker_latency_seconds_count{blocker="begin_blocker",module="mint"} 1`)
+
+	// the metrics already registered are reused
+	require.NoError(t, registerTxMetrics())
+	IncrAnteRejected("check", "sdk", 5)
+	require.Contains(t, gatherPrometheus(t), `tx_ante_rejected_total{code="5",codespace="sdk",mode="check"} 3`)
+}
+
+func gatherPrometheus(t *testing.T) string {
+	metricsFamilies, err := prometheus.DefaultGatherer.Gather()
+	require.NoError(t, err)
+
+	buf := &bytes.Buffer{}
+	e := expfmt.NewEncoder(buf, expfmt.FmtText)
+	for _, mf := range metricsFamilies {
+		require.NoError(t, e.Encode(mf))
+	}
+
+	return buf.String()
+}
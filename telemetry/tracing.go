@@ -0,0 +1,317 @@
+package telemetry
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// Span exporter names
+const (
+	SpanExporterStdout = "stdout"
+	SpanExporterFile   = "file"
+)
+
+// SpanData defines a finished span, as exported.
+type SpanData struct {
+	TraceID    string            `json:"trace_id"`
+	SpanID     string            `json:"span_id"`
+	ParentID   string            `json:"parent_id,omitempty"`
+	Name       string            `json:"name"`
+	Start      time.Time         `json:"start"`
+	End        time.Time         `json:"end"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+	Error      string            `json:"error,omitempty"`
+}
+
+// SpanExporter defines the interface of the exporters of the spans of a
+// Tracer. ExportSpan may be called concurrently.
+type SpanExporter interface {
+	ExportSpan(span SpanData) error
+	Shutdown() error
+}
+
+// SpanExporterConstructor returns the SpanExporter configured by the given
+// telemetry configuration.
+type SpanExporterConstructor func(cfg Config) (SpanExporter, error)
+
+var spanExporters = map[string]SpanExporterConstructor{
+	SpanExporterStdout: func(Config) (SpanExporter, error) {
+		return NewWriterSpanExporter(os.Stdout), nil
+	},
+	SpanExporterFile: func(cfg Config) (SpanExporter, error) {
+		return NewFileSpanExporter(cfg.TracingFile)
+	},
+}
+
+// RegisterSpanExporter registers a SpanExporter under the given name, by which
+// it can be selected in the telemetry configuration. It panics if a SpanExporter
+// is already registered under this name.
+func RegisterSpanExporter(name string, constructor SpanExporterConstructor) {
+	if _, ok := spanExporters[name]; ok {
+		panic(fmt.Sprintf("span exporter %s already registered", name))
+	}
+
+	spanExporters[name] = constructor
+}
+
+// Tracer opens spans, which are exported when they end. A nil Tracer is valid,
+// and opens nil spans, on which all operations are no-ops, so that tracing can
+// be disabled at no cost.
+type Tracer struct {
+	exporter SpanExporter
+}
+
+// NewTracer returns a reference to a new Tracer exporting its spans with the
+// given SpanExporter.
+func NewTracer(exporter SpanExporter) *Tracer {
+	return &Tracer{exporter: exporter}
+}
+
+// NewTracerFromConfig returns a reference to a new Tracer exporting its spans
+// with the SpanExporter selected in the given configuration, or nil if tracing
+// is disabled.
+func NewTracerFromConfig(cfg Config) (*Tracer, error) {
+	if !cfg.EnableTracing {
+		return nil, nil
+	}
+
+	constructor, ok := spanExporters[cfg.TracingExporter]
+	if !ok {
+		return nil, fmt.Errorf("unknown span exporter: %s", cfg.TracingExporter)
+	}
+
+	exporter, err := constructor(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTracer(exporter), nil
+}
+
+// StartSpan opens a root span, starting a new trace.
+func (t *Tracer) StartSpan(name string) *Span {
+	if t == nil {
+		return nil
+	}
+
+	return t.startSpan(name, randomID(16), "")
+}
+
+// Shutdown shuts the SpanExporter of the Tracer down.
+func (t *Tracer) Shutdown() error {
+	if t == nil {
+		return nil
+	}
+
+	return t.exporter.Shutdown()
+}
+
+// StartRecordingSpan opens a root span whose descendants are recorded when
+// they end, rather than exported, so that they can be exported later as the
+// descendants of another span with AdoptRecorded. The recording span itself is
+// never exported.
+func (t *Tracer) StartRecordingSpan(name string) *Span {
+	if t == nil {
+		return nil
+	}
+
+	span := t.startSpan(name, randomID(16), "")
+	span.recorder = &spanRecorder{}
+
+	return span
+}
+
+func (t *Tracer) startSpan(name, traceID, parentID string) *Span {
+	return &Span{
+		tracer: t,
+		data: SpanData{
+			TraceID:  traceID,
+			SpanID:   randomID(8),
+			ParentID: parentID,
+			Name:     name,
+			Start:    time.Now().UTC(),
+		},
+	}
+}
+
+// Span defines an operation of a trace, possibly the child of another one. A
+// Span isn't safe for concurrent use, and all its operations are no-ops on a
+// nil Span.
+type Span struct {
+	tracer *Tracer
+	data   SpanData
+
+	// records the ended spans of a recording span's descendants, nil if the
+	// span isn't recorded
+	recorder *spanRecorder
+}
+
+// spanRecorder holds the spans ended under a recording span.
+type spanRecorder struct {
+	spans []SpanData
+}
+
+// StartChild opens a child span of s.
+func (s *Span) StartChild(name string) *Span {
+	if s == nil {
+		return nil
+	}
+
+	child := s.tracer.startSpan(name, s.data.TraceID, s.data.SpanID)
+	child.recorder = s.recorder
+
+	return child
+}
+
+// AdoptRecorded exports the spans recorded under the recording span r as
+// descendants of s, in the trace of s: the children of r become children of
+// s. The spans keep the times at which they were recorded.
+func (s *Span) AdoptRecorded(r *Span) {
+	if s == nil || r == nil || r.recorder == nil {
+		return
+	}
+
+	for _, data := range r.recorder.spans {
+		data.TraceID = s.data.TraceID
+		if data.ParentID == r.data.SpanID {
+			data.ParentID = s.data.SpanID
+		}
+
+		s.export(data)
+	}
+
+	r.recorder.spans = nil
+}
+
+// SetAttribute sets an attribute of the span, formatting its value with
+// fmt.Sprint.
+func (s *Span) SetAttribute(key string, value interface{}) {
+	if s == nil {
+		return
+	}
+
+	if s.data.Attributes == nil {
+		s.data.Attributes = make(map[string]string)
+	}
+
+	s.data.Attributes[key] = fmt.Sprint(value)
+}
+
+// SetError records the error of the operation of the span, if any.
+func (s *Span) SetError(err error) {
+	if s == nil || err == nil {
+		return
+	}
+
+	s.data.Error = err.Error()
+}
+
+// End ends the span and exports it, or records it if it descends from a
+// recording span. Export errors are dropped, so that tracing never affects the
+// traced operations.
+func (s *Span) End() {
+	if s == nil {
+		return
+	}
+
+	// the recording span itself isn't recorded
+	if s.recorder != nil && s.data.ParentID == "" {
+		return
+	}
+
+	s.data.End = time.Now().UTC()
+	s.export(s.data)
+}
+
+// export exports a span, or records it if s is recorded.
+func (s *Span) export(data SpanData) {
+	if s.recorder != nil {
+		s.recorder.spans = append(s.recorder.spans, data)
+		return
+	}
+
+	_ = s.tracer.exporter.ExportSpan(data)
+}
+
+type spanContextKey struct{}
+
+// ContextWithSpan returns a copy of the given context.Context holding the span.
+func ContextWithSpan(ctx context.Context, span *Span) context.Context {
+	return context.WithValue(ctx, spanContextKey{}, span)
+}
+
+// SpanFromContext returns the span held by the given context.Context, or nil
+// if there's none.
+func SpanFromContext(ctx context.Context) *Span {
+	if ctx == nil {
+		return nil
+	}
+
+	span, _ := ctx.Value(spanContextKey{}).(*Span)
+	return span
+}
+
+// randomID returns the hex encoding of n random bytes.
+func randomID(n int) string {
+	bz := make([]byte, n)
+	if _, err := rand.Read(bz); err != nil {
+		panic(err)
+	}
+
+	return hex.EncodeToString(bz)
+}
+
+// writerSpanExporter is a SpanExporter writing the spans as JSON lines.
+type writerSpanExporter struct {
+	mtx sync.Mutex
+	enc *json.Encoder
+	w   io.Writer
+}
+
+// NewWriterSpanExporter returns a SpanExporter writing each span to the given
+// io.Writer as a line of JSON.
+func NewWriterSpanExporter(w io.Writer) SpanExporter {
+	return &writerSpanExporter{enc: json.NewEncoder(w), w: w}
+}
+
+func (e *writerSpanExporter) ExportSpan(span SpanData) error {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	return e.enc.Encode(span)
+}
+
+// Shutdown closes the io.Writer of the exporter if it is an io.Closer, unless
+// it is the standard output.
+func (e *writerSpanExporter) Shutdown() error {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	if c, ok := e.w.(io.Closer); ok && e.w != os.Stdout {
+		return c.Close()
+	}
+
+	return nil
+}
+
+// NewFileSpanExporter returns a SpanExporter appending each span to the file
+// at the given path as a line of JSON.
+func NewFileSpanExporter(path string) (SpanExporter, error) {
+	if path == "" {
+		return nil, fmt.Errorf("no file to export the spans to")
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open span file: %w", err)
+	}
+
+	return NewWriterSpanExporter(f), nil
+}
@@ -0,0 +1,169 @@
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type memSpanExporter struct {
+	spans []SpanData
+}
+
+func (e *memSpanExporter) ExportSpan(span SpanData) error {
+	e.spans = append(e.spans, span)
+	return nil
+}
+
+func (e *memSpanExporter) Shutdown() error { return nil }
+
+func TestTracer(t *testing.T) {
+	exporter := &memSpanExporter{}
+	tracer := NewTracer(exporter)
+
+	root := tracer.StartSpan("root")
+	root.SetAttribute("height", 5)
+
+	child := root.StartChild("child")
+	child.SetError(errors.New("failure"))
+	child.End()
+	root.End()
+
+	require.Len(t, exporter.spans, 2)
+	childData, rootData := exporter.spans[0], exporter.spans[1]
+
+	require.Equal(t, "root", rootData.Name)
+	require.Empty(t, rootData.ParentID)
+	require.Equal(t, map[string]string{"height": "5"}, rootData.Attributes)
+	require.False(t, rootData.End.Before(rootData.Start))
+
+	require.Equal(t, "child", childData.Name)
+	require.Equal(t, rootData.TraceID, childData.TraceID)
+	require.Equal(t, rootData.SpanID, childData.ParentID)
+	require.NotEqual(t, rootData.SpanID, childData.SpanID)
+	require.Equal(t, "failure", childData.Error)
+
+	// each root span starts a new trace
+	tracer.StartSpan("root").End()
+	require.NotEqual(t, rootData.TraceID, exporter.spans[2].TraceID)
+
+	// spans are passed through context.Context
+	ctx := ContextWithSpan(context.Background(), root)
+	require.Equal(t, root, SpanFromContext(ctx))
+	require.Nil(t, SpanFromContext(context.Background()))
+	require.Nil(t, SpanFromContext(nil)) // nolint:staticcheck
+}
+
+func TestTracer_Recording(t *testing.T) {
+	exporter := &memSpanExporter{}
+	tracer := NewTracer(exporter)
+
+	recording := tracer.StartRecordingSpan("recording")
+	child := recording.StartChild("child")
+	child.StartChild("grandchild").End()
+	child.End()
+	recording.End()
+
+	// the spans under a recording span are recorded, rather than exported
+	require.Empty(t, exporter.spans)
+
+	root := tracer.StartSpan("root")
+	root.AdoptRecorded(recording)
+	root.End()
+
+	require.Len(t, exporter.spans, 3)
+	grandchildData, childData, rootData := exporter.spans[0], exporter.spans[1], exporter.spans[2]
+
+	// the recorded spans are moved under the adopting span, in its trace
+	require.Equal(t, "child", childData.Name)
+	require.Equal(t, rootData.SpanID, childData.ParentID)
+	require.Equal(t, rootData.TraceID, childData.TraceID)
+
+	require.Equal(t, "grandchild", grandchildData.Name)
+	require.Equal(t, childData.SpanID, grandchildData.ParentID)
+	require.Equal(t, rootData.TraceID, grandchildData.TraceID)
+
+	// the recorded spans are adopted once
+	tracer.StartSpan("root").AdoptRecorded(recording)
+	require.Len(t, exporter.spans, 3)
+}
+
+func TestTracer_Nil(t *testing.T) {
+	var tracer *Tracer
+
+	span := tracer.StartSpan("root")
+	require.Nil(t, span)
+	require.Nil(t, span.StartChild("child"))
+	require.Nil(t, tracer.StartRecordingSpan("recording"))
+
+	require.NotPanics(t, func() {
+		span.SetAttribute("height", 5)
+		span.SetError(errors.New("failure"))
+		span.End()
+	})
+	require.NoError(t, tracer.Shutdown())
+}
+
+func TestWriterSpanExporter(t *testing.T) {
+	buf := &bytes.Buffer{}
+	tracer := NewTracer(NewWriterSpanExporter(buf))
+
+	root := tracer.StartSpan("root")
+	root.StartChild("child").End()
+	root.End()
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+
+	var span SpanData
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &span))
+	require.Equal(t, "root", span.Name)
+}
+
+func TestNewTracerFromConfig(t *testing.T) {
+	tracer, err := NewTracerFromConfig(Config{})
+	require.NoError(t, err)
+	require.Nil(t, tracer)
+
+	_, err = NewTracerFromConfig(Config{EnableTracing: true, TracingExporter: "unknown"})
+	require.Error(t, err)
+
+	_, err = NewTracerFromConfig(Config{EnableTracing: true, TracingExporter: SpanExporterFile})
+	require.Error(t, err)
+
+	dir, err := ioutil.TempDir("", "tracing")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "spans.json")
+	tracer, err = NewTracerFromConfig(Config{EnableTracing: true, TracingExporter: SpanExporterFile, TracingFile: path})
+	require.NoError(t, err)
+
+	tracer.StartSpan("root").End()
+	require.NoError(t, tracer.Shutdown())
+
+	bz, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	require.Contains(t, string(bz), `"name":"root"`)
+
+	// exporters can be registered by applications
+	exporter := &memSpanExporter{}
+	RegisterSpanExporter("mem", func(Config) (SpanExporter, error) { return exporter, nil })
+	require.Panics(t, func() {
+		RegisterSpanExporter("mem", func(Config) (SpanExporter, error) { return exporter, nil })
+	})
+
+	tracer, err = NewTracerFromConfig(Config{EnableTracing: true, TracingExporter: "mem"})
+	require.NoError(t, err)
+
+	tracer.StartSpan("root").End()
+	require.Len(t, exporter.spans, 1)
+}
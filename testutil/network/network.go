@@ -22,6 +22,7 @@ import (
 	"github.com/tendermint/tendermint/node"
 	tmclient "github.com/tendermint/tendermint/rpc/client"
 	dbm "github.com/tendermint/tm-db"
+	"google.golang.org/grpc"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -125,22 +126,24 @@ type (
 	// a client can make RPC and API calls and interact with any client command
 	// or handler.
 	Validator struct {
-		AppConfig  *srvconfig.Config
-		ClientCtx  client.Context
-		Ctx        *server.Context
-		Dir        string
-		NodeID     string
-		PubKey     crypto.PubKey
-		Moniker    string
-		APIAddress string
-		RPCAddress string
-		P2PAddress string
-		Address    sdk.AccAddress
-		ValAddress sdk.ValAddress
-		RPCClient  tmclient.Client
+		AppConfig   *srvconfig.Config
+		ClientCtx   client.Context
+		Ctx         *server.Context
+		Dir         string
+		NodeID      string
+		PubKey      crypto.PubKey
+		Moniker     string
+		APIAddress  string
+		RPCAddress  string
+		GRPCAddress string
+		P2PAddress  string
+		Address     sdk.AccAddress
+		ValAddress  sdk.ValAddress
+		RPCClient   tmclient.Client
 
 		tmNode *node.Node
 		api    *api.Server
+		grpc   *grpc.Server
 	}
 )
 
@@ -187,10 +190,11 @@ func New(t *testing.T, cfg Config) *Network {
 		tmCfg := ctx.Config
 		tmCfg.Consensus.TimeoutCommit = cfg.TimeoutCommit
 
-		// Only allow the first validator to expose an RPC and API server/client
-		// due to Tendermint in-process constraints.
+		// Only allow the first validator to expose an RPC, API and gRPC
+		// server/client due to Tendermint in-process constraints.
 		apiAddr := ""
 		tmCfg.RPC.ListenAddress = ""
+		appCfg.GRPC.Enable = false
 		if i == 0 {
 			apiListenAddr, _, err := server.FreeTCPAddr()
 			require.NoError(t, err)
@@ -203,6 +207,11 @@ func New(t *testing.T, cfg Config) *Network {
 			rpcAddr, _, err := server.FreeTCPAddr()
 			require.NoError(t, err)
 			tmCfg.RPC.ListenAddress = rpcAddr
+
+			_, grpcPort, err := server.FreeTCPAddr()
+			require.NoError(t, err)
+			appCfg.GRPC.Enable = true
+			appCfg.GRPC.Address = fmt.Sprintf("0.0.0.0:%s", grpcPort)
 		}
 
 		logger := log.NewNopLogger()
@@ -302,18 +311,19 @@ func New(t *testing.T, cfg Config) *Network {
 			WithAccountRetriever(cfg.AccountRetriever)
 
 		network.Validators[i] = &Validator{
-			AppConfig:  appCfg,
-			ClientCtx:  clientCtx,
-			Ctx:        ctx,
-			Dir:        filepath.Join(network.BaseDir, nodeDirName),
-			NodeID:     nodeID,
-			PubKey:     pubKey,
-			Moniker:    nodeDirName,
-			RPCAddress: tmCfg.RPC.ListenAddress,
-			P2PAddress: tmCfg.P2P.ListenAddress,
-			APIAddress: apiAddr,
-			Address:    addr,
-			ValAddress: sdk.ValAddress(addr),
+			AppConfig:   appCfg,
+			ClientCtx:   clientCtx,
+			Ctx:         ctx,
+			Dir:         filepath.Join(network.BaseDir, nodeDirName),
+			NodeID:      nodeID,
+			PubKey:      pubKey,
+			Moniker:     nodeDirName,
+			RPCAddress:  tmCfg.RPC.ListenAddress,
+			P2PAddress:  tmCfg.P2P.ListenAddress,
+			APIAddress:  apiAddr,
+			GRPCAddress: appCfg.GRPC.Address,
+			Address:     addr,
+			ValAddress:  sdk.ValAddress(addr),
 		}
 	}
 
@@ -406,6 +416,10 @@ func (n *Network) Cleanup() {
 		if v.api != nil {
 			_ = v.api.Close()
 		}
+
+		if v.grpc != nil {
+			v.grpc.Stop()
+		}
 	}
 
 	if n.config.CleanupDir {
@@ -10,6 +10,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/store/gaskv"
 	stypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 )
 
 /*
@@ -219,6 +220,19 @@ func (c Context) Value(key interface{}) interface{} {
 	return c.ctx.Value(key)
 }
 
+// Span returns the tracing span of the operation the Context is given to, or
+// nil if it isn't traced.
+func (c Context) Span() *telemetry.Span {
+	return telemetry.SpanFromContext(c.ctx)
+}
+
+// WithSpan returns a Context with an updated tracing span, under which the
+// child spans of the operations it is given to are opened.
+func (c Context) WithSpan(span *telemetry.Span) Context {
+	c.ctx = telemetry.ContextWithSpan(c.ctx, span)
+	return c
+}
+
 // ----------------------------------------------------------------------------
 // Store / Caching
 // ----------------------------------------------------------------------------
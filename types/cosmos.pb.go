@@ -246,6 +246,9 @@ type GasInfo struct {
 	GasWanted uint64 `protobuf:"varint,1,opt,name=gas_wanted,json=gasWanted,proto3" json:"gas_wanted,omitempty" yaml:"gas_wanted"`
 	// GasUsed is the amount of gas actually consumed.
 	GasUsed uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty" yaml:"gas_used"`
+	// GasConsumption is the gas consumed by descriptor. It is only recorded in
+	// simulations and in DeliverTx in debug mode.
+	GasConsumption []GasConsumption `protobuf:"bytes,3,rep,name=gas_consumption,json=gasConsumption,proto3" json:"gas_consumption" yaml:"gas_consumption,omitempty"`
 }
 
 func (m *GasInfo) Reset()      { *m = GasInfo{} }
@@ -294,6 +297,65 @@ func (m *GasInfo) GetGasUsed() uint64 {
 	return 0
 }
 
+func (m *GasInfo) GetGasConsumption() []GasConsumption {
+	if m != nil {
+		return m.GasConsumption
+	}
+	return nil
+}
+
+// GasConsumption defines the gas consumed for a gas descriptor, e.g. ReadFlat.
+type GasConsumption struct {
+	GasDescriptor string `protobuf:"bytes,1,opt,name=descriptor,proto3" json:"descriptor,omitempty" yaml:"descriptor"`
+	Gas           uint64 `protobuf:"varint,2,opt,name=gas,proto3" json:"gas,omitempty" yaml:"gas"`
+}
+
+func (m *GasConsumption) Reset()      { *m = GasConsumption{} }
+func (*GasConsumption) ProtoMessage() {}
+func (*GasConsumption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_809e58c688fefd51, []int{6}
+}
+func (m *GasConsumption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasConsumption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasConsumption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasConsumption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasConsumption.Merge(m, src)
+}
+func (m *GasConsumption) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasConsumption) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasConsumption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasConsumption proto.InternalMessageInfo
+
+func (m *GasConsumption) GetGasDescriptor() string {
+	if m != nil {
+		return m.GasDescriptor
+	}
+	return ""
+}
+
+func (m *GasConsumption) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
 // Result is the union of ResponseFormat and ResponseCheckTx.
 type Result struct {
 	// Data is any data returned from message or handler execution. It MUST be length
@@ -304,12 +366,15 @@ type Result struct {
 	// Events contains a slice of Event objects that were emitted during message or
 	// handler execution.
 	Events []types.Event `protobuf:"bytes,3,rep,name=events,proto3" json:"events"`
+	// MsgResults contains the result of each message. It is only recorded in
+	// simulations and in DeliverTx in debug mode.
+	MsgResults []*MsgResult `protobuf:"bytes,4,rep,name=msg_results,json=msgResults,proto3" json:"msg_results,omitempty" yaml:"msg_results,omitempty"`
 }
 
 func (m *Result) Reset()      { *m = Result{} }
 func (*Result) ProtoMessage() {}
 func (*Result) Descriptor() ([]byte, []int) {
-	return fileDescriptor_809e58c688fefd51, []int{6}
+	return fileDescriptor_809e58c688fefd51, []int{7}
 }
 func (m *Result) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -338,6 +403,53 @@ func (m *Result) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Result proto.InternalMessageInfo
 
+// MsgResult defines the result of the execution of a message, with the gas it
+// consumed.
+type MsgResult struct {
+	// MsgType is the type of the message.
+	MsgType string `protobuf:"bytes,1,opt,name=msg_type,json=msgType,proto3" json:"msg_type,omitempty" yaml:"msg_type"`
+	// GasUsed is the gas consumed by the message execution, and GasConsumption
+	// is this gas by descriptor.
+	GasUsed        uint64           `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty" yaml:"gas_used"`
+	GasConsumption []GasConsumption `protobuf:"bytes,3,rep,name=gas_consumption,json=gasConsumption,proto3" json:"gas_consumption" yaml:"gas_consumption"`
+	// Data and Events are the data returned and the events emitted by the
+	// message execution.
+	Data   []byte        `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	Events []types.Event `protobuf:"bytes,5,rep,name=events,proto3" json:"events"`
+}
+
+func (m *MsgResult) Reset()      { *m = MsgResult{} }
+func (*MsgResult) ProtoMessage() {}
+func (*MsgResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_809e58c688fefd51, []int{8}
+}
+func (m *MsgResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResult.Merge(m, src)
+}
+func (m *MsgResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResult proto.InternalMessageInfo
+
 // SimulationResponse defines the response generated when a transaction is
 // successfully simulated.
 type SimulationResponse struct {
@@ -348,7 +460,7 @@ type SimulationResponse struct {
 func (m *SimulationResponse) Reset()      { *m = SimulationResponse{} }
 func (*SimulationResponse) ProtoMessage() {}
 func (*SimulationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_809e58c688fefd51, []int{7}
+	return fileDescriptor_809e58c688fefd51, []int{9}
 }
 func (m *SimulationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -393,7 +505,7 @@ type MsgData struct {
 func (m *MsgData) Reset()      { *m = MsgData{} }
 func (*MsgData) ProtoMessage() {}
 func (*MsgData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_809e58c688fefd51, []int{8}
+	return fileDescriptor_809e58c688fefd51, []int{10}
 }
 func (m *MsgData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -445,7 +557,7 @@ type TxData struct {
 func (m *TxData) Reset()      { *m = TxData{} }
 func (*TxData) ProtoMessage() {}
 func (*TxData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_809e58c688fefd51, []int{9}
+	return fileDescriptor_809e58c688fefd51, []int{11}
 }
 func (m *TxData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -488,7 +600,9 @@ func init() {
 	proto.RegisterType((*DecProto)(nil), "cosmos.DecProto")
 	proto.RegisterType((*ValAddresses)(nil), "cosmos.ValAddresses")
 	proto.RegisterType((*GasInfo)(nil), "cosmos.GasInfo")
+	proto.RegisterType((*GasConsumption)(nil), "cosmos.GasConsumption")
 	proto.RegisterType((*Result)(nil), "cosmos.Result")
+	proto.RegisterType((*MsgResult)(nil), "cosmos.MsgResult")
 	proto.RegisterType((*SimulationResponse)(nil), "cosmos.SimulationResponse")
 	proto.RegisterType((*MsgData)(nil), "cosmos.MsgData")
 	proto.RegisterType((*TxData)(nil), "cosmos.TxData")
@@ -497,43 +611,54 @@ func init() {
 func init() { proto.RegisterFile("cosmos/cosmos.proto", fileDescriptor_809e58c688fefd51) }
 
 var fileDescriptor_809e58c688fefd51 = []byte{
-	// 567 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0xc1, 0x6f, 0xd3, 0x3e,
-	0x14, 0x4e, 0x96, 0xfc, 0xd2, 0xee, 0x75, 0xda, 0x7e, 0x78, 0x43, 0x2a, 0x13, 0x24, 0x25, 0x93,
-	0xd0, 0x90, 0x20, 0x95, 0xba, 0x9d, 0x7a, 0x40, 0x22, 0x14, 0x4d, 0x45, 0x42, 0x42, 0x61, 0x80,
-	0xc4, 0x65, 0x72, 0x13, 0x2f, 0x44, 0x34, 0x76, 0x55, 0xbb, 0x40, 0x6f, 0x3b, 0x72, 0xe4, 0x4f,
-	0xd8, 0x9f, 0xb3, 0x63, 0x8f, 0x13, 0x42, 0x11, 0xb4, 0x17, 0xce, 0x3b, 0x72, 0x42, 0xb6, 0xb3,
-	0x66, 0xdb, 0x91, 0x4b, 0xf2, 0xfc, 0xde, 0xf7, 0x3e, 0x7f, 0xfe, 0xfc, 0x0c, 0x9b, 0x31, 0xe3,
-	0x39, 0xe3, 0x6d, 0xfd, 0x0b, 0x46, 0x63, 0x26, 0x18, 0x72, 0xf4, 0x6a, 0x7b, 0x2b, 0x65, 0x29,
-	0x53, 0xa9, 0xb6, 0x8c, 0x74, 0x75, 0xfb, 0xbe, 0x20, 0x34, 0x21, 0xe3, 0x3c, 0xa3, 0xa2, 0x8d,
-	0x07, 0x71, 0xd6, 0x16, 0xd3, 0x11, 0xe1, 0xfa, 0xab, 0x21, 0xfe, 0x01, 0xd8, 0xcf, 0x58, 0x46,
-	0xd1, 0x16, 0xfc, 0x97, 0x10, 0xca, 0xf2, 0xa6, 0xd9, 0x32, 0x77, 0x57, 0x23, 0xbd, 0x40, 0x3b,
-	0xe0, 0xe0, 0x9c, 0x4d, 0xa8, 0x68, 0xae, 0xc8, 0x74, 0xd8, 0x38, 0x2b, 0x3c, 0xe3, 0x7b, 0xe1,
-	0x59, 0x7d, 0x2a, 0xa2, 0xb2, 0xd4, 0xb5, 0x7f, 0x9f, 0x7a, 0xa6, 0xff, 0x02, 0x6a, 0x3d, 0x12,
-	0xff, 0x0b, 0x57, 0x8f, 0xc4, 0x37, 0xb8, 0x1e, 0x42, 0xbd, 0x4f, 0xc5, 0x2b, 0x75, 0xc2, 0x7b,
-	0x60, 0x65, 0x54, 0x68, 0xaa, 0xeb, 0xfb, 0xcb, 0xbc, 0x84, 0xf6, 0x48, 0xbc, 0x84, 0x26, 0x24,
-	0xbe, 0x09, 0x95, 0xf4, 0x32, 0xef, 0x87, 0xb0, 0xf6, 0x16, 0x0f, 0x9f, 0x26, 0xc9, 0x98, 0x70,
-	0x4e, 0x38, 0x7a, 0x04, 0xab, 0xf8, 0x72, 0xd1, 0x34, 0x5b, 0xd6, 0xee, 0x5a, 0xb8, 0xfe, 0xa7,
-	0xf0, 0xa0, 0x02, 0x45, 0x15, 0xa0, 0x6b, 0x9f, 0xfc, 0x68, 0x99, 0x3e, 0x83, 0xda, 0x01, 0xe6,
-	0x7d, 0x7a, 0xcc, 0xd0, 0x3e, 0x40, 0x8a, 0xf9, 0xd1, 0x67, 0x4c, 0x05, 0x49, 0xd4, 0xa6, 0x76,
-	0x78, 0xfb, 0xa2, 0xf0, 0x6e, 0x4d, 0x71, 0x3e, 0xec, 0xfa, 0x55, 0xcd, 0x8f, 0x56, 0x53, 0xcc,
-	0xdf, 0xa9, 0x18, 0x05, 0x50, 0x97, 0x95, 0x09, 0x27, 0x89, 0xf2, 0xc1, 0x0e, 0x37, 0x2f, 0x0a,
-	0x6f, 0xa3, 0xea, 0x91, 0x15, 0x3f, 0xaa, 0xa5, 0x98, 0xbf, 0x91, 0xd1, 0x08, 0x9c, 0x88, 0xf0,
-	0xc9, 0x50, 0x20, 0x04, 0x76, 0x82, 0x05, 0x56, 0x3b, 0xad, 0x45, 0x2a, 0x46, 0xff, 0x83, 0x35,
-	0x64, 0xa9, 0x36, 0x34, 0x92, 0x21, 0xea, 0x82, 0x43, 0x3e, 0x11, 0x2a, 0x78, 0xd3, 0x6a, 0x59,
-	0xbb, 0x8d, 0xce, 0xdd, 0xa0, 0x9a, 0x81, 0x40, 0xce, 0x40, 0xa0, 0x6f, 0xff, 0xb9, 0x04, 0x85,
-	0xb6, 0x34, 0x29, 0x2a, 0x3b, 0xba, 0xf6, 0xd7, 0x53, 0xcf, 0xf0, 0xc7, 0x80, 0x5e, 0x67, 0xf9,
-	0x64, 0x88, 0x45, 0xc6, 0x68, 0x44, 0xf8, 0x88, 0x51, 0x4e, 0xd0, 0xbe, 0xd6, 0x9d, 0xd1, 0x63,
-	0xa6, 0x14, 0x34, 0x3a, 0x1b, 0x41, 0x39, 0x89, 0xa5, 0x21, 0x61, 0x5d, 0x92, 0xcd, 0x0a, 0xcf,
-	0x54, 0xea, 0x95, 0x47, 0x0f, 0xc0, 0x19, 0x2b, 0xf5, 0x4a, 0x62, 0xa3, 0xb3, 0x7e, 0xd9, 0xa3,
-	0xcf, 0x14, 0x95, 0x55, 0xff, 0x09, 0xd4, 0x5e, 0xf2, 0xb4, 0x27, 0x8f, 0x74, 0x07, 0xea, 0x39,
-	0x4f, 0x8f, 0xa4, 0xca, 0x72, 0x7e, 0x6a, 0x39, 0x4f, 0x0f, 0xa7, 0x23, 0xb2, 0x74, 0x60, 0xa5,
-	0x72, 0xa0, 0xbc, 0x96, 0x3d, 0x70, 0x0e, 0xbf, 0xa8, 0xf6, 0x9d, 0xa5, 0x4b, 0xd6, 0x55, 0x8d,
-	0x25, 0xfb, 0xd5, 0xa6, 0xb0, 0x77, 0xfe, 0xcb, 0x35, 0x4e, 0xe6, 0xae, 0x71, 0x36, 0x77, 0xcd,
-	0xd9, 0xdc, 0x35, 0x7f, 0xce, 0x5d, 0xf3, 0xdb, 0xc2, 0x35, 0x66, 0x0b, 0xd7, 0x38, 0x5f, 0xb8,
-	0xc6, 0x7b, 0x3f, 0xcd, 0xc4, 0x87, 0xc9, 0x20, 0x88, 0x59, 0xde, 0xbe, 0xf6, 0xfa, 0x1e, 0xf3,
-	0xe4, 0xa3, 0x7e, 0x46, 0x03, 0x47, 0xbd, 0xa3, 0xbd, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x39,
-	0x8e, 0x45, 0x07, 0x9f, 0x03, 0x00, 0x00,
+	// 750 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xc1, 0x4f, 0x13, 0x4f,
+	0x14, 0xee, 0xb2, 0x4b, 0x4b, 0x5f, 0xf9, 0x95, 0x1f, 0x03, 0x92, 0x4a, 0x70, 0xb7, 0x0e, 0x09,
+	0xa9, 0x09, 0xb6, 0x09, 0x70, 0xea, 0xc1, 0xc4, 0xa5, 0xda, 0x60, 0x42, 0x62, 0x56, 0xd4, 0xc4,
+	0x0b, 0x19, 0x76, 0x87, 0x75, 0x63, 0x77, 0xa7, 0xe9, 0x4c, 0xd5, 0x7a, 0xe2, 0xe8, 0xd1, 0x3f,
+	0x81, 0xf8, 0xc7, 0x18, 0x8e, 0x1c, 0x09, 0x31, 0x1b, 0x2d, 0x17, 0xcf, 0x3d, 0x7a, 0x32, 0xb3,
+	0xbb, 0xed, 0x6e, 0xc1, 0x0b, 0x5e, 0xda, 0xd9, 0xf9, 0xde, 0xf7, 0xe6, 0xbd, 0xef, 0x7d, 0x33,
+	0xb0, 0x64, 0x33, 0xee, 0x33, 0xde, 0x88, 0xff, 0xea, 0xdd, 0x1e, 0x13, 0x0c, 0xe5, 0xe3, 0xaf,
+	0xd5, 0x65, 0x97, 0xb9, 0x2c, 0xda, 0x6a, 0xc8, 0x55, 0x8c, 0xae, 0xde, 0x17, 0x34, 0x70, 0x68,
+	0xcf, 0xf7, 0x02, 0xd1, 0x20, 0x47, 0xb6, 0xd7, 0x10, 0x83, 0x2e, 0xe5, 0xf1, 0x6f, 0x1c, 0x82,
+	0xdb, 0xa0, 0xed, 0x32, 0x2f, 0x40, 0xcb, 0x30, 0xeb, 0xd0, 0x80, 0xf9, 0x15, 0xa5, 0xaa, 0xd4,
+	0x8a, 0x56, 0xfc, 0x81, 0xd6, 0x21, 0x4f, 0x7c, 0xd6, 0x0f, 0x44, 0x65, 0x46, 0x6e, 0x9b, 0xa5,
+	0xb3, 0xd0, 0xc8, 0x5d, 0x86, 0x86, 0xba, 0x17, 0x08, 0x2b, 0x81, 0x9a, 0xda, 0xaf, 0x53, 0x43,
+	0xc1, 0xcf, 0xa0, 0xd0, 0xa2, 0xf6, 0xbf, 0xe4, 0x6a, 0x51, 0xfb, 0x5a, 0xae, 0x07, 0x30, 0xb7,
+	0x17, 0x88, 0xe7, 0x51, 0x87, 0xf7, 0x40, 0xf5, 0x02, 0x51, 0x51, 0xa6, 0x39, 0xf2, 0x7c, 0xb9,
+	0x2f, 0x43, 0x5b, 0xd4, 0x9e, 0x84, 0x3a, 0xd4, 0xae, 0x28, 0x37, 0xd3, 0xcb, 0x7d, 0x6c, 0xc2,
+	0xfc, 0x2b, 0xd2, 0x79, 0xec, 0x38, 0x3d, 0xca, 0x39, 0xe5, 0x68, 0x13, 0x8a, 0x64, 0xfc, 0x51,
+	0x51, 0xaa, 0x6a, 0x6d, 0xde, 0x2c, 0xff, 0x0e, 0x0d, 0x48, 0x83, 0xac, 0x34, 0xa0, 0xa9, 0x9d,
+	0x7c, 0xaf, 0x2a, 0xf8, 0x52, 0x81, 0x42, 0x9b, 0xf0, 0xbd, 0xe0, 0x98, 0xa1, 0x1d, 0x00, 0x97,
+	0xf0, 0xc3, 0x0f, 0x24, 0x10, 0xd4, 0x89, 0x4e, 0xd5, 0xcc, 0x3b, 0xa3, 0xd0, 0x58, 0x1c, 0x10,
+	0xbf, 0xd3, 0xc4, 0x29, 0x86, 0xad, 0xa2, 0x4b, 0xf8, 0xeb, 0x68, 0x8d, 0xea, 0x30, 0x27, 0x91,
+	0x3e, 0xa7, 0x4e, 0x24, 0x84, 0x66, 0x2e, 0x8d, 0x42, 0x63, 0x21, 0xe5, 0x48, 0x04, 0x5b, 0x05,
+	0x97, 0xf0, 0x97, 0x9c, 0x3a, 0xc8, 0x83, 0x05, 0xb9, 0x6b, 0xb3, 0x80, 0xf7, 0xfd, 0xae, 0xf0,
+	0x58, 0x50, 0x51, 0xab, 0x6a, 0xad, 0xb4, 0xb5, 0x52, 0x4f, 0x9c, 0xd0, 0x26, 0x7c, 0x37, 0x45,
+	0xcd, 0x9a, 0x6c, 0x7c, 0x14, 0x1a, 0xd5, 0x34, 0x65, 0x86, 0xbc, 0xc9, 0x7c, 0x4f, 0x50, 0xbf,
+	0x2b, 0x06, 0xd8, 0x2a, 0xbb, 0x53, 0x4c, 0xfc, 0x09, 0xca, 0xd3, 0xb9, 0xd0, 0x53, 0x00, 0x87,
+	0x72, 0xbb, 0xe7, 0x75, 0x05, 0xeb, 0x25, 0xc2, 0x6e, 0x0c, 0x43, 0xe3, 0xbf, 0x36, 0xe1, 0xad,
+	0x09, 0x90, 0xf6, 0x9c, 0x06, 0x63, 0x2b, 0xc3, 0x44, 0x55, 0x50, 0x5d, 0xc2, 0x93, 0x7e, 0xcb,
+	0xa3, 0xd0, 0x80, 0x49, 0x71, 0xd8, 0x92, 0x10, 0xfe, 0xa6, 0x40, 0xde, 0xa2, 0xbc, 0xdf, 0x11,
+	0x08, 0x81, 0xe6, 0x10, 0x41, 0xa2, 0xe3, 0xe6, 0xad, 0x68, 0x8d, 0xfe, 0x07, 0xb5, 0xc3, 0xdc,
+	0xd8, 0x39, 0x96, 0x5c, 0xa2, 0x26, 0xe4, 0xe9, 0x7b, 0x1a, 0x08, 0x9e, 0xc8, 0xb1, 0x56, 0x4f,
+	0xcd, 0x5e, 0x97, 0x66, 0xaf, 0xc7, 0x36, 0x7f, 0x22, 0x83, 0x4c, 0x4d, 0x8a, 0x62, 0x25, 0x0c,
+	0x74, 0x00, 0x25, 0x9f, 0xbb, 0x87, 0xbd, 0xe8, 0x3c, 0x5e, 0xd1, 0xa2, 0x04, 0x8b, 0x63, 0x3d,
+	0xf7, 0xb9, 0x1b, 0x57, 0x62, 0x56, 0x47, 0xa1, 0xb1, 0x16, 0x57, 0x9a, 0x89, 0xcf, 0x4a, 0x08,
+	0xfe, 0x38, 0x98, 0x37, 0xb5, 0xcf, 0xa7, 0x46, 0x0e, 0x7f, 0x9d, 0x81, 0xe2, 0x24, 0x83, 0x9c,
+	0xb6, 0x64, 0xca, 0x52, 0x12, 0xf9, 0x32, 0xd3, 0x1e, 0x23, 0xd8, 0x2a, 0xf8, 0xdc, 0x3d, 0x18,
+	0x74, 0xe9, 0xad, 0xdd, 0x71, 0x78, 0x5b, 0x77, 0xe8, 0x89, 0x3b, 0x56, 0xfe, 0xea, 0x8e, 0x1b,
+	0x9e, 0x98, 0x0c, 0x43, 0xcb, 0x0c, 0x23, 0x95, 0x7e, 0xf6, 0xb6, 0xd2, 0x27, 0x22, 0xf5, 0x00,
+	0xbd, 0xf0, 0xfc, 0x7e, 0x87, 0xc8, 0x33, 0x2c, 0xca, 0xbb, 0x2c, 0xe0, 0x14, 0xed, 0xc4, 0xcd,
+	0x7b, 0xc1, 0x31, 0x8b, 0xc4, 0x2a, 0x6d, 0x2d, 0x64, 0xba, 0x90, 0x77, 0xce, 0x9c, 0x93, 0xc9,
+	0xce, 0x43, 0x43, 0x89, 0x24, 0x88, 0xae, 0xe1, 0x06, 0xe4, 0xe3, 0xc1, 0x44, 0x82, 0x95, 0xb6,
+	0xca, 0x63, 0x4e, 0x3c, 0x02, 0x2b, 0x41, 0xf1, 0x23, 0x28, 0xec, 0x73, 0xb7, 0x25, 0x1b, 0xb8,
+	0x7b, 0x7d, 0x2a, 0xe9, 0x00, 0xc6, 0xfd, 0xce, 0xa4, 0xfd, 0x26, 0x57, 0x7f, 0x1b, 0xf2, 0x07,
+	0x1f, 0x23, 0xfa, 0xfa, 0xc4, 0xa0, 0x6a, 0xb6, 0xc6, 0x24, 0x7b, 0x96, 0x64, 0xb6, 0x2e, 0x7e,
+	0xea, 0xb9, 0x93, 0xa1, 0x9e, 0x3b, 0x1b, 0xea, 0xca, 0xf9, 0x50, 0x57, 0x7e, 0x0c, 0x75, 0xe5,
+	0xcb, 0x95, 0x9e, 0x3b, 0xbf, 0xd2, 0x73, 0x17, 0x57, 0x7a, 0xee, 0x0d, 0x76, 0x3d, 0xf1, 0xb6,
+	0x7f, 0x54, 0xb7, 0x99, 0xdf, 0x98, 0x7a, 0xe1, 0x1f, 0x72, 0xe7, 0x5d, 0xfc, 0x54, 0x1f, 0xe5,
+	0xa3, 0xb7, 0x7a, 0xfb, 0xcf, 0x00, 0x74, 0x0f, 0x68, 0xa1, 0x03, 0x06, 0x00, 0x00,
 }
 
 func (this *Coin) Equal(that interface{}) bool {
@@ -788,6 +913,20 @@ func (m *GasInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.GasConsumption) > 0 {
+		for iNdEx := len(m.GasConsumption) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GasConsumption[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCosmos(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.GasUsed != 0 {
 		i = encodeVarintCosmos(dAtA, i, uint64(m.GasUsed))
 		i--
@@ -801,6 +940,41 @@ func (m *GasInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GasConsumption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasConsumption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasConsumption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Gas != 0 {
+		i = encodeVarintCosmos(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.GasDescriptor) > 0 {
+		i -= len(m.GasDescriptor)
+		copy(dAtA[i:], m.GasDescriptor)
+		i = encodeVarintCosmos(dAtA, i, uint64(len(m.GasDescriptor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Result) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -821,6 +995,20 @@ func (m *Result) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MsgResults) > 0 {
+		for iNdEx := len(m.MsgResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCosmos(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Events) > 0 {
 		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -852,6 +1040,76 @@ func (m *Result) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCosmos(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintCosmos(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.GasConsumption) > 0 {
+		for iNdEx := len(m.GasConsumption) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GasConsumption[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCosmos(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintCosmos(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MsgType) > 0 {
+		i -= len(m.MsgType)
+		copy(dAtA[i:], m.MsgType)
+		i = encodeVarintCosmos(dAtA, i, uint64(len(m.MsgType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SimulationResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1061,6 +1319,28 @@ func (m *GasInfo) Size() (n int) {
 	if m.GasUsed != 0 {
 		n += 1 + sovCosmos(uint64(m.GasUsed))
 	}
+	if len(m.GasConsumption) > 0 {
+		for _, e := range m.GasConsumption {
+			l = e.Size()
+			n += 1 + l + sovCosmos(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GasConsumption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GasDescriptor)
+	if l > 0 {
+		n += 1 + l + sovCosmos(uint64(l))
+	}
+	if m.Gas != 0 {
+		n += 1 + sovCosmos(uint64(m.Gas))
+	}
 	return n
 }
 
@@ -1084,6 +1364,44 @@ func (m *Result) Size() (n int) {
 			n += 1 + l + sovCosmos(uint64(l))
 		}
 	}
+	if len(m.MsgResults) > 0 {
+		for _, e := range m.MsgResults {
+			l = e.Size()
+			n += 1 + l + sovCosmos(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgType)
+	if l > 0 {
+		n += 1 + l + sovCosmos(uint64(l))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovCosmos(uint64(m.GasUsed))
+	}
+	if len(m.GasConsumption) > 0 {
+		for _, e := range m.GasConsumption {
+			l = e.Size()
+			n += 1 + l + sovCosmos(uint64(l))
+		}
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovCosmos(uint64(l))
+	}
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovCosmos(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1748,6 +2066,40 @@ func (m *GasInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasConsumption", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCosmos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasConsumption = append(m.GasConsumption, GasConsumption{})
+			if err := m.GasConsumption[len(m.GasConsumption)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCosmos(dAtA[iNdEx:])
@@ -1772,7 +2124,7 @@ func (m *GasInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Result) Unmarshal(dAtA []byte) error {
+func (m *GasConsumption) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1795,15 +2147,119 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Result: wiretype end group for non-group")
+			return fmt.Errorf("proto: GasConsumption: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Result: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GasConsumption: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasDescriptor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCosmos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasDescriptor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCosmos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCosmos
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCosmos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Result) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCosmos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Result: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Result: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1901,6 +2357,246 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCosmos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgResults = append(m.MsgResults, &MsgResult{})
+			if err := m.MsgResults[len(m.MsgResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCosmos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCosmos
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCosmos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCosmos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCosmos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasConsumption", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCosmos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasConsumption = append(m.GasConsumption, GasConsumption{})
+			if err := m.GasConsumption[len(m.GasConsumption)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCosmos
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosmos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCosmos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosmos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, types.Event{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCosmos(dAtA[iNdEx:])
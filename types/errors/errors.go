@@ -106,6 +106,24 @@ var (
 	// ErrInvalidType defines an error an invalid type.
 	ErrInvalidType = Register(RootCodespace, 29, "invalid type")
 
+	// ErrTxTimeoutHeight defines an error for when a tx is rejected due to an
+	// explicitly set timeout height.
+	ErrTxTimeoutHeight = Register(RootCodespace, 30, "tx timeout height")
+
+	// ErrUnknownExtensionOptions defines an error for unknown extension options.
+	ErrUnknownExtensionOptions = Register(RootCodespace, 31, "unknown extension options")
+
+	// ErrNotFound defines an error when a requested entity doesn't exist.
+	ErrNotFound = Register(RootCodespace, 32, "not found")
+
+	// ErrLogic defines an internal logic error, e.g. an invariant or assertion
+	// that is violated. It is a programmer error, not a user-facing error.
+	ErrLogic = Register(RootCodespace, 33, "internal logic error")
+
+	// ErrQueryTimeout defines an error for when a query exceeds the time it is
+	// allowed to run for.
+	ErrQueryTimeout = Register(RootCodespace, 34, "query timeout")
+
 	// ErrPanic is only set when we recover from a panic, so we know to
 	// redact potentially sensitive system info
 	ErrPanic = Register(UndefinedCodespace, 111222, "panic")
@@ -0,0 +1,8 @@
+package grpc
+
+const (
+	// GRPCBlockHeightHeader is the gRPC header for block height. It is read from
+	// the request metadata to pin a query to a given height, and is echoed back
+	// in the response headers with the height the query was served at.
+	GRPCBlockHeightHeader = "x-cosmos-block-height"
+)
@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // Handler defines the core of the state transition function of an application.
 type Handler func(ctx Context, msg Msg) (*Result, error)
 
@@ -7,6 +9,17 @@ type Handler func(ctx Context, msg Msg) (*Result, error)
 // If newCtx.IsZero(), ctx is used instead.
 type AnteHandler func(ctx Context, tx Tx, simulate bool) (newCtx Context, err error)
 
+// PostHandler processes transactions after their internal messages are handled,
+// e.g. to refund the fees of the gas they didn't consume. It is given the
+// GasMeter of the tx, and whether all the messages succeeded. If it fails, the
+// tx fails. If newCtx.IsZero(), ctx is used instead.
+type PostHandler func(ctx Context, tx Tx, simulate, success bool) (newCtx Context, err error)
+
+// BlockFinalizer applies the state changes deferred by the txs of a block to
+// the end of the block, e.g. the fees credited once per block. It is run
+// before the EndBlocker.
+type BlockFinalizer func(ctx Context)
+
 // AnteDecorator wraps the next AnteHandler to perform custom pre- and post-processing.
 type AnteDecorator interface {
 	AnteHandle(ctx Context, tx Tx, simulate bool, next AnteHandler) (newCtx Context, err error)
@@ -37,7 +50,23 @@ func ChainAnteDecorators(chain ...AnteDecorator) AnteHandler {
 	}
 
 	return func(ctx Context, tx Tx, simulate bool) (Context, error) {
-		return chain[0].AnteHandle(ctx, tx, simulate, ChainAnteDecorators(chain[1:]...))
+		parent := ctx.Span()
+		if parent == nil || (chain[0] == Terminator{}) {
+			return chain[0].AnteHandle(ctx, tx, simulate, ChainAnteDecorators(chain[1:]...))
+		}
+
+		// when traced, each decorator has its own span, which is the parent of
+		// the spans of the decorators it calls
+		span := parent.StartChild(fmt.Sprintf("%T", chain[0]))
+		newCtx, err := chain[0].AnteHandle(ctx.WithSpan(span), tx, simulate, ChainAnteDecorators(chain[1:]...))
+		span.SetError(err)
+		span.End()
+
+		if !newCtx.IsZero() {
+			newCtx = newCtx.WithSpan(parent)
+		}
+
+		return newCtx, err
 	}
 }
 
@@ -0,0 +1,184 @@
+package module
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MigrationHandler is the in-place store migration function that a module
+// registers to migrate its state from one consensus version to the next.
+type MigrationHandler func(sdk.Context) error
+
+// VersionMap is a map of module name to its consensus version.
+type VersionMap map[string]uint64
+
+// Configurator allows modules to register their in-place store migrations.
+type Configurator interface {
+	// RegisterMigration registers an in-place store migration for a module.
+	// The handler is a migration script to perform the in-place migration from
+	// version `forVersion` to version `forVersion+1`.
+	//
+	// EACH TIME a module's ConsensusVersion increments, a new migration MUST be
+	// registered using this function. If a migration handler is missing for a
+	// particular function, the upgrade logic (see RunMigrations function) will
+	// return an error.
+	RegisterMigration(moduleName string, forVersion uint64, handler MigrationHandler) error
+}
+
+// MigrationModule is an interface that modules can implement in order to
+// register their in-place store migrations in a Configurator
+type MigrationModule interface {
+	RegisterMigrations(cfg Configurator)
+}
+
+type configurator struct {
+	cdc codec.JSONMarshaler
+
+	// migrations is a map of moduleName -> forVersion -> migration script handler
+	migrations map[string]map[uint64]MigrationHandler
+}
+
+// NewConfigurator returns a new Configurator instance. The codec is used to
+// initialize the genesis state of modules added during an upgrade.
+func NewConfigurator(cdc codec.JSONMarshaler) Configurator {
+	return configurator{
+		cdc:        cdc,
+		migrations: map[string]map[uint64]MigrationHandler{},
+	}
+}
+
+var _ Configurator = configurator{}
+
+// RegisterMigration implements the Configurator.RegisterMigration method
+func (c configurator) RegisterMigration(moduleName string, forVersion uint64, handler MigrationHandler) error {
+	if forVersion == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidVersion, "module migration versions should start at 1")
+	}
+
+	if c.migrations[moduleName] == nil {
+		c.migrations[moduleName] = map[uint64]MigrationHandler{}
+	}
+
+	if c.migrations[moduleName][forVersion] != nil {
+		return sdkerrors.Wrapf(
+			sdkerrors.ErrLogic,
+			"another migration for module %s and version %d already exists", moduleName, forVersion,
+		)
+	}
+
+	c.migrations[moduleName][forVersion] = handler
+
+	return nil
+}
+
+// runModuleMigrations runs all in-place store migrations for one given module
+// from a version to another version.
+func (c configurator) runModuleMigrations(ctx sdk.Context, moduleName string, fromVersion, toVersion uint64) error {
+	// No-op if toVersion is the initial version or if the version is unchanged.
+	if toVersion <= 1 || fromVersion == toVersion {
+		return nil
+	}
+
+	moduleMigrationsMap, found := c.migrations[moduleName]
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "no migrations found for module %s", moduleName)
+	}
+
+	// Run in-place migrations for the module sequentially until toVersion.
+	for i := fromVersion; i < toVersion; i++ {
+		migrateFn, found := moduleMigrationsMap[i]
+		if !found {
+			return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "no migration found for module %s from version %d to version %d", moduleName, i, i+1)
+		}
+
+		if err := migrateFn(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RegisterMigrations calls RegisterMigrations with the configurator parameter
+// on all of the modules which implement MigrationModule in the manager
+func (m *Manager) RegisterMigrations(cfg Configurator) {
+	for _, moduleName := range m.OrderMigrations {
+		mm, ok := m.Modules[moduleName].(MigrationModule)
+		if !ok {
+			continue
+		}
+
+		mm.RegisterMigrations(cfg)
+	}
+}
+
+// RunMigrations performs in-place store migrations for all modules. This
+// function MUST be called inside an x/upgrade UpgradeHandler.
+//
+// fromVM is the VersionMap of the modules' consensus versions before the
+// upgrade, as persisted by x/upgrade. For each module of the manager, in
+// OrderMigrations order:
+//   - if the module is in fromVM, all its registered migrations from its
+//     version in fromVM up to its current ConsensusVersion are run,
+//   - otherwise the module is considered new and is initialized with its
+//     default genesis state.
+//
+// The returned VersionMap holds the up-to-date consensus versions of all
+// modules and is meant to be returned by the UpgradeHandler so that x/upgrade
+// can persist it.
+//
+// An empty fromVM is rejected, as it would initialize every module again: a
+// chain started before x/upgrade persisted the versions of the modules has no
+// VersionMap, and its first UpgradeHandler must set fromVM explicitly.
+func (m *Manager) RunMigrations(ctx sdk.Context, cfg Configurator, fromVM VersionMap) (VersionMap, error) {
+	c, ok := cfg.(configurator)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expected %T, got %T", configurator{}, cfg)
+	}
+
+	if len(fromVM) == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no module versions to migrate from")
+	}
+
+	for _, moduleName := range m.OrderMigrations {
+		module := m.Modules[moduleName]
+		toVersion := module.ConsensusVersion()
+
+		fromVersion, exists := fromVM[moduleName]
+		if exists {
+			if fromVersion > toVersion {
+				return nil, sdkerrors.Wrapf(
+					sdkerrors.ErrInvalidVersion,
+					"module %s cannot be downgraded from version %d to version %d", moduleName, fromVersion, toVersion,
+				)
+			}
+
+			if err := c.runModuleMigrations(ctx, moduleName, fromVersion, toVersion); err != nil {
+				return nil, err
+			}
+
+			continue
+		}
+
+		ctx.Logger().Info(fmt.Sprintf("adding a new module: %s", moduleName))
+		valUpdates := module.InitGenesis(ctx, c.cdc, module.DefaultGenesis(c.cdc))
+		if len(valUpdates) > 0 {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "new module %s cannot update the validator set during an upgrade", moduleName)
+		}
+	}
+
+	return m.GetVersionMap(), nil
+}
+
+// GetVersionMap gets the consensus versions of all modules of the manager.
+func (m *Manager) GetVersionMap() VersionMap {
+	vermap := make(VersionMap)
+	for name, module := range m.Modules {
+		vermap[name] = module.ConsensusVersion()
+	}
+
+	return vermap
+}
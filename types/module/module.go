@@ -30,6 +30,7 @@ package module
 
 import (
 	"encoding/json"
+	"time"
 
 	"github.com/gogo/protobuf/grpc"
 
@@ -39,6 +40,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -155,11 +157,24 @@ type AppModule interface {
 	// RegisterQueryService allows a module to register a gRPC query service
 	RegisterQueryService(grpc.Server)
 
+	// ConsensusVersion is a sequence number for state-breaking changes of the
+	// module. It must be incremented on each consensus-breaking change
+	// introduced by the module, along with a registered migration from the
+	// previous version. The initial version is 1.
+	ConsensusVersion() uint64
+
 	// ABCI
 	BeginBlock(sdk.Context, abci.RequestBeginBlock)
 	EndBlock(sdk.Context, abci.RequestEndBlock) []abci.ValidatorUpdate
 }
 
+// MsgServiceModule is an interface that modules can implement in order to
+// register their protobuf Msg service, whose messages are then routed by type
+// URL rather than through the module's legacy Route.
+type MsgServiceModule interface {
+	RegisterMsgService(grpc.Server)
+}
+
 //___________________________
 
 // GenesisOnlyAppModule is an AppModule that only has import/export functionality
@@ -188,6 +203,9 @@ func (gam GenesisOnlyAppModule) NewQuerierHandler() sdk.Querier { return nil }
 
 func (gam GenesisOnlyAppModule) RegisterQueryService(grpc.Server) {}
 
+// ConsensusVersion implements AppModule/ConsensusVersion.
+func (gam GenesisOnlyAppModule) ConsensusVersion() uint64 { return 1 }
+
 // BeginBlock returns an empty module begin-block
 func (gam GenesisOnlyAppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {}
 
@@ -206,6 +224,7 @@ type Manager struct {
 	OrderExportGenesis []string
 	OrderBeginBlockers []string
 	OrderEndBlockers   []string
+	OrderMigrations    []string
 }
 
 // NewManager creates a new Manager object
@@ -224,6 +243,7 @@ func NewManager(modules ...AppModule) *Manager {
 		OrderExportGenesis: modulesStr,
 		OrderBeginBlockers: modulesStr,
 		OrderEndBlockers:   modulesStr,
+		OrderMigrations:    modulesStr,
 	}
 }
 
@@ -247,6 +267,11 @@ func (m *Manager) SetOrderEndBlockers(moduleNames ...string) {
 	m.OrderEndBlockers = moduleNames
 }
 
+// SetOrderMigrations sets the order of in-place store migrations, see RunMigrations
+func (m *Manager) SetOrderMigrations(moduleNames ...string) {
+	m.OrderMigrations = moduleNames
+}
+
 // RegisterInvariants registers all module routes and module querier routes
 func (m *Manager) RegisterInvariants(ir sdk.InvariantRegistry) {
 	for _, module := range m.Modules {
@@ -273,6 +298,16 @@ func (m *Manager) RegisterQueryServices(grpcRouter grpc.Server) {
 	}
 }
 
+// RegisterMsgServices registers the Msg services of all modules which
+// implement MsgServiceModule
+func (m *Manager) RegisterMsgServices(msgRouter grpc.Server) {
+	for _, module := range m.Modules {
+		if msm, ok := module.(MsgServiceModule); ok {
+			msm.RegisterMsgService(msgRouter)
+		}
+	}
+}
+
 // InitGenesis performs init genesis functionality for modules
 func (m *Manager) InitGenesis(ctx sdk.Context, cdc codec.JSONMarshaler, genesisData map[string]json.RawMessage) abci.ResponseInitChain {
 	var validatorUpdates []abci.ValidatorUpdate
@@ -310,12 +345,15 @@ func (m *Manager) ExportGenesis(ctx sdk.Context, cdc codec.JSONMarshaler) map[st
 
 // BeginBlock performs begin block functionality for all modules. It creates a
 // child context with an event manager to aggregate events emitted from all
-// modules.
+// modules. The latency of each module is recorded when the telemetry module
+// metrics are enabled.
 func (m *Manager) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
 	ctx = ctx.WithEventManager(sdk.NewEventManager())
 
 	for _, moduleName := range m.OrderBeginBlockers {
+		start := time.Now()
 		m.Modules[moduleName].BeginBlock(ctx, req)
+		telemetry.ObserveModuleBlocker(telemetry.MetricKeyBeginBlocker, moduleName, start)
 	}
 
 	return abci.ResponseBeginBlock{
@@ -325,13 +363,16 @@ func (m *Manager) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) abci.R
 
 // EndBlock performs end block functionality for all modules. It creates a
 // child context with an event manager to aggregate events emitted from all
-// modules.
+// modules. The latency of each module is recorded when the telemetry module
+// metrics are enabled.
 func (m *Manager) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
 	ctx = ctx.WithEventManager(sdk.NewEventManager())
 	validatorUpdates := []abci.ValidatorUpdate{}
 
 	for _, moduleName := range m.OrderEndBlockers {
+		start := time.Now()
 		moduleValUpdates := m.Modules[moduleName].EndBlock(ctx, req)
+		telemetry.ObserveModuleBlocker(telemetry.MetricKeyEndBlocker, moduleName, start)
 
 		// use these validator updates if provided, the module manager assumes
 		// only one module will update the validator set
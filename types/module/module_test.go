@@ -10,11 +10,13 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/tests/mocks"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/module"
 )
 
@@ -106,6 +108,10 @@ func TestManagerOrderSetters(t *testing.T) {
 	require.Equal(t, []string{"module1", "module2"}, mm.OrderEndBlockers)
 	mm.SetOrderEndBlockers("module2", "module1")
 	require.Equal(t, []string{"module2", "module1"}, mm.OrderEndBlockers)
+
+	require.Equal(t, []string{"module1", "module2"}, mm.OrderMigrations)
+	mm.SetOrderMigrations("module2", "module1")
+	require.Equal(t, []string{"module2", "module1"}, mm.OrderMigrations)
 }
 
 func TestManager_RegisterInvariants(t *testing.T) {
@@ -272,3 +278,81 @@ func TestManager_EndBlock(t *testing.T) {
 	mockAppModule2.EXPECT().EndBlock(gomock.Any(), gomock.Eq(req)).Times(1).Return([]abci.ValidatorUpdate{{}})
 	require.Panics(t, func() { mm.EndBlock(sdk.Context{}, req) })
 }
+
+func TestConfigurator_RegisterMigration(t *testing.T) {
+	cfg := module.NewConfigurator(codec.New())
+	noop := func(sdk.Context) error { return nil }
+
+	require.True(t, errors.Is(cfg.RegisterMigration("module1", 0, noop), sdkerrors.ErrInvalidVersion))
+	require.NoError(t, cfg.RegisterMigration("module1", 1, noop))
+	require.True(t, errors.Is(cfg.RegisterMigration("module1", 1, noop), sdkerrors.ErrLogic))
+	require.NoError(t, cfg.RegisterMigration("module2", 1, noop))
+}
+
+func TestManager_RunMigrations(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+
+	mockAppModule1 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule2 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule1.EXPECT().Name().Times(2).Return("module1")
+	mockAppModule2.EXPECT().Name().Times(2).Return("module2")
+	mockAppModule1.EXPECT().ConsensusVersion().AnyTimes().Return(uint64(3))
+	mockAppModule2.EXPECT().ConsensusVersion().AnyTimes().Return(uint64(1))
+	mm := module.NewManager(mockAppModule1, mockAppModule2)
+	require.NotNil(t, mm)
+
+	cdc, ctx := codec.New(), sdk.Context{}.WithLogger(log.NewNopLogger())
+	cfg := module.NewConfigurator(cdc)
+
+	var migrated []uint64
+	for _, v := range []uint64{1, 2} {
+		v := v
+		require.NoError(t, cfg.RegisterMigration("module1", v, func(sdk.Context) error {
+			migrated = append(migrated, v)
+			return nil
+		}))
+	}
+
+	require.Equal(t, module.VersionMap{"module1": 3, "module2": 1}, mm.GetVersionMap())
+
+	t.Log("migrations are run from the version in fromVM up to the current version")
+	vm, err := mm.RunMigrations(ctx, cfg, module.VersionMap{"module1": 1, "module2": 1})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2}, migrated)
+	require.Equal(t, module.VersionMap{"module1": 3, "module2": 1}, vm)
+
+	t.Log("up-to-date modules are not migrated")
+	migrated = nil
+	_, err = mm.RunMigrations(ctx, cfg, module.VersionMap{"module1": 3, "module2": 1})
+	require.NoError(t, err)
+	require.Empty(t, migrated)
+
+	t.Log("modules absent from fromVM are initialized with their default genesis")
+	mockAppModule2.EXPECT().DefaultGenesis(gomock.Eq(cdc)).Times(1).Return(json.RawMessage(`{}`))
+	mockAppModule2.EXPECT().InitGenesis(gomock.Eq(ctx), gomock.Eq(cdc), gomock.Eq(json.RawMessage(`{}`))).Times(1).Return(nil)
+	_, err = mm.RunMigrations(ctx, cfg, module.VersionMap{"module1": 2})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{2}, migrated)
+
+	t.Log("an empty fromVM is rejected rather than initializing all the modules")
+	_, err = mm.RunMigrations(ctx, cfg, module.VersionMap{})
+	require.True(t, errors.Is(err, sdkerrors.ErrInvalidRequest))
+	_, err = mm.RunMigrations(ctx, cfg, nil)
+	require.True(t, errors.Is(err, sdkerrors.ErrInvalidRequest))
+
+	t.Log("missing migrations and downgrades are rejected")
+	_, err = mm.RunMigrations(ctx, module.NewConfigurator(cdc), module.VersionMap{"module1": 1, "module2": 1})
+	require.True(t, errors.Is(err, sdkerrors.ErrNotFound))
+	_, err = mm.RunMigrations(ctx, cfg, module.VersionMap{"module1": 1, "module2": 2})
+	require.True(t, errors.Is(err, sdkerrors.ErrInvalidVersion))
+
+	t.Log("migration errors are returned")
+	require.NoError(t, cfg.RegisterMigration("module2", 1, func(sdk.Context) error { return errFoo }))
+	mockAppModule2Upgraded := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule2Upgraded.EXPECT().Name().Times(2).Return("module2")
+	mockAppModule2Upgraded.EXPECT().ConsensusVersion().AnyTimes().Return(uint64(2))
+	mm = module.NewManager(mockAppModule2Upgraded)
+	_, err = mm.RunMigrations(ctx, cfg, module.VersionMap{"module2": 1})
+	require.Equal(t, errFoo, err)
+}
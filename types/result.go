@@ -5,10 +5,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"math"
+	"sort"
 	"strings"
 
+	"github.com/gogo/protobuf/proto"
 	yaml "gopkg.in/yaml.v2"
 
+	abci "github.com/tendermint/tendermint/abci/types"
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 
 	"github.com/cosmos/cosmos-sdk/codec/legacy"
@@ -25,6 +28,35 @@ func (r Result) String() string {
 	return string(bz)
 }
 
+// NewGasConsumption returns the gas consumption of the given gas consumed by
+// descriptor, sorted by descriptor, or nil if no gas was consumed.
+func NewGasConsumption(consumed map[string]Gas) []GasConsumption {
+	if len(consumed) == 0 {
+		return nil
+	}
+
+	gasConsumption := make([]GasConsumption, 0, len(consumed))
+	for descriptor, gas := range consumed {
+		gasConsumption = append(gasConsumption, GasConsumption{GasDescriptor: descriptor, Gas: gas})
+	}
+
+	sort.Slice(gasConsumption, func(i, j int) bool {
+		return gasConsumption[i].GasDescriptor < gasConsumption[j].GasDescriptor
+	})
+
+	return gasConsumption
+}
+
+func (gc GasConsumption) String() string {
+	bz, _ := yaml.Marshal(gc)
+	return string(bz)
+}
+
+func (mr MsgResult) String() string {
+	bz, _ := yaml.Marshal(mr)
+	return string(bz)
+}
+
 func (r Result) GetEvents() Events {
 	events := make(Events, len(r.Events))
 	for i, e := range r.Events {
@@ -286,3 +318,30 @@ func (s SearchTxsResult) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 func (r TxResponse) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 	return types.UnpackInterfaces(r.Tx, unpacker)
 }
+
+// WrapServiceResult wraps a result from a protobuf Msg service method call in
+// a Result object, with the response marshaled as data and the events of the
+// context's event manager.
+func WrapServiceResult(ctx Context, res proto.Message, err error) (*Result, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	var data []byte
+	if res != nil {
+		data, err = proto.Marshal(res)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var events []abci.Event
+	if evtMgr := ctx.EventManager(); evtMgr != nil {
+		events = evtMgr.ABCIEvents()
+	}
+
+	return &Result{
+		Data:   data,
+		Events: events,
+	}, nil
+}
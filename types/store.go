@@ -170,3 +170,9 @@ type (
 func NewInfiniteGasMeter() GasMeter {
 	return types.NewInfiniteGasMeter()
 }
+
+type RecordingGasMeter = types.RecordingGasMeter
+
+func NewRecordingGasMeter(gasMeter GasMeter) *RecordingGasMeter {
+	return types.NewRecordingGasMeter(gasMeter)
+}
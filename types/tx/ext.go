@@ -0,0 +1,18 @@
+package tx
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+// TxExtensionOptionI defines the interface for tx extension options. Modules
+// add support for an extension option by registering its concrete type as an
+// implementation of this interface, e.g.:
+//
+//	registry.RegisterImplementations((*tx.TxExtensionOptionI)(nil), &MyOption{})
+type TxExtensionOptionI interface{}
+
+// RegisterInterfaces registers the TxExtensionOptionI interface so that modules
+// can register their supported extension option types against it.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterInterface("cosmos.tx.TxExtensionOptionI", (*TxExtensionOptionI)(nil))
+}
@@ -260,7 +260,7 @@ type TxBody struct {
 	Memo string `protobuf:"bytes,2,opt,name=memo,proto3" json:"memo,omitempty"`
 	// timeout is the block height after which this transaction will not
 	// be processed by the chain
-	TimeoutHeight int64 `protobuf:"varint,3,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height,omitempty"`
+	TimeoutHeight uint64 `protobuf:"varint,3,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height,omitempty"`
 	// extension_options are arbitrary options that can be added by chains
 	// when the default options are not sufficient. If any of these are present
 	// and can't be handled, the transaction will be rejected
@@ -318,7 +318,7 @@ func (m *TxBody) GetMemo() string {
 	return ""
 }
 
-func (m *TxBody) GetTimeoutHeight() int64 {
+func (m *TxBody) GetTimeoutHeight() uint64 {
 	if m != nil {
 		return m.TimeoutHeight
 	}
@@ -658,6 +658,10 @@ type Fee struct {
 	// gas_limit is the maximum gas that can be used in transaction processing
 	// before an out of gas error occurs
 	GasLimit uint64 `protobuf:"varint,2,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// granter is the address of an account that has granted the fee payer a fee
+	// allowance (see x/feegrant). If set, the fee is deducted from the granter's
+	// account instead of the fee payer's.
+	Granter github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=granter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"granter,omitempty"`
 }
 
 func (m *Fee) Reset()         { *m = Fee{} }
@@ -707,6 +711,13 @@ func (m *Fee) GetGasLimit() uint64 {
 	return 0
 }
 
+func (m *Fee) GetGranter() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Granter
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Tx)(nil), "cosmos.tx.Tx")
 	proto.RegisterType((*TxRaw)(nil), "cosmos.tx.TxRaw")
@@ -723,57 +734,59 @@ func init() {
 func init() { proto.RegisterFile("cosmos/tx/tx.proto", fileDescriptor_9b35c9d5d6b7bce8) }
 
 var fileDescriptor_9b35c9d5d6b7bce8 = []byte{
-	// 795 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4f, 0x6f, 0xe3, 0x44,
-	0x14, 0x8f, 0xe3, 0x24, 0x8d, 0x5f, 0xff, 0xed, 0xce, 0x2e, 0x92, 0x9b, 0x0a, 0x37, 0x8a, 0x54,
-	0x14, 0x0e, 0xd8, 0xa5, 0x20, 0xf1, 0xe7, 0x82, 0x9a, 0xc2, 0xaa, 0x2b, 0x58, 0x40, 0x93, 0x8a,
-	0xc3, 0x5e, 0x2c, 0xdb, 0x99, 0x38, 0xa3, 0x8d, 0x67, 0x82, 0x67, 0xac, 0xda, 0x48, 0x7c, 0x07,
-	0x2e, 0x7c, 0x09, 0x0e, 0x7c, 0x03, 0xee, 0x7b, 0xdc, 0x23, 0x27, 0x40, 0xed, 0x07, 0x01, 0xcd,
-	0x78, 0x9c, 0x0d, 0xab, 0xee, 0xf6, 0xb2, 0xa7, 0xbc, 0xf9, 0xbd, 0xdf, 0x7b, 0xbf, 0x97, 0xf7,
-	0xc7, 0x80, 0x12, 0x2e, 0x32, 0x2e, 0x02, 0x59, 0x06, 0xb2, 0xf4, 0x57, 0x39, 0x97, 0x1c, 0x39,
-	0x35, 0xe6, 0xcb, 0x72, 0xf0, 0x30, 0xe5, 0x29, 0xd7, 0x68, 0xa0, 0xac, 0x9a, 0x30, 0x18, 0x98,
-	0xa0, 0x24, 0xaf, 0x56, 0x92, 0x9b, 0x1f, 0xe3, 0x7b, 0xd0, 0xf8, 0xea, 0x1c, 0x35, 0x78, 0xf4,
-	0x52, 0x45, 0xd0, 0x94, 0x51, 0x96, 0x36, 0xbf, 0x86, 0x70, 0x90, 0x72, 0x9e, 0x2e, 0x49, 0xa0,
-	0x5f, 0x71, 0x31, 0x0f, 0x22, 0x56, 0xd5, 0xae, 0xd1, 0xcf, 0xd0, 0xbe, 0x2c, 0xd1, 0x31, 0x74,
-	0x62, 0x3e, 0xab, 0x5c, 0x6b, 0x68, 0x8d, 0xb7, 0x4f, 0xef, 0xfb, 0xeb, 0x12, 0xfd, 0xcb, 0x72,
-	0xc2, 0x67, 0x15, 0xd6, 0x6e, 0x74, 0x02, 0x4e, 0x54, 0xc8, 0x45, 0x48, 0xd9, 0x9c, 0xbb, 0x6d,
-	0xcd, 0x7d, 0xb0, 0xc1, 0x3d, 0x2b, 0xe4, 0xe2, 0x31, 0x9b, 0x73, 0xdc, 0x8f, 0x8c, 0x85, 0x3c,
-	0x00, 0x55, 0x4a, 0x24, 0x8b, 0x9c, 0x08, 0xd7, 0x1e, 0xda, 0xe3, 0x1d, 0xbc, 0x81, 0x8c, 0x18,
-	0x74, 0x2f, 0x4b, 0x1c, 0x5d, 0xa1, 0x77, 0x01, 0x94, 0x44, 0x18, 0x57, 0x92, 0x08, 0x5d, 0xc7,
-	0x0e, 0x76, 0x14, 0x32, 0x51, 0x00, 0x7a, 0x0f, 0xf6, 0xd7, 0xca, 0x86, 0xd3, 0xd6, 0x9c, 0xdd,
-	0x46, 0xaa, 0xe6, 0xdd, 0xa5, 0xf7, 0x87, 0x05, 0x5b, 0x53, 0x9a, 0xb2, 0x2f, 0x79, 0xf2, 0xb6,
-	0x24, 0x0f, 0xa0, 0x9f, 0x2c, 0x22, 0xca, 0x42, 0x3a, 0x73, 0xed, 0xa1, 0x35, 0x76, 0xf0, 0x96,
-	0x7e, 0x3f, 0x9e, 0xa1, 0x63, 0xd8, 0x8b, 0x92, 0x84, 0x17, 0x4c, 0x86, 0xac, 0xc8, 0x62, 0x92,
-	0xbb, 0x9d, 0xa1, 0x35, 0xee, 0xe0, 0x5d, 0x83, 0x7e, 0xab, 0x41, 0xf4, 0x3e, 0xdc, 0x6b, 0x68,
-	0x82, 0xfc, 0x58, 0x10, 0x96, 0x10, 0xb7, 0xab, 0x89, 0xfb, 0x06, 0x9f, 0x1a, 0x78, 0xf4, 0x6b,
-	0x1b, 0x7a, 0xf5, 0x48, 0xd0, 0x09, 0xf4, 0x33, 0x22, 0x44, 0x94, 0xea, 0xe2, 0xed, 0xf1, 0xf6,
-	0xe9, 0x43, 0xbf, 0x9e, 0xb3, 0xdf, 0xcc, 0xd9, 0x3f, 0x63, 0x15, 0x5e, 0xb3, 0x10, 0x82, 0x4e,
-	0x46, 0xb2, 0x7a, 0x72, 0x0e, 0xd6, 0xb6, 0x2a, 0x51, 0xd2, 0x8c, 0xf0, 0x42, 0x86, 0x0b, 0x42,
-	0xd3, 0x85, 0xd4, 0xff, 0xc1, 0xc6, 0xbb, 0x06, 0xbd, 0xd0, 0x20, 0x9a, 0xc0, 0x7d, 0x52, 0x4a,
-	0xc2, 0x04, 0xe5, 0x2c, 0xe4, 0x2b, 0x49, 0x39, 0x13, 0xee, 0xbf, 0x5b, 0x6f, 0x90, 0xbd, 0xb7,
-	0xe6, 0x7f, 0x57, 0xd3, 0xd1, 0x53, 0xf0, 0x18, 0x67, 0x61, 0x92, 0x53, 0x49, 0x93, 0x68, 0x19,
-	0xde, 0x92, 0x70, 0xff, 0x0d, 0x09, 0x0f, 0x19, 0x67, 0xe7, 0x26, 0xf6, 0xab, 0x57, 0x72, 0x8f,
-	0xe6, 0xd0, 0x6f, 0xb6, 0x0f, 0x7d, 0x0a, 0x3b, 0x6a, 0xe2, 0x24, 0xd7, 0xa3, 0x6b, 0x9a, 0xf3,
-	0xce, 0xc6, 0xa2, 0x4e, 0xb5, 0x5b, 0xaf, 0xea, 0xb6, 0x58, 0xdb, 0x02, 0x0d, 0xc1, 0x9e, 0x13,
-	0x62, 0x36, 0x7b, 0x6f, 0x23, 0xe0, 0x11, 0x21, 0x58, 0xb9, 0x46, 0x57, 0x00, 0x2f, 0x83, 0xd1,
-	0x27, 0x00, 0xab, 0x22, 0x5e, 0xd2, 0x24, 0x7c, 0x46, 0x9a, 0xe3, 0x71, 0x9b, 0x30, 0x73, 0xb7,
-	0xdf, 0x6b, 0xc2, 0xd7, 0xa4, 0xc2, 0xce, 0xaa, 0x31, 0xd5, 0x21, 0x65, 0x7c, 0x46, 0x5e, 0x77,
-	0x48, 0x4f, 0xf8, 0x8c, 0xd4, 0x87, 0x94, 0x19, 0x6b, 0xf4, 0x7b, 0x1b, 0xfa, 0x0d, 0x8c, 0x3e,
-	0x86, 0x9e, 0xa0, 0x2c, 0x5d, 0x12, 0xa3, 0x39, 0xb8, 0x25, 0xd6, 0x9f, 0x6a, 0xc6, 0x45, 0x0b,
-	0x1b, 0x2e, 0xfa, 0x10, 0xba, 0x59, 0xb1, 0x94, 0xd4, 0x08, 0x1e, 0xdc, 0x16, 0xf4, 0x44, 0x11,
-	0x2e, 0x5a, 0xb8, 0x66, 0x0e, 0x3e, 0x83, 0x5e, 0x9d, 0x06, 0x05, 0xd0, 0x51, 0xb5, 0x68, 0xc1,
-	0xbd, 0xd3, 0xc3, 0x8d, 0xd8, 0xe6, 0x53, 0xa3, 0xfa, 0xa2, 0xf2, 0x60, 0x4d, 0x1c, 0x5c, 0x41,
-	0x57, 0x27, 0x43, 0x9f, 0x43, 0x3f, 0xa6, 0x32, 0xca, 0xf3, 0xa8, 0x69, 0x91, 0xf7, 0x4a, 0x8b,
-	0xce, 0x79, 0xb6, 0x8a, 0x12, 0x39, 0xa1, 0xf2, 0x4c, 0xb1, 0xf0, 0x9a, 0x8f, 0x4e, 0x01, 0xd6,
-	0x7d, 0x52, 0xe7, 0x67, 0xbf, 0xae, 0x51, 0x4e, 0xd3, 0x28, 0x31, 0xe9, 0x82, 0x2d, 0x8a, 0x6c,
-	0xf4, 0x13, 0xd8, 0x8f, 0x08, 0x41, 0x3f, 0x40, 0x2f, 0xca, 0xd4, 0x09, 0x99, 0x35, 0xd8, 0x69,
-	0xa2, 0xcf, 0x39, 0x65, 0x93, 0x93, 0xe7, 0x7f, 0x1d, 0xb5, 0x7e, 0xfb, 0xfb, 0x68, 0x9c, 0x52,
-	0xb9, 0x28, 0x62, 0x3f, 0xe1, 0x59, 0xf0, 0xbf, 0x2f, 0xec, 0x07, 0x62, 0xf6, 0x2c, 0x90, 0xd5,
-	0x8a, 0xd4, 0x01, 0x02, 0x9b, 0x6c, 0xe8, 0x10, 0x9c, 0x34, 0x12, 0xe1, 0x92, 0x66, 0x54, 0xea,
-	0x86, 0x76, 0x70, 0x3f, 0x8d, 0xc4, 0x37, 0xea, 0x3d, 0xf9, 0xe2, 0xf9, 0xb5, 0x67, 0xbd, 0xb8,
-	0xf6, 0xac, 0x7f, 0xae, 0x3d, 0xeb, 0x97, 0x1b, 0xaf, 0xf5, 0xe2, 0xc6, 0x6b, 0xfd, 0x79, 0xe3,
-	0xb5, 0x9e, 0x1e, 0xdf, 0x2d, 0x14, 0xc8, 0x32, 0xee, 0xe9, 0xcd, 0xff, 0xe8, 0xbf, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x40, 0xba, 0x1f, 0xb6, 0x40, 0x06, 0x00, 0x00,
+	// 825 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0xfa, 0x5f, 0xec, 0x17, 0x27, 0x69, 0xa7, 0x45, 0x72, 0x1c, 0xb1, 0xb1, 0x2c, 0x05,
+	0x99, 0x43, 0x77, 0xd3, 0x80, 0xc4, 0x9f, 0x0b, 0xb2, 0x03, 0x55, 0xaa, 0x52, 0x40, 0x93, 0x88,
+	0x43, 0x2f, 0xab, 0xf1, 0xee, 0x78, 0x3d, 0xaa, 0x77, 0xc6, 0xec, 0xcc, 0x2a, 0xf6, 0x81, 0xef,
+	0xc0, 0x85, 0x2f, 0xc1, 0x81, 0x6f, 0xc0, 0x99, 0x1e, 0x7b, 0xe4, 0x54, 0x50, 0xf2, 0x2d, 0xb8,
+	0x80, 0x66, 0x76, 0xc6, 0x35, 0x55, 0xda, 0x72, 0xe8, 0xc9, 0x6f, 0x7e, 0xef, 0xf7, 0xde, 0xef,
+	0xf9, 0xfd, 0x59, 0x40, 0xb1, 0x90, 0x99, 0x90, 0xa1, 0x5a, 0x86, 0x6a, 0x19, 0x2c, 0x72, 0xa1,
+	0x04, 0x6a, 0x97, 0x58, 0xa0, 0x96, 0xbd, 0xbb, 0xa9, 0x48, 0x85, 0x41, 0x43, 0x6d, 0x95, 0x84,
+	0x5e, 0xcf, 0x06, 0xc5, 0xf9, 0x6a, 0xa1, 0x84, 0xfd, 0xb1, 0xbe, 0x3b, 0xce, 0x57, 0xe6, 0x28,
+	0xc1, 0xc3, 0x97, 0x2a, 0x92, 0xa5, 0x9c, 0xf1, 0xd4, 0xfd, 0x5a, 0xc2, 0x7e, 0x2a, 0x44, 0x3a,
+	0xa7, 0xa1, 0x79, 0x4d, 0x8a, 0x69, 0x48, 0xf8, 0xaa, 0x74, 0x0d, 0x7e, 0x84, 0xea, 0xc5, 0x12,
+	0x1d, 0x41, 0x7d, 0x22, 0x92, 0x55, 0xd7, 0xeb, 0x7b, 0xc3, 0xed, 0x93, 0xdb, 0xc1, 0xba, 0xc4,
+	0xe0, 0x62, 0x39, 0x16, 0xc9, 0x0a, 0x1b, 0x37, 0x3a, 0x86, 0x36, 0x29, 0xd4, 0x2c, 0x62, 0x7c,
+	0x2a, 0xba, 0x55, 0xc3, 0xbd, 0xb3, 0xc1, 0x1d, 0x15, 0x6a, 0xf6, 0x90, 0x4f, 0x05, 0x6e, 0x11,
+	0x6b, 0x21, 0x1f, 0x40, 0x97, 0x42, 0x54, 0x91, 0x53, 0xd9, 0xad, 0xf5, 0x6b, 0xc3, 0x0e, 0xde,
+	0x40, 0x06, 0x1c, 0x1a, 0x17, 0x4b, 0x4c, 0x2e, 0xd1, 0xfb, 0x00, 0x5a, 0x22, 0x9a, 0xac, 0x14,
+	0x95, 0xa6, 0x8e, 0x0e, 0x6e, 0x6b, 0x64, 0xac, 0x01, 0xf4, 0x01, 0xec, 0xad, 0x95, 0x2d, 0xa7,
+	0x6a, 0x38, 0x3b, 0x4e, 0xaa, 0xe4, 0xbd, 0x4d, 0xef, 0x37, 0x0f, 0xb6, 0xce, 0x59, 0xca, 0xbf,
+	0x14, 0xf1, 0xbb, 0x92, 0xdc, 0x87, 0x56, 0x3c, 0x23, 0x8c, 0x47, 0x2c, 0xe9, 0xd6, 0xfa, 0xde,
+	0xb0, 0x8d, 0xb7, 0xcc, 0xfb, 0x61, 0x82, 0x8e, 0x60, 0x97, 0xc4, 0xb1, 0x28, 0xb8, 0x8a, 0x78,
+	0x91, 0x4d, 0x68, 0xde, 0xad, 0xf7, 0xbd, 0x61, 0x1d, 0xef, 0x58, 0xf4, 0x1b, 0x03, 0xa2, 0x0f,
+	0xe1, 0x96, 0xa3, 0x49, 0xfa, 0x43, 0x41, 0x79, 0x4c, 0xbb, 0x0d, 0x43, 0xdc, 0xb3, 0xf8, 0xb9,
+	0x85, 0x07, 0x3f, 0x57, 0xa1, 0x59, 0x8e, 0x04, 0x1d, 0x43, 0x2b, 0xa3, 0x52, 0x92, 0xd4, 0x14,
+	0x5f, 0x1b, 0x6e, 0x9f, 0xdc, 0x0d, 0xca, 0x39, 0x07, 0x6e, 0xce, 0xc1, 0x88, 0xaf, 0xf0, 0x9a,
+	0x85, 0x10, 0xd4, 0x33, 0x9a, 0x95, 0x93, 0x6b, 0x63, 0x63, 0xeb, 0x12, 0x15, 0xcb, 0xa8, 0x28,
+	0x54, 0x34, 0xa3, 0x2c, 0x9d, 0x29, 0xf3, 0x1f, 0xea, 0x78, 0xc7, 0xa2, 0x67, 0x06, 0x44, 0x63,
+	0xb8, 0x4d, 0x97, 0x8a, 0x72, 0xc9, 0x04, 0x8f, 0xc4, 0x42, 0x31, 0xc1, 0x65, 0xf7, 0x9f, 0xad,
+	0x37, 0xc8, 0xde, 0x5a, 0xf3, 0xbf, 0x2d, 0xe9, 0xe8, 0x09, 0xf8, 0x5c, 0xf0, 0x28, 0xce, 0x99,
+	0x62, 0x31, 0x99, 0x47, 0x37, 0x24, 0xdc, 0x7b, 0x43, 0xc2, 0x03, 0x2e, 0xf8, 0xa9, 0x8d, 0xfd,
+	0xea, 0x95, 0xdc, 0x83, 0x29, 0xb4, 0xdc, 0xf6, 0xa1, 0x4f, 0xa1, 0xa3, 0x27, 0x4e, 0x73, 0x33,
+	0x3a, 0xd7, 0x9c, 0xf7, 0x36, 0x16, 0xf5, 0xdc, 0xb8, 0xcd, 0xaa, 0x6e, 0xcb, 0xb5, 0x2d, 0x51,
+	0x1f, 0x6a, 0x53, 0x4a, 0xed, 0x66, 0xef, 0x6e, 0x04, 0x3c, 0xa0, 0x14, 0x6b, 0xd7, 0xe0, 0x12,
+	0xe0, 0x65, 0x30, 0xfa, 0x04, 0x60, 0x51, 0x4c, 0xe6, 0x2c, 0x8e, 0x9e, 0x52, 0x77, 0x3c, 0x5d,
+	0x17, 0x66, 0xef, 0xf6, 0x3b, 0x43, 0x78, 0x44, 0x57, 0xb8, 0xbd, 0x70, 0xa6, 0x3e, 0xa4, 0x4c,
+	0x24, 0xf4, 0x75, 0x87, 0xf4, 0x58, 0x24, 0xb4, 0x3c, 0xa4, 0xcc, 0x5a, 0x83, 0x5f, 0xab, 0xd0,
+	0x72, 0x30, 0xfa, 0x18, 0x9a, 0x92, 0xf1, 0x74, 0x4e, 0xad, 0x66, 0xef, 0x86, 0xd8, 0xe0, 0xdc,
+	0x30, 0xce, 0x2a, 0xd8, 0x72, 0xd1, 0x7d, 0x68, 0x64, 0xc5, 0x5c, 0x31, 0x2b, 0xb8, 0x7f, 0x53,
+	0xd0, 0x63, 0x4d, 0x38, 0xab, 0xe0, 0x92, 0xd9, 0xfb, 0x0c, 0x9a, 0x65, 0x1a, 0x14, 0x42, 0x5d,
+	0xd7, 0x62, 0x04, 0x77, 0x4f, 0x0e, 0x36, 0x62, 0xdd, 0xa7, 0x46, 0xf7, 0x45, 0xe7, 0xc1, 0x86,
+	0xd8, 0xbb, 0x84, 0x86, 0x49, 0x86, 0x3e, 0x87, 0xd6, 0x84, 0x29, 0x92, 0xe7, 0xc4, 0xb5, 0xc8,
+	0x7f, 0xa5, 0x45, 0xa7, 0x22, 0x5b, 0x90, 0x58, 0x8d, 0x99, 0x1a, 0x69, 0x16, 0x5e, 0xf3, 0xd1,
+	0x09, 0xc0, 0xba, 0x4f, 0xfa, 0xfc, 0x6a, 0xaf, 0x6b, 0x54, 0xdb, 0x35, 0x4a, 0x8e, 0x1b, 0x50,
+	0x93, 0x45, 0x36, 0xf8, 0xdd, 0x83, 0xda, 0x03, 0x4a, 0xd1, 0xf7, 0xd0, 0x24, 0x99, 0xbe, 0x21,
+	0xbb, 0x07, 0x1d, 0x17, 0x7e, 0x2a, 0x18, 0x1f, 0x1f, 0x3f, 0x7b, 0x71, 0x58, 0xf9, 0xe5, 0xcf,
+	0xc3, 0x61, 0xca, 0xd4, 0xac, 0x98, 0x04, 0xb1, 0xc8, 0xc2, 0xff, 0x7c, 0x62, 0xef, 0xc9, 0xe4,
+	0x69, 0xa8, 0x56, 0x0b, 0x5a, 0x06, 0x48, 0x6c, 0xb3, 0xa1, 0x03, 0x68, 0xa7, 0x44, 0x46, 0x73,
+	0x96, 0x31, 0x65, 0x3a, 0x5a, 0xc7, 0xad, 0x94, 0xc8, 0xaf, 0xf5, 0x1b, 0x3d, 0x82, 0xad, 0x34,
+	0x27, 0x5c, 0xd1, 0xdc, 0x9c, 0x53, 0x67, 0x7c, 0xff, 0xef, 0x17, 0x87, 0xf7, 0xfe, 0x87, 0xc6,
+	0x28, 0x8e, 0x47, 0x49, 0x92, 0x53, 0x29, 0xb1, 0xcb, 0x30, 0xfe, 0xe2, 0xd9, 0x95, 0xef, 0x3d,
+	0xbf, 0xf2, 0xbd, 0xbf, 0xae, 0x7c, 0xef, 0xa7, 0x6b, 0xbf, 0xf2, 0xfc, 0xda, 0xaf, 0xfc, 0x71,
+	0xed, 0x57, 0x9e, 0x1c, 0xbd, 0x3d, 0x63, 0xa8, 0x96, 0x93, 0xa6, 0xb9, 0xa3, 0x8f, 0xfe, 0x1d,
+	0x00, 0xc1, 0x5c, 0x08, 0x42, 0x8e, 0x06, 0x00, 0x00,
 }
 
 func (m *Tx) Marshal() (dAtA []byte, err error) {
@@ -1280,6 +1293,13 @@ func (m *Fee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.GasLimit != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.GasLimit))
 		i--
@@ -1538,6 +1558,10 @@ func (m *Fee) Size() (n int) {
 	if m.GasLimit != 0 {
 		n += 1 + sovTx(uint64(m.GasLimit))
 	}
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -2157,7 +2181,7 @@ func (m *TxBody) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TimeoutHeight |= int64(b&0x7F) << shift
+				m.TimeoutHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2902,6 +2926,40 @@ func (m *Fee) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = append(m.Granter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Granter == nil {
+				m.Granter = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
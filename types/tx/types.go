@@ -24,5 +24,18 @@ func (m *TxBody) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 			return err
 		}
 	}
+
+	// Extension options that aren't registered are left packed rather than
+	// failing decoding. Whether an unknown option is acceptable is decided by
+	// the ante handler, see x/auth/ante.ExtensionOptionsDecorator.
+	for _, any := range m.ExtensionOptions {
+		var opt TxExtensionOptionI
+		_ = unpacker.UnpackAny(any, &opt)
+	}
+	for _, any := range m.NonCriticalExtensionOptions {
+		var opt TxExtensionOptionI
+		_ = unpacker.UnpackAny(any, &opt)
+	}
+
 	return nil
 }
@@ -62,6 +62,7 @@ type (
 		GetGas() uint64
 		GetFee() Coins
 		FeePayer() AccAddress
+		FeeGranter() AccAddress
 	}
 
 	// Tx must have GetMemo() method to use ValidateMemoDecorator
@@ -69,6 +70,13 @@ type (
 		Tx
 		GetMemo() string
 	}
+
+	// TxWithTimeoutHeight extends the Tx interface by allowing a transaction to
+	// set a height timeout.
+	TxWithTimeoutHeight interface {
+		Tx
+		GetTimeoutHeight() uint64
+	}
 )
 
 // TxDecoder unmarshals transaction bytes
@@ -78,3 +86,9 @@ type TxDecoder func(txBytes []byte) (Tx, error)
 type TxEncoder func(tx Tx) ([]byte, error)
 
 //__________________________________________________________
+
+// MsgTypeURL returns the type URL of a Msg, under which it is packed in an Any
+// and routed to its Msg service handler.
+func MsgTypeURL(msg Msg) string {
+	return "/" + proto.MessageName(msg)
+}
@@ -13,7 +13,8 @@ import (
 )
 
 var (
-	_ sdk.TxWithMemo = (*types.StdTx)(nil) // assert StdTx implements TxWithMemo
+	_ sdk.TxWithMemo          = (*types.StdTx)(nil) // assert StdTx implements TxWithMemo
+	_ sdk.TxWithTimeoutHeight = (*types.StdTx)(nil) // assert StdTx implements TxWithTimeoutHeight
 )
 
 // ValidateBasicDecorator will call tx.ValidateBasic and return any non-nil error.
@@ -39,6 +40,33 @@ func (vbd ValidateBasicDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulat
 	return next(ctx, tx, simulate)
 }
 
+// TxTimeoutHeightDecorator defines an AnteHandler decorator that checks for a
+// tx height timeout. If the current block height is greater than the tx's
+// timeout height, the tx is rejected. A timeout height of zero disables the
+// check.
+// CONTRACT: Tx must implement TxWithTimeoutHeight interface
+type TxTimeoutHeightDecorator struct{}
+
+func NewTxTimeoutHeightDecorator() TxTimeoutHeightDecorator {
+	return TxTimeoutHeightDecorator{}
+}
+
+func (txh TxTimeoutHeightDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	timeoutTx, ok := tx.(sdk.TxWithTimeoutHeight)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "expected tx to implement TxWithTimeoutHeight")
+	}
+
+	timeoutHeight := timeoutTx.GetTimeoutHeight()
+	if timeoutHeight > 0 && uint64(ctx.BlockHeight()) > timeoutHeight {
+		return ctx, sdkerrors.Wrapf(
+			sdkerrors.ErrTxTimeoutHeight, "block height: %d, timeout height: %d", ctx.BlockHeight(), timeoutHeight,
+		)
+	}
+
+	return next(ctx, tx, simulate)
+}
+
 // ValidateMemoDecorator will validate memo given the parameters passed in
 // If memo is too large decorator returns with error, otherwise call next AnteHandler
 // CONTRACT: Tx must implement TxWithMemo interface
@@ -2,6 +2,7 @@ package ante_test
 
 import (
 	"encoding/json"
+	"errors"
 	"strings"
 	"testing"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/tendermint/tendermint/crypto"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -82,6 +84,48 @@ func TestValidateMemo(t *testing.T) {
 	require.Nil(t, err, "ValidateBasicDecorator returned error on valid tx. err: %v", err)
 }
 
+func TestTxTimeoutHeight(t *testing.T) {
+	// setup
+	_, ctx := createTestApp(true)
+
+	// keys and addresses
+	_, _, addr1 := types.KeyTestPubAddr()
+
+	// msg and signatures
+	msgs := []sdk.Msg{testdata.NewTestMsg(addr1)}
+	fee := types.NewTestStdFee()
+
+	antehandler := sdk.ChainAnteDecorators(ante.NewTxTimeoutHeightDecorator())
+
+	testCases := []struct {
+		name      string
+		timeout   uint64
+		height    int64
+		expectErr bool
+	}{
+		{"default value", 0, 10, false},
+		{"no timeout (greater height)", 15, 10, false},
+		{"no timeout (same height)", 10, 10, false},
+		{"timeout (smaller height)", 9, 10, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			tx := types.NewStdTx(msgs, fee, nil, "")
+			tx.TimeoutHeight = tc.timeout
+
+			_, err := antehandler(ctx.WithBlockHeight(tc.height), tx, false)
+			if tc.expectErr {
+				require.True(t, errors.Is(err, sdkerrors.ErrTxTimeoutHeight), "expected ErrTxTimeoutHeight, got: %v", err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestConsumeGasForTxSize(t *testing.T) {
 	// setup
 	app, ctx := createTestApp(true)
@@ -0,0 +1,59 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NestedMsgsMsg defines a Msg executing other messages, such as the authz
+// MsgExecAuthorized.
+type NestedMsgsMsg interface {
+	sdk.Msg
+	GetMessages() ([]sdk.Msg, error)
+}
+
+// CircuitBreakerDecorator rejects a tx if any of its messages, or of the
+// messages nested in them, is disabled by the circuit breaker. It runs in both
+// CheckTx and DeliverTx. If the circuit breaker keeper is nil, all messages are
+// allowed.
+type CircuitBreakerDecorator struct {
+	circuitKeeper CircuitBreakerKeeper
+}
+
+func NewCircuitBreakerDecorator(ck CircuitBreakerKeeper) CircuitBreakerDecorator {
+	return CircuitBreakerDecorator{
+		circuitKeeper: ck,
+	}
+}
+
+func (cbd CircuitBreakerDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if cbd.circuitKeeper == nil {
+		return next(ctx, tx, simulate)
+	}
+
+	if err := cbd.assertMsgsEnabled(ctx, tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+func (cbd CircuitBreakerDecorator) assertMsgsEnabled(ctx sdk.Context, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		if err := cbd.circuitKeeper.AssertMsgEnabled(ctx, msg); err != nil {
+			return err
+		}
+
+		if nestedMsg, ok := msg.(NestedMsgsMsg); ok {
+			nested, err := nestedMsg.GetMessages()
+			if err != nil {
+				return err
+			}
+
+			if err := cbd.assertMsgsEnabled(ctx, nested); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package ante_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	authztypes "github.com/cosmos/cosmos-sdk/x/authz/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	circuittypes "github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+func TestCircuitBreakerDecorator(t *testing.T) {
+	// setup
+	app, ctx := createTestApp(true)
+
+	// keys and addresses
+	_, _, addr1 := types.KeyTestPubAddr()
+	_, _, addr2 := types.KeyTestPubAddr()
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
+	msgSend := banktypes.NewMsgSend(addr1, addr2, coins)
+	msgMultiSend := banktypes.NewMsgMultiSend(
+		[]banktypes.Input{banktypes.NewInput(addr1, coins)},
+		[]banktypes.Output{banktypes.NewOutput(addr2, coins)},
+	)
+	msgExec, err := authztypes.NewMsgExecAuthorized(addr2, []sdk.Msg{msgSend})
+	require.NoError(t, err)
+
+	fee := types.NewTestStdFee()
+
+	antehandler := sdk.ChainAnteDecorators(ante.NewCircuitBreakerDecorator(app.CircuitKeeper))
+
+	testCases := []struct {
+		name      string
+		disabled  []string
+		msgs      []sdk.Msg
+		expectErr bool
+	}{
+		{"nothing disabled", nil, []sdk.Msg{msgSend, msgMultiSend}, false},
+		{"other type URL disabled", []string{"/cosmos.bank.MsgMultiSend"}, []sdk.Msg{msgSend}, false},
+		{"other route disabled", []string{"staking"}, []sdk.Msg{msgSend, msgMultiSend}, false},
+		{"type URL disabled", []string{"/cosmos.bank.MsgSend"}, []sdk.Msg{msgMultiSend, msgSend}, true},
+		{"route disabled", []string{"bank"}, []sdk.Msg{msgMultiSend}, true},
+		{"nested type URL disabled", []string{"/cosmos.bank.MsgSend"}, []sdk.Msg{msgExec}, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			cacheCtx, _ := ctx.CacheContext()
+			if len(tc.disabled) > 0 {
+				require.NoError(t, app.CircuitKeeper.DisableMsgs(cacheCtx, tc.disabled))
+			}
+
+			tx := types.NewStdTx(tc.msgs, fee, nil, "")
+
+			for _, isCheckTx := range []bool{true, false} {
+				_, err := antehandler(cacheCtx.WithIsCheckTx(isCheckTx), tx, false)
+				if tc.expectErr {
+					require.True(t, errors.Is(err, circuittypes.ErrMsgDisabled), "expected ErrMsgDisabled, got: %v", err)
+				} else {
+					require.NoError(t, err)
+				}
+			}
+		})
+	}
+
+	// a nil keeper allows all messages
+	antehandler = sdk.ChainAnteDecorators(ante.NewCircuitBreakerDecorator(nil))
+	_, err = antehandler(ctx, types.NewStdTx([]sdk.Msg{msgSend}, fee, nil, ""), false)
+	require.NoError(t, err)
+}
@@ -13,3 +13,17 @@ type AccountKeeper interface {
 	SetAccount(ctx sdk.Context, acc types.AccountI)
 	GetModuleAddress(moduleName string) sdk.AccAddress
 }
+
+// FeegrantKeeper defines the expected feegrant keeper. It is used by the
+// DeductFeeDecorator to charge fees to a fee granter instead of the fee payer,
+// and by the GasRefundHandler to give the refunded fees back to the allowance.
+type FeegrantKeeper interface {
+	UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins) error
+	RestoreGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins) error
+}
+
+// CircuitBreakerKeeper defines the expected circuit breaker keeper. It is used
+// by the CircuitBreakerDecorator to reject the messages which are disabled.
+type CircuitBreakerKeeper interface {
+	AssertMsgEnabled(ctx sdk.Context, msg sdk.Msg) error
+}
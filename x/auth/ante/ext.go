@@ -0,0 +1,68 @@
+package ante
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// HasExtensionOptionsTx defines a Tx which can carry extension options
+type HasExtensionOptionsTx interface {
+	sdk.Tx
+	GetExtensionOptions() []*codectypes.Any
+	GetNonCriticalExtensionOptions() []*codectypes.Any
+}
+
+// ExtensionOptionsDecorator rejects a tx if it carries a critical extension
+// option whose type has not been registered as a TxExtensionOptionI
+// implementation. Unknown non-critical extension options are ignored. Txs that
+// don't implement HasExtensionOptionsTx are passed through untouched.
+type ExtensionOptionsDecorator struct{}
+
+func NewExtensionOptionsDecorator() ExtensionOptionsDecorator {
+	return ExtensionOptionsDecorator{}
+}
+
+func (eod ExtensionOptionsDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	extTx, ok := tx.(HasExtensionOptionsTx)
+	if !ok {
+		return next(ctx, tx, simulate)
+	}
+
+	for _, any := range extTx.GetExtensionOptions() {
+		if any.GetCachedValue() == nil {
+			return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnknownExtensionOptions, "unsupported extension option: %s", any.TypeUrl)
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// GetExtensionOptions returns the unpacked extension options carried by tx,
+// critical options first, followed by the non-critical ones. Options whose type
+// has not been registered are skipped. It is meant to be used by chain-specific
+// decorators to read the options they support, e.g.:
+//
+//	for _, opt := range ante.GetExtensionOptions(tx) {
+//		if o, ok := opt.(*MyOption); ok {
+//			...
+//		}
+//	}
+func GetExtensionOptions(tx sdk.Tx) []txtypes.TxExtensionOptionI {
+	extTx, ok := tx.(HasExtensionOptionsTx)
+	if !ok {
+		return nil
+	}
+
+	var opts []txtypes.TxExtensionOptionI
+	for _, anys := range [][]*codectypes.Any{extTx.GetExtensionOptions(), extTx.GetNonCriticalExtensionOptions()} {
+		for _, any := range anys {
+			if opt := any.GetCachedValue(); opt != nil {
+				opts = append(opts, opt)
+			}
+		}
+	}
+
+	return opts
+}
@@ -0,0 +1,87 @@
+package ante_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/testdata"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestExtensionOptionsDecorator(t *testing.T) {
+	// setup
+	_, ctx := createTestApp(true)
+	_, _, addr1 := types.KeyTestPubAddr()
+	msg := testdata.NewTestMsg(addr1)
+
+	// only Cat is a supported extension option
+	registry := codectypes.NewInterfaceRegistry()
+	registry.RegisterImplementations((*sdk.Msg)(nil), &testdata.TestMsg{})
+	txtypes.RegisterInterfaces(registry)
+	registry.RegisterImplementations((*txtypes.TxExtensionOptionI)(nil), &testdata.Cat{})
+	txGen := authtx.NewTxGenerator(codec.NewProtoCodec(registry), std.DefaultPublicKeyCodec{}, authtx.DefaultSignModeHandler())
+
+	cat := &testdata.Cat{Moniker: "kitty", Lives: 9}
+	knownOpt, err := codectypes.NewAnyWithValue(cat)
+	require.NoError(t, err)
+	unknownOpt, err := codectypes.NewAnyWithValue(&testdata.Dog{Name: "spot"})
+	require.NoError(t, err)
+
+	antehandler := sdk.ChainAnteDecorators(ante.NewExtensionOptionsDecorator())
+
+	testCases := []struct {
+		name        string
+		critical    []*codectypes.Any
+		nonCritical []*codectypes.Any
+		expectOpts  []txtypes.TxExtensionOptionI
+		expectErr   bool
+	}{
+		{"no extension options", nil, nil, nil, false},
+		{"known critical option", []*codectypes.Any{knownOpt}, nil, []txtypes.TxExtensionOptionI{cat}, false},
+		{"known non-critical option", nil, []*codectypes.Any{knownOpt}, []txtypes.TxExtensionOptionI{cat}, false},
+		{"unknown non-critical option", nil, []*codectypes.Any{unknownOpt}, nil, false},
+		{"unknown critical option", []*codectypes.Any{knownOpt, unknownOpt}, nil, nil, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			txBuilder := txGen.NewTxBuilder().(authtx.ExtensionOptionsTxBuilder)
+			require.NoError(t, txBuilder.SetMsgs(msg))
+			txBuilder.SetExtensionOptions(tc.critical...)
+			txBuilder.SetNonCriticalExtensionOptions(tc.nonCritical...)
+
+			// round-trip the tx so the options are unpacked through the registry
+			bz, err := txGen.TxEncoder()(txBuilder.GetTx())
+			require.NoError(t, err)
+			tx, err := txGen.TxDecoder()(bz)
+			require.NoError(t, err)
+
+			_, err = antehandler(ctx, tx, false)
+			if tc.expectErr {
+				require.True(t, errors.Is(err, sdkerrors.ErrUnknownExtensionOptions), "expected ErrUnknownExtensionOptions, got: %v", err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expectOpts, ante.GetExtensionOptions(tx))
+		})
+	}
+
+	// txs that can't carry extension options are passed through
+	stdTx := types.NewStdTx([]sdk.Msg{msg}, types.NewTestStdFee(), nil, "")
+	_, err = antehandler(ctx, stdTx, false)
+	require.NoError(t, err)
+	require.Empty(t, ante.GetExtensionOptions(stdTx))
+}
@@ -60,17 +60,23 @@ func (mfd MempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 
 // DeductFeeDecorator deducts fees from the first signer of the tx
 // If the first signer does not have the funds to pay for the fees, return with InsufficientFunds error
+// If the tx sets a fee granter, the fees are deducted from the granter instead,
+// provided it has granted the first signer a sufficient fee allowance
 // Call next AnteHandler if fees successfully deducted
 // CONTRACT: Tx must implement FeeTx interface to use DeductFeeDecorator
 type DeductFeeDecorator struct {
-	ak         AccountKeeper
-	bankKeeper types.BankKeeper
+	ak             AccountKeeper
+	bankKeeper     types.BankKeeper
+	feegrantKeeper FeegrantKeeper
 }
 
-func NewDeductFeeDecorator(ak AccountKeeper, bk types.BankKeeper) DeductFeeDecorator {
+// NewDeductFeeDecorator returns a new DeductFeeDecorator. The feegrant keeper
+// may be nil, in which case transactions setting a fee granter are rejected.
+func NewDeductFeeDecorator(ak AccountKeeper, bk types.BankKeeper, fk FeegrantKeeper) DeductFeeDecorator {
 	return DeductFeeDecorator{
-		ak:         ak,
-		bankKeeper: bk,
+		ak:             ak,
+		bankKeeper:     bk,
+		feegrantKeeper: fk,
 	}
 }
 
@@ -84,16 +90,37 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 		panic(fmt.Sprintf("%s module account has not been set", types.FeeCollectorName))
 	}
 
+	fee := feeTx.GetFee()
 	feePayer := feeTx.FeePayer()
-	feePayerAcc := dfd.ak.GetAccount(ctx, feePayer)
+	feeGranter := feeTx.FeeGranter()
 
-	if feePayerAcc == nil {
-		return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "fee payer address: %s does not exist", feePayer)
+	deductFeesFrom := feePayer
+
+	// if a fee granter was set, deduct the fees from the granter's account
+	// after checking and updating the grant it gave the fee payer
+	if !feeGranter.Empty() {
+		if dfd.feegrantKeeper == nil {
+			return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "fee grants are not enabled")
+		}
+
+		if !feeGranter.Equals(feePayer) {
+			err := dfd.feegrantKeeper.UseGrantedFees(ctx, feeGranter, feePayer, fee)
+			if err != nil {
+				return ctx, sdkerrors.Wrapf(err, "%s not allowed to pay fees from %s", feePayer, feeGranter)
+			}
+		}
+
+		deductFeesFrom = feeGranter
+	}
+
+	deductFeesFromAcc := dfd.ak.GetAccount(ctx, deductFeesFrom)
+	if deductFeesFromAcc == nil {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "fee payer address: %s does not exist", deductFeesFrom)
 	}
 
 	// deduct the fees
-	if !feeTx.GetFee().IsZero() {
-		err = DeductFees(dfd.bankKeeper, ctx, feePayerAcc, feeTx.GetFee())
+	if !fee.IsZero() {
+		err = DeductFees(dfd.bankKeeper, ctx, deductFeesFromAcc, fee)
 		if err != nil {
 			return ctx, err
 		}
@@ -115,3 +142,30 @@ func DeductFees(bankKeeper types.BankKeeper, ctx sdk.Context, acc types.AccountI
 
 	return nil
 }
+
+// deferredFeeBankKeeper is a BankKeeper sending the fees to the fee collector
+// through the deferred sends of a DeferredBankKeeper.
+type deferredFeeBankKeeper struct {
+	bk types.DeferredBankKeeper
+}
+
+// DeferFees returns a BankKeeper for the DeductFeeDecorator and the
+// GasRefundHandler which defers the crediting of the fees to the fee
+// collector, so that the txs paying fees don't conflict with each other on its
+// balance when executed in parallel. The deferred fees must be credited at the
+// end of each block, by setting the CreditDeferredCoins method of the bank
+// keeper as the BlockFinalizer of the app.
+//
+// NOTE: Deferring the fees changes the gas consumed by txs, so all the nodes
+// of a chain must make the same choice, regardless of their configuration.
+func DeferFees(bk types.DeferredBankKeeper) types.BankKeeper {
+	return deferredFeeBankKeeper{bk: bk}
+}
+
+func (dbk deferredFeeBankKeeper) SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return dbk.bk.SendCoinsFromAccountToModuleDeferred(ctx, senderAddr, recipientModule, amt)
+}
+
+func (dbk deferredFeeBankKeeper) SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	return dbk.bk.SendDeferredCoinsToAccount(ctx, senderModule, recipientAddr, amt)
+}
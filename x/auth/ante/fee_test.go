@@ -11,6 +11,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	feegranttypes "github.com/cosmos/cosmos-sdk/x/feegrant/types"
 )
 
 func TestEnsureMempoolFees(t *testing.T) {
@@ -83,7 +84,7 @@ func TestDeductFees(t *testing.T) {
 	app.AccountKeeper.SetAccount(ctx, acc)
 	app.BankKeeper.SetBalances(ctx, addr1, sdk.NewCoins(sdk.NewCoin("atom", sdk.NewInt(10))))
 
-	dfd := ante.NewDeductFeeDecorator(app.AccountKeeper, app.BankKeeper)
+	dfd := ante.NewDeductFeeDecorator(app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper)
 	antehandler := sdk.ChainAnteDecorators(dfd)
 
 	_, err := antehandler(ctx, tx, false)
@@ -98,3 +99,114 @@ func TestDeductFees(t *testing.T) {
 
 	require.Nil(t, err, "Tx errored after account has been set with sufficient funds")
 }
+
+func TestDeductFeesWithGranter(t *testing.T) {
+	// setup
+	app, ctx := createTestApp(true)
+
+	// keys and addresses
+	priv1, _, addr1 := types.KeyTestPubAddr()
+	_, _, addr2 := types.KeyTestPubAddr()
+
+	// msg and signatures
+	msg1 := testdata.NewTestMsg(addr1)
+	fee := types.NewTestStdFee()
+	fee.Granter = addr2
+
+	msgs := []sdk.Msg{msg1}
+
+	privs, accNums, seqs := []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx := types.NewTestTx(ctx, msgs, privs, accNums, seqs, fee)
+
+	// fee payer has no funds, granter has enough to cover the fees
+	acc1 := app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	app.AccountKeeper.SetAccount(ctx, acc1)
+	acc2 := app.AccountKeeper.NewAccountWithAddress(ctx, addr2)
+	app.AccountKeeper.SetAccount(ctx, acc2)
+	app.BankKeeper.SetBalances(ctx, addr2, sdk.NewCoins(sdk.NewCoin("atom", sdk.NewInt(200))))
+
+	// fee granters are rejected without a feegrant keeper
+	antehandler := sdk.ChainAnteDecorators(ante.NewDeductFeeDecorator(app.AccountKeeper, app.BankKeeper, nil))
+	_, err := antehandler(ctx, tx, false)
+	require.Error(t, err, "Tx did not error when fee grants are disabled")
+
+	dfd := ante.NewDeductFeeDecorator(app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper)
+	antehandler = sdk.ChainAnteDecorators(dfd)
+
+	_, err = antehandler(ctx, tx, false)
+	require.Error(t, err, "Tx did not error when granter had not granted an allowance")
+
+	// allowance too small to cover the fees
+	err = app.FeeGrantKeeper.GrantFeeAllowance(ctx, addr2, addr1, &feegranttypes.BasicFeeAllowance{
+		SpendLimit: sdk.NewCoins(sdk.NewCoin("atom", sdk.NewInt(100))),
+	})
+	require.NoError(t, err)
+
+	_, err = antehandler(ctx, tx, false)
+	require.Error(t, err, "Tx did not error when allowance was insufficient")
+
+	// sufficient allowance
+	err = app.FeeGrantKeeper.GrantFeeAllowance(ctx, addr2, addr1, &feegranttypes.BasicFeeAllowance{
+		SpendLimit: sdk.NewCoins(sdk.NewCoin("atom", sdk.NewInt(500))),
+	})
+	require.NoError(t, err)
+
+	_, err = antehandler(ctx, tx, false)
+	require.NoError(t, err, "Tx errored after granter granted a sufficient allowance")
+
+	// fees are deducted from the granter and the allowance
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, addr1).IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("atom", sdk.NewInt(50))), app.BankKeeper.GetAllBalances(ctx, addr2))
+
+	allowance, err := app.FeeGrantKeeper.GetFeeAllowance(ctx, addr2, addr1)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("atom", sdk.NewInt(350))), allowance.(*feegranttypes.BasicFeeAllowance).SpendLimit)
+}
+
+func TestDeductFeesDeferred(t *testing.T) {
+	// setup
+	app, ctx := createTestApp(true)
+	ctx = ctx.WithTxBytes([]byte("tx"))
+
+	// keys and addresses
+	priv1, _, addr1 := types.KeyTestPubAddr()
+
+	// msg and signatures
+	msg1 := testdata.NewTestMsg(addr1)
+	fee := types.NewTestStdFee()
+
+	msgs := []sdk.Msg{msg1}
+
+	privs, accNums, seqs := []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx := types.NewTestTx(ctx, msgs, privs, accNums, seqs, fee)
+
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	app.AccountKeeper.SetAccount(ctx, acc)
+	app.BankKeeper.SetBalances(ctx, addr1, sdk.NewCoins(sdk.NewCoin("atom", sdk.NewInt(200))))
+
+	bankKeeper := ante.DeferFees(app.BankKeeper)
+	antehandler := sdk.ChainAnteDecorators(ante.NewDeductFeeDecorator(app.AccountKeeper, bankKeeper, app.FeeGrantKeeper))
+
+	_, err := antehandler(ctx, tx, false)
+	require.NoError(t, err)
+
+	// the fees are deducted, but not yet credited to the fee collector
+	feeCollector := app.AccountKeeper.GetModuleAddress(types.FeeCollectorName)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("atom", sdk.NewInt(50))), app.BankKeeper.GetAllBalances(ctx, addr1))
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, feeCollector).IsZero())
+	require.Equal(t, fee.Amount, app.BankKeeper.GetAllDeferredCoins(ctx))
+
+	// the refund is taken from the deferred fees
+	posthandler := ante.NewPostHandler(bankKeeper, nil, sdk.OneDec())
+	postCtx := ctx.WithGasMeter(sdk.NewGasMeter(fee.Gas))
+	postCtx.GasMeter().ConsumeGas(fee.Gas/3*2, "test")
+
+	_, err = posthandler(postCtx, tx, false, true)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("atom", sdk.NewInt(100))), app.BankKeeper.GetAllBalances(ctx, addr1))
+
+	// the rest of the fees is credited at the end of the block
+	app.BankKeeper.CreditDeferredCoins(ctx)
+	require.True(t, app.BankKeeper.GetAllDeferredCoins(ctx).IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("atom", sdk.NewInt(100))), app.BankKeeper.GetAllBalances(ctx, feeCollector))
+}
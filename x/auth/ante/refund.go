@@ -0,0 +1,112 @@
+package ante
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// GasRefundHandler refunds a fraction of the fees of the gas a tx didn't
+// consume, from the fee collector to the account the fees were deducted from
+// by the DeductFeeDecorator, i.e. the fee granter if set, or the fee payer.
+// The refund of a fee coin is the refund ratio of the fee amount times the
+// unused fraction of the gas limit, rounded down. A refund to a fee granter
+// is given back to the allowance it granted the fee payer.
+// CONTRACT: Tx must implement FeeTx interface to use GasRefundHandler
+type GasRefundHandler struct {
+	bankKeeper     types.BankKeeper
+	feegrantKeeper FeegrantKeeper
+	refundRatio    sdk.Dec
+}
+
+// NewGasRefundHandler returns a new GasRefundHandler refunding the given ratio
+// of the fees of the unused gas. It panics if the ratio isn't between 0 and 1.
+// The feegrant keeper may be nil if fee grants are disabled.
+func NewGasRefundHandler(bk types.BankKeeper, fk FeegrantKeeper, refundRatio sdk.Dec) GasRefundHandler {
+	if refundRatio.IsNegative() || refundRatio.GT(sdk.OneDec()) {
+		panic(fmt.Sprintf("gas refund ratio must be between 0 and 1: %s", refundRatio))
+	}
+
+	return GasRefundHandler{
+		bankKeeper:     bk,
+		feegrantKeeper: fk,
+		refundRatio:    refundRatio,
+	}
+}
+
+// NewPostHandler returns a PostHandler refunding the fees of unused gas with
+// a GasRefundHandler.
+func NewPostHandler(bk types.BankKeeper, fk FeegrantKeeper, refundRatio sdk.Dec) sdk.PostHandler {
+	return NewGasRefundHandler(bk, fk, refundRatio).PostHandle
+}
+
+// PostHandle implements the sdk.PostHandler function type. The refund is sent
+// with an infinite GasMeter, so that it doesn't consume the gas of the tx. As
+// it is persisted even if the messages failed, its event is then part of the
+// failed DeliverTx response.
+func (grh GasRefundHandler) PostHandle(ctx sdk.Context, tx sdk.Tx, _, _ bool) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	refund := grh.Refund(feeTx.GetFee(), feeTx.GetGas(), ctx.GasMeter().GasConsumed())
+	if refund.IsZero() {
+		return ctx, nil
+	}
+
+	feePayer := feeTx.FeePayer()
+	feeGranter := feeTx.FeeGranter()
+
+	refundTo := feePayer
+	if !feeGranter.Empty() {
+		refundTo = feeGranter
+	}
+
+	refundCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	if err := grh.bankKeeper.SendCoinsFromModuleToAccount(refundCtx, types.FeeCollectorName, refundTo, refund); err != nil {
+		return ctx, sdkerrors.Wrapf(err, "failed to refund fees to %s", refundTo)
+	}
+
+	// the DeductFeeDecorator charged the whole fee to the allowance
+	if !feeGranter.Empty() && !feeGranter.Equals(feePayer) && grh.feegrantKeeper != nil {
+		if err := grh.feegrantKeeper.RestoreGrantedFees(refundCtx, feeGranter, feePayer, refund); err != nil {
+			return ctx, sdkerrors.Wrapf(err, "failed to restore the fee allowance of %s from %s", feePayer, feeGranter)
+		}
+	}
+
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyFeePayer, feePayer.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, refund.String()),
+	}
+	if !feeGranter.Empty() {
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyFeeGranter, feeGranter.String()))
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeFeeRefund, attrs...))
+
+	return ctx, nil
+}
+
+// Refund returns the fees refunded for a tx paying the given fee, with the
+// given gas limit, which consumed the given amount of gas.
+func (grh GasRefundHandler) Refund(fee sdk.Coins, gasLimit, gasUsed uint64) sdk.Coins {
+	if gasLimit == 0 || gasUsed >= gasLimit || grh.refundRatio.IsZero() {
+		return sdk.NewCoins()
+	}
+
+	unused := sdk.NewDecFromBigInt(new(big.Int).SetUint64(gasLimit - gasUsed)).
+		Quo(sdk.NewDecFromBigInt(new(big.Int).SetUint64(gasLimit))).
+		Mul(grh.refundRatio)
+
+	refund := sdk.NewCoins()
+	for _, coin := range fee {
+		amount := unused.MulInt(coin.Amount).TruncateInt()
+		refund = refund.Add(sdk.NewCoin(coin.Denom, amount))
+	}
+
+	return refund
+}
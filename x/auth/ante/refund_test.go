@@ -0,0 +1,123 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/cosmos/cosmos-sdk/codec/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	feegranttypes "github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+func TestGasRefundHandler(t *testing.T) {
+	// setup
+	app, ctx := createTestApp(true)
+
+	// keys and addresses
+	priv1, _, addr1 := types.KeyTestPubAddr()
+	_, _, addr2 := types.KeyTestPubAddr()
+
+	// msg and signatures, with a fee of 150atom for 100000 gas
+	msgs := []sdk.Msg{testdata.NewTestMsg(addr1)}
+	fee := types.NewTestStdFee()
+
+	privs, accNums, seqs := []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx := types.NewTestTx(ctx, msgs, privs, accNums, seqs, fee)
+
+	feeCollector := app.AccountKeeper.GetModuleAddress(types.FeeCollectorName)
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, feeCollector, sdk.NewCoins(sdk.NewInt64Coin("atom", 300))))
+
+	require.Panics(t, func() { ante.NewGasRefundHandler(app.BankKeeper, app.FeeGrantKeeper, sdk.NewDecWithPrec(11, 1)) })
+	require.Panics(t, func() { ante.NewGasRefundHandler(app.BankKeeper, app.FeeGrantKeeper, sdk.NewDec(-1)) })
+
+	// balances are read without consuming the gas of the tx
+	balances := func(addr sdk.AccAddress) sdk.Coins {
+		return app.BankKeeper.GetAllBalances(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), addr)
+	}
+
+	posthandler := ante.NewPostHandler(app.BankKeeper, app.FeeGrantKeeper, sdk.NewDecWithPrec(5, 1))
+
+	// half of the fees of the 60% of unused gas is refunded to the fee payer
+	ctx = ctx.WithGasMeter(sdk.NewGasMeter(fee.Gas)).WithEventManager(sdk.NewEventManager())
+	ctx.GasMeter().ConsumeGas(40000, "test")
+
+	_, err := posthandler(ctx, tx, false, true)
+	require.NoError(t, err)
+
+	// the refund doesn't consume the gas of the tx
+	require.Equal(t, uint64(40000), ctx.GasMeter().GasConsumed())
+
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 45)), balances(addr1))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 255)), balances(feeCollector))
+
+	events := ctx.EventManager().Events()
+	require.Equal(t, sdk.NewEvent(
+		types.EventTypeFeeRefund,
+		sdk.NewAttribute(types.AttributeKeyFeePayer, addr1.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, "45atom"),
+	), events[len(events)-1])
+
+	// the refund goes to the fee granter if set, and is given back to the
+	// allowance, which was charged the whole fee by the DeductFeeDecorator
+	fee.Granter = addr2
+	tx = types.NewTestTx(ctx, msgs, privs, accNums, seqs, fee)
+
+	spendLimit := sdk.NewCoins(sdk.NewInt64Coin("atom", 200))
+	require.NoError(t, app.FeeGrantKeeper.GrantFeeAllowance(ctx, addr2, addr1, feegranttypes.NewBasicFeeAllowance(spendLimit, nil)))
+	require.NoError(t, app.FeeGrantKeeper.UseGrantedFees(ctx, addr2, addr1, fee.Amount))
+
+	ctx = ctx.WithGasMeter(sdk.NewGasMeter(fee.Gas)).WithEventManager(sdk.NewEventManager())
+	ctx.GasMeter().ConsumeGas(40000, "test")
+
+	_, err = posthandler(ctx, tx, false, false)
+	require.NoError(t, err)
+	require.Equal(t, uint64(40000), ctx.GasMeter().GasConsumed())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 45)), balances(addr2))
+
+	allowance, err := app.FeeGrantKeeper.GetFeeAllowance(ctx, addr2, addr1)
+	require.NoError(t, err)
+	require.Equal(t, feegranttypes.NewBasicFeeAllowance(sdk.NewCoins(sdk.NewInt64Coin("atom", 95)), nil), allowance)
+
+	events = ctx.EventManager().Events()
+	require.Equal(t, sdk.NewEvent(
+		types.EventTypeFeeRefund,
+		sdk.NewAttribute(types.AttributeKeyFeePayer, addr1.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, "45atom"),
+		sdk.NewAttribute(types.AttributeKeyFeeGranter, addr2.String()),
+	), events[len(events)-1])
+
+	// nothing is refunded if all the gas was consumed
+	ctx = ctx.WithGasMeter(sdk.NewGasMeter(fee.Gas)).WithEventManager(sdk.NewEventManager())
+	ctx.GasMeter().ConsumeGas(fee.Gas, "test")
+
+	_, err = posthandler(ctx, tx, false, true)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 210)), balances(feeCollector))
+	require.Empty(t, ctx.EventManager().Events())
+}
+
+func TestGasRefundHandlerRefund(t *testing.T) {
+	grh := ante.NewGasRefundHandler(nil, nil, sdk.NewDecWithPrec(5, 1))
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 150), sdk.NewInt64Coin("stake", 3))
+
+	testCases := []struct {
+		msg      string
+		gasLimit uint64
+		gasUsed  uint64
+		expected sdk.Coins
+	}{
+		{"no gas used", 100000, 0, sdk.NewCoins(sdk.NewInt64Coin("atom", 75), sdk.NewInt64Coin("stake", 1))},
+		{"amounts rounded down", 100000, 40000, sdk.NewCoins(sdk.NewInt64Coin("atom", 45))},
+		{"all gas used", 100000, 100000, sdk.NewCoins()},
+		{"out of gas", 100000, 100001, sdk.NewCoins()},
+		{"no gas limit", 0, 0, sdk.NewCoins()},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expected, grh.Refund(fee, tc.gasLimit, tc.gasUsed), tc.msg)
+	}
+}
@@ -44,6 +44,15 @@ func TestSetup(t *testing.T) {
 
 	// Context GasMeter Limit should be set after SetUpContextDecorator runs
 	require.Equal(t, fee.Gas, newCtx.GasMeter().Limit(), "GasMeter not set correctly")
+
+	// the GasMeter set keeps recording the gas consumption by descriptor
+	recordingGasMeter := sdk.NewRecordingGasMeter(ctx.GasMeter())
+	newCtx, err = antehandler(ctx.WithGasMeter(recordingGasMeter), tx, false)
+	require.Nil(t, err, "SetUpContextDecorator returned error")
+	require.Equal(t, fee.Gas, newCtx.GasMeter().Limit(), "GasMeter not set correctly")
+
+	newCtx.GasMeter().ConsumeGas(10, "test")
+	require.Equal(t, sdk.Gas(10), recordingGasMeter.GasConsumedByDescriptor()["test"])
 }
 
 func TestRecoverPanic(t *testing.T) {
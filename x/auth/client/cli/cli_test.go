@@ -301,7 +301,8 @@ func (s *IntegrationTestSuite) TestCLISendGenerateSignAndBroadcast() {
 
 	err = val1.ClientCtx.JSONMarshaler.UnmarshalJSON(resp.Bytes(), &coins)
 	s.Require().NoError(err)
-	s.Require().Equal(sdk.NewInt(389999990), coins.AmountOf(cli.Denom))
+	// the fees of the unused gas are partially refunded
+	s.Require().Equal(sdk.NewInt(389999993), coins.AmountOf(cli.Denom))
 }
 
 func (s *IntegrationTestSuite) TestCLIMultisignInsufficientCosigners() {
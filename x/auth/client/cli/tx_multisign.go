@@ -108,7 +108,7 @@ func makeMultiSignCmd() func(cmd *cobra.Command, args []string) error {
 
 			// Validate each signature
 			sigBytes := types.StdSignBytes(
-				txBldr.ChainID(), txBldr.AccountNumber(), txBldr.Sequence(),
+				txBldr.ChainID(), txBldr.AccountNumber(), txBldr.Sequence(), stdTx.GetTimeoutHeight(),
 				stdTx.Fee, stdTx.GetMsgs(), stdTx.GetMemo(),
 			)
 			if ok := stdSig.GetPubKey().VerifyBytes(sigBytes, stdSig.Signature); !ok {
@@ -100,7 +100,7 @@ func printAndValidateSigs(
 			}
 
 			sigBytes := types.StdSignBytes(
-				chainID, acc.GetAccountNumber(), acc.GetSequence(),
+				chainID, acc.GetAccountNumber(), acc.GetSequence(), stdTx.GetTimeoutHeight(),
 				stdTx.Fee, stdTx.GetMsgs(), stdTx.GetMemo(),
 			)
 
@@ -16,9 +16,10 @@ type SigVerifiableTx interface {
 }
 
 // SigFeeMemoTx defines an interface for transactions that support all standard message, signature,
-// fee and memo interfaces.
+// fee, memo and timeout height interfaces.
 type SigFeeMemoTx interface {
 	SigVerifiableTx
 	types.TxWithMemo
+	types.TxWithTimeoutHeight
 	types.FeeTx
 }
@@ -0,0 +1,48 @@
+package textual
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MsgRenderer renders a single message as a list of deterministic,
+// human-readable lines for SIGN_MODE_TEXTUAL. Lines must not contain newlines.
+type MsgRenderer func(msg sdk.Msg) ([]string, error)
+
+var (
+	renderersMtx sync.RWMutex
+	renderers    = make(map[string]MsgRenderer)
+)
+
+// RegisterMsgRenderer registers the renderer used for messages of the same
+// protobuf type as msg. Modules are expected to register renderers for their
+// messages at init time. It panics if a renderer has already been registered
+// for the message type or if msg isn't a registered protobuf message.
+func RegisterMsgRenderer(msg sdk.Msg, renderer MsgRenderer) {
+	name := proto.MessageName(msg)
+	if name == "" {
+		panic(fmt.Sprintf("%T is not a registered protobuf message", msg))
+	}
+
+	renderersMtx.Lock()
+	defer renderersMtx.Unlock()
+
+	if _, ok := renderers[name]; ok {
+		panic(fmt.Sprintf("already registered textual renderer for message: %s", name))
+	}
+
+	renderers[name] = renderer
+}
+
+// getMsgRenderer returns the renderer registered for msg, if any.
+func getMsgRenderer(msg sdk.Msg) (MsgRenderer, bool) {
+	renderersMtx.RLock()
+	defer renderersMtx.RUnlock()
+
+	r, ok := renderers[proto.MessageName(msg)]
+	return r, ok
+}
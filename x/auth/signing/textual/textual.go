@@ -0,0 +1,144 @@
+package textual
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing/direct"
+)
+
+// ModeHandler defines the SIGN_MODE_TEXTUAL SignModeHandler. The sign bytes
+// are a newline separated list of human-readable lines describing the tx, so
+// that they can be displayed as is on a hardware wallet. The last line is the
+// hash of the SIGN_MODE_DIRECT sign bytes, which makes the signature commit to
+// every field of the tx, including the ones that aren't rendered.
+type ModeHandler struct{}
+
+var _ signing.SignModeHandler = ModeHandler{}
+
+// DefaultMode implements SignModeHandler.DefaultMode
+func (ModeHandler) DefaultMode() signingtypes.SignMode {
+	return signingtypes.SignMode_SIGN_MODE_TEXTUAL
+}
+
+// Modes implements SignModeHandler.Modes
+func (ModeHandler) Modes() []signingtypes.SignMode {
+	return []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_TEXTUAL}
+}
+
+// GetSignBytes implements SignModeHandler.GetSignBytes
+func (ModeHandler) GetSignBytes(mode signingtypes.SignMode, data signing.SignerData, tx sdk.Tx) ([]byte, error) {
+	if mode != signingtypes.SignMode_SIGN_MODE_TEXTUAL {
+		return nil, fmt.Errorf("expected %s, got %s", signingtypes.SignMode_SIGN_MODE_TEXTUAL, mode)
+	}
+
+	lines, err := RenderTx(data, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(strings.Join(lines, "\n")), nil
+}
+
+// RenderTx returns the SIGN_MODE_TEXTUAL lines for the provided SignerData and
+// Tx. Messages are rendered with the MsgRenderer registered for their type, or
+// with a generic renderer based on their sign bytes if none was registered.
+func RenderTx(data signing.SignerData, tx sdk.Tx) ([]string, error) {
+	protoTx, ok := tx.(direct.ProtoTx)
+	if !ok {
+		return nil, fmt.Errorf("can only get textual sign bytes for a ProtoTx, got %T", tx)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return nil, fmt.Errorf("expected FeeTx, got %T", tx)
+	}
+
+	memoTx, ok := tx.(sdk.TxWithMemo)
+	if !ok {
+		return nil, fmt.Errorf("expected TxWithMemo, got %T", tx)
+	}
+
+	lines := []string{
+		fmt.Sprintf("Chain ID: %s", sanitize(data.ChainID)),
+		fmt.Sprintf("Account number: %d", data.AccountNumber),
+		fmt.Sprintf("Sequence: %d", data.AccountSequence),
+	}
+
+	msgs := tx.GetMsgs()
+	for i, msg := range msgs {
+		lines = append(lines, fmt.Sprintf("Message (%d/%d): %s", i+1, len(msgs), proto.MessageName(msg)))
+
+		msgLines, err := renderMsg(msg)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, l := range msgLines {
+			lines = append(lines, "  "+sanitize(l))
+		}
+	}
+
+	if memo := memoTx.GetMemo(); memo != "" {
+		lines = append(lines, fmt.Sprintf("Memo: %s", sanitize(memo)))
+	}
+
+	if fee := feeTx.GetFee(); !fee.Empty() {
+		lines = append(lines, fmt.Sprintf("Fee: %s", fee))
+	}
+
+	if granter := feeTx.FeeGranter(); !granter.Empty() {
+		lines = append(lines, fmt.Sprintf("Fee granter: %s", granter))
+	}
+
+	lines = append(lines, fmt.Sprintf("Gas limit: %d", feeTx.GetGas()))
+
+	if timeoutTx, ok := tx.(sdk.TxWithTimeoutHeight); ok && timeoutTx.GetTimeoutHeight() > 0 {
+		lines = append(lines, fmt.Sprintf("Timeout height: %d", timeoutTx.GetTimeoutHeight()))
+	}
+
+	directBz, err := direct.SignBytes(
+		protoTx.GetBodyBytes(), protoTx.GetAuthInfoBytes(), data.ChainID, data.AccountNumber, data.AccountSequence,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	lines = append(lines, fmt.Sprintf("Hash: %X", sha256.Sum256(directBz)))
+
+	return lines, nil
+}
+
+// renderMsg renders msg with its registered MsgRenderer, falling back to its
+// route, type and sign bytes.
+func renderMsg(msg sdk.Msg) ([]string, error) {
+	if renderer, ok := getMsgRenderer(msg); ok {
+		return renderer(msg)
+	}
+
+	return []string{
+		fmt.Sprintf("Route: %s", msg.Route()),
+		fmt.Sprintf("Type: %s", msg.Type()),
+		fmt.Sprintf("Data: %s", msg.GetSignBytes()),
+	}, nil
+}
+
+// sanitize quotes s if it contains characters which could break the line
+// based layout or not be displayed faithfully.
+func sanitize(s string) string {
+	for _, r := range s {
+		if !unicode.IsPrint(r) {
+			return strconv.Quote(s)
+		}
+	}
+
+	return s
+}
@@ -0,0 +1,108 @@
+package textual_test
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/testdata"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing/direct"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing/textual"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestTextualModeHandler(t *testing.T) {
+	_, _, addr1 := authtypes.KeyTestPubAddr()
+	_, _, addr2 := authtypes.KeyTestPubAddr()
+
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	interfaceRegistry.RegisterImplementations((*sdk.Msg)(nil), &testdata.TestMsg{}, &banktypes.MsgSend{})
+	marshaler := codec.NewProtoCodec(interfaceRegistry)
+	txGen := tx.NewTxGenerator(marshaler, std.DefaultPublicKeyCodec{}, tx.DefaultSignModeHandler())
+
+	sendMsg := banktypes.NewMsgSend(addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("atom", 10)))
+	testMsg := testdata.NewTestMsg(addr1)
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 150))
+
+	txBuilder := txGen.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(sendMsg, testMsg))
+	txBuilder.SetMemo("sometestmemo\nwith a newline")
+	txBuilder.SetFeeAmount(fee)
+	txBuilder.SetGasLimit(20000)
+	txBuilder.SetTimeoutHeight(10)
+
+	t.Log("verify modes and default-mode")
+	textualModeHandler := textual.ModeHandler{}
+	require.Equal(t, signingtypes.SignMode_SIGN_MODE_TEXTUAL, textualModeHandler.DefaultMode())
+	require.Len(t, textualModeHandler.Modes(), 1)
+
+	signingData := signing.SignerData{
+		ChainID:         "test-chain",
+		AccountNumber:   1,
+		AccountSequence: 2,
+	}
+
+	signBytes, err := textualModeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+
+	protoTx := txBuilder.GetTx().(direct.ProtoTx)
+	directBz, err := direct.SignBytes(protoTx.GetBodyBytes(), protoTx.GetAuthInfoBytes(), "test-chain", 1, 2)
+	require.NoError(t, err)
+
+	expectedLines := []string{
+		"Chain ID: test-chain",
+		"Account number: 1",
+		"Sequence: 2",
+		"Message (1/2): cosmos.bank.MsgSend",
+		fmt.Sprintf("  From: %s", addr1),
+		fmt.Sprintf("  To: %s", addr2),
+		"  Amount: 10atom",
+		"Message (2/2): testdata.TestMsg",
+		"  Route: TestMsg",
+		"  Type: Test message",
+		fmt.Sprintf("  Data: %s", testMsg.GetSignBytes()),
+		`Memo: "sometestmemo\nwith a newline"`,
+		"Fee: 150atom",
+		"Gas limit: 20000",
+		"Timeout height: 10",
+		fmt.Sprintf("Hash: %X", sha256.Sum256(directBz)),
+	}
+	require.Equal(t, strings.Join(expectedLines, "\n"), string(signBytes))
+
+	t.Log("verify sign bytes are the same through the default SignModeHandler")
+	defaultSignBytes, err := txGen.SignModeHandler().GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+	require.Equal(t, signBytes, defaultSignBytes)
+
+	t.Log("verify sign bytes commit to the signer data")
+	signingData.AccountSequence = 3
+	otherSignBytes, err := textualModeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+	require.NotEqual(t, signBytes, otherSignBytes)
+
+	t.Log("verify unsupported modes and txs are rejected")
+	_, err = textualModeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_DIRECT, signingData, txBuilder.GetTx())
+	require.Error(t, err)
+
+	stdTx := authtypes.NewStdTx([]sdk.Msg{sendMsg}, authtypes.NewTestStdFee(), nil, "")
+	_, err = textualModeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signingData, stdTx)
+	require.Error(t, err)
+}
+
+func TestRegisterMsgRenderer(t *testing.T) {
+	// MsgSend's renderer is registered by x/bank
+	require.Panics(t, func() {
+		textual.RegisterMsgRenderer(&banktypes.MsgSend{}, func(sdk.Msg) ([]string, error) { return nil, nil })
+	})
+}
@@ -50,7 +50,7 @@ func TestVerifySignature(t *testing.T) {
 		AccountNumber:   acc.GetAccountNumber(),
 		AccountSequence: acc.GetSequence(),
 	}
-	signBytes := types.StdSignBytes(signerData.ChainID, signerData.AccountNumber, signerData.AccountSequence,
+	signBytes := types.StdSignBytes(signerData.ChainID, signerData.AccountNumber, signerData.AccountSequence, 0,
 		fee, msgs, memo)
 	signature, err := priv.Sign(signBytes)
 	require.NoError(t, err)
@@ -68,7 +68,7 @@ func TestVerifySignature(t *testing.T) {
 	multisigKey := multisig.NewPubKeyMultisigThreshold(2, pkSet)
 	multisignature := multisig.NewMultisig(2)
 	msgs = []sdk.Msg{testdata.NewTestMsg(addr, addr1)}
-	multiSignBytes := types.StdSignBytes(signerData.ChainID, signerData.AccountNumber, signerData.AccountSequence,
+	multiSignBytes := types.StdSignBytes(signerData.ChainID, signerData.AccountNumber, signerData.AccountSequence, 0,
 		fee, msgs, memo)
 
 	sig1, err := priv.Sign(multiSignBytes)
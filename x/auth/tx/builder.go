@@ -42,11 +42,20 @@ type builder struct {
 }
 
 var (
-	_ authsigning.SigFeeMemoTx = &builder{}
-	_ client.TxBuilder         = &builder{}
-	_ direct.ProtoTx           = &builder{}
+	_ authsigning.SigFeeMemoTx  = &builder{}
+	_ client.TxBuilder          = &builder{}
+	_ ExtensionOptionsTxBuilder = &builder{}
+	_ direct.ProtoTx            = &builder{}
 )
 
+// ExtensionOptionsTxBuilder defines a TxBuilder that can also set extension options.
+type ExtensionOptionsTxBuilder interface {
+	client.TxBuilder
+
+	SetExtensionOptions(...*codectypes.Any)
+	SetNonCriticalExtensionOptions(...*codectypes.Any)
+}
+
 func newBuilder(marshaler codec.Marshaler, pubkeyCodec types.PublicKeyCodec) *builder {
 	return &builder{
 		tx: &tx.Tx{
@@ -198,10 +207,18 @@ func (t *builder) FeePayer() sdk.AccAddress {
 	return t.GetSigners()[0]
 }
 
+func (t *builder) FeeGranter() sdk.AccAddress {
+	return t.tx.AuthInfo.Fee.Granter
+}
+
 func (t *builder) GetMemo() string {
 	return t.tx.Body.Memo
 }
 
+func (t *builder) GetTimeoutHeight() uint64 {
+	return t.tx.Body.TimeoutHeight
+}
+
 func (t *builder) GetSignatures() [][]byte {
 	return t.tx.Signatures
 }
@@ -254,6 +271,35 @@ func (t *builder) SetMemo(memo string) {
 	t.bodyBz = nil
 }
 
+func (t *builder) GetExtensionOptions() []*codectypes.Any {
+	return t.tx.Body.ExtensionOptions
+}
+
+func (t *builder) GetNonCriticalExtensionOptions() []*codectypes.Any {
+	return t.tx.Body.NonCriticalExtensionOptions
+}
+
+func (t *builder) SetExtensionOptions(extOpts ...*codectypes.Any) {
+	t.tx.Body.ExtensionOptions = extOpts
+
+	// set bodyBz to nil because the cached bodyBz no longer matches tx.Body
+	t.bodyBz = nil
+}
+
+func (t *builder) SetNonCriticalExtensionOptions(extOpts ...*codectypes.Any) {
+	t.tx.Body.NonCriticalExtensionOptions = extOpts
+
+	// set bodyBz to nil because the cached bodyBz no longer matches tx.Body
+	t.bodyBz = nil
+}
+
+func (t *builder) SetTimeoutHeight(height uint64) {
+	t.tx.Body.TimeoutHeight = height
+
+	// set bodyBz to nil because the cached bodyBz no longer matches tx.Body
+	t.bodyBz = nil
+}
+
 func (t *builder) SetGasLimit(limit uint64) {
 	if t.tx.AuthInfo.Fee == nil {
 		t.tx.AuthInfo.Fee = &tx.Fee{}
@@ -276,6 +322,17 @@ func (t *builder) SetFeeAmount(coins sdk.Coins) {
 	t.authInfoBz = nil
 }
 
+func (t *builder) SetFeeGranter(feeGranter sdk.AccAddress) {
+	if t.tx.AuthInfo.Fee == nil {
+		t.tx.AuthInfo.Fee = &tx.Fee{}
+	}
+
+	t.tx.AuthInfo.Fee.Granter = feeGranter
+
+	// set authInfoBz to nil because the cached authInfoBz no longer matches tx.AuthInfo
+	t.authInfoBz = nil
+}
+
 func (t *builder) SetSignatures(signatures ...signing.SignatureV2) error {
 	n := len(signatures)
 	signerInfos := make([]*tx.SignerInfo, n)
@@ -39,7 +39,13 @@ func (LegacyAminoJSONHandler) GetSignBytes(mode signingtypes.SignMode, data sign
 		return nil, fmt.Errorf("expected TxWithMemo, got %T", tx)
 	}
 
+	var timeoutHeight uint64
+	if timeoutTx, ok := tx.(sdk.TxWithTimeoutHeight); ok {
+		timeoutHeight = timeoutTx.GetTimeoutHeight()
+	}
+
 	return StdSignBytes(
-		data.ChainID, data.AccountNumber, data.AccountSequence, StdFee{Amount: feeTx.GetFee(), Gas: feeTx.GetGas()}, tx.GetMsgs(), memoTx.GetMemo(),
+		data.ChainID, data.AccountNumber, data.AccountSequence, timeoutHeight,
+		StdFee{Amount: feeTx.GetFee(), Gas: feeTx.GetGas(), Granter: feeTx.FeeGranter()}, tx.GetMsgs(), memoTx.GetMemo(),
 	), nil
 }
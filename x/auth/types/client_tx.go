@@ -66,11 +66,21 @@ func (s *StdTxBuilder) SetGasLimit(limit uint64) {
 	s.StdTx.Fee.Gas = limit
 }
 
+// SetFeeGranter implements TxBuilder.SetFeeGranter
+func (s *StdTxBuilder) SetFeeGranter(feeGranter sdk.AccAddress) {
+	s.StdTx.Fee.Granter = feeGranter
+}
+
 // SetMemo implements TxBuilder.SetMemo
 func (s *StdTxBuilder) SetMemo(memo string) {
 	s.Memo = memo
 }
 
+// SetTimeoutHeight implements TxBuilder.SetTimeoutHeight
+func (s *StdTxBuilder) SetTimeoutHeight(height uint64) {
+	s.TimeoutHeight = height
+}
+
 // StdTxGenerator is a context.TxGenerator for StdTx
 type StdTxGenerator struct {
 	Cdc *codec.Codec
@@ -0,0 +1,9 @@
+package types
+
+// auth module event types
+const (
+	EventTypeFeeRefund = "fee_refund"
+
+	AttributeKeyFeePayer   = "fee_payer"
+	AttributeKeyFeeGranter = "fee_granter"
+)
@@ -0,0 +1,138 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+// GetQueryCmd returns the parent command for all x/authz CLI query commands.
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the authz module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetCmdQueryAuthorization(),
+		GetCmdQueryAuthorizations(),
+	)
+
+	return cmd
+}
+
+// GetCmdQueryAuthorization returns the authorization for a message type
+// granted by a granter to a grantee.
+func GetCmdQueryAuthorization() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "authorization [granter] [grantee] [msg_type]",
+		Short: "Query authorization for a message type",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the authorization granted by a granter to a grantee for a message type.
+
+Example:
+  $ %s query %s authorization cosmos1skjw... cosmos1skjwj... cosmos.bank.MsgSend
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			granter, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Authorization(
+				context.Background(),
+				types.NewQueryAuthorizationRequest(granter, grantee, args[2]),
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res.Authorization)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryAuthorizations returns all the authorizations granted by a
+// granter to a grantee.
+func GetCmdQueryAuthorizations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "authorizations [granter] [grantee]",
+		Short: "Query list of authorizations",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the authorizations granted by a granter to a grantee.
+
+Example:
+  $ %s query %s authorizations cosmos1skjw... cosmos1skjwj...
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			granter, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Authorizations(
+				context.Background(),
+				types.NewQueryAuthorizationsRequest(granter, grantee, &query.PageRequest{}),
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
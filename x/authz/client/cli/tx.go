@@ -0,0 +1,233 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+// flags for authz module
+const (
+	FlagSpendLimit = "spend-limit"
+	FlagMsgType    = "msg-type"
+	FlagExpiration = "expiration"
+)
+
+// authorization types accepted by the grant command
+const (
+	authorizationTypeSend    = "send"
+	authorizationTypeGeneric = "generic"
+)
+
+// NewTxCmd returns a root CLI command handler for all x/authz transaction commands.
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Authorization transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewCmdGrantAuthorization(),
+		NewCmdRevokeAuthorization(),
+		NewCmdExecAuthorization(),
+	)
+
+	return txCmd
+}
+
+// NewCmdGrantAuthorization returns a CLI command handler for creating a
+// MsgGrantAuthorization transaction.
+func NewCmdGrantAuthorization() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant [grantee] [authorization_type=\"send\"|\"generic\"] --from [granter]",
+		Short: "Grant authorization to an address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Grant authorization to an address to execute a transaction on your behalf.
+A send authorization allows the grantee to send coins up to --spend-limit,
+a generic authorization allows the grantee to execute any message of type
+--msg-type.
+
+Examples:
+  $ %s tx %s grant cosmos1skjw... send --spend-limit=1000stake --from=granter
+  $ %s tx %s grant cosmos1skjw... generic --msg-type=cosmos.staking.MsgDelegate --from=granter
+`,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var authorization types.Authorization
+			switch args[1] {
+			case authorizationTypeSend:
+				limit, err := cmd.Flags().GetString(FlagSpendLimit)
+				if err != nil {
+					return err
+				}
+
+				spendLimit, err := sdk.ParseCoins(limit)
+				if err != nil {
+					return err
+				}
+
+				authorization = types.NewSendAuthorization(spendLimit)
+
+			case authorizationTypeGeneric:
+				msgType, err := cmd.Flags().GetString(FlagMsgType)
+				if err != nil {
+					return err
+				}
+
+				authorization = types.NewGenericAuthorization(msgType)
+
+			default:
+				return fmt.Errorf("invalid authorization type %s, expected %s or %s", args[1], authorizationTypeSend, authorizationTypeGeneric)
+			}
+
+			expStr, err := cmd.Flags().GetString(FlagExpiration)
+			if err != nil {
+				return err
+			}
+
+			expiration := time.Now().AddDate(1, 0, 0)
+			if expStr != "" {
+				expiration, err = time.Parse(time.RFC3339, expStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg, err := types.NewMsgGrantAuthorization(clientCtx.GetFromAddress(), grantee, authorization, expiration)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagSpendLimit, "", "SpendLimit for a send authorization, an array of Coins allowed to be spent")
+	cmd.Flags().String(FlagMsgType, "", "Fully qualified protobuf name of the message type allowed by a generic authorization")
+	cmd.Flags().String(FlagExpiration, "", "The RFC 3339 timestamp after which the authorization expires (default one year from now)")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdRevokeAuthorization returns a CLI command handler for creating a
+// MsgRevokeAuthorization transaction.
+func NewCmdRevokeAuthorization() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke [grantee] [msg_type] --from [granter]",
+		Short: "Revoke authorization",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Revoke the authorization granted to an address for a message type.
+
+Example:
+  $ %s tx %s revoke cosmos1skjw... cosmos.bank.MsgSend --from=granter
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeAuthorization(clientCtx.GetFromAddress(), grantee, args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdExecAuthorization returns a CLI command handler for creating a
+// MsgExecAuthorized transaction.
+func NewCmdExecAuthorization() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exec [msg_tx_json_file] --from [grantee]",
+		Short: "Execute tx on behalf of granter account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Execute the messages of an unsigned transaction on behalf of their signer,
+using the authorizations granted to the grantee. The transaction can be
+generated with the --generate-only flag.
+
+Example:
+  $ %s tx bank send cosmos1granter... cosmos1recipient... 10stake --generate-only > tx.json
+  $ %s tx %s exec tx.json --from=grantee
+`,
+				version.AppName, version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			theTx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgExecAuthorized(clientCtx.GetFromAddress(), theTx.GetMsgs())
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
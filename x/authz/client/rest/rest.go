@@ -0,0 +1,17 @@
+package rest
+
+import (
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+// RegisterHandlers registers all x/authz transaction and query HTTP REST
+// handlers on the provided mux router.
+func RegisterHandlers(clientCtx client.Context, r *mux.Router) {
+	r.HandleFunc("/authz/grants/{granter}/{grantee}", queryAuthorizationsHandlerFn(clientCtx)).Methods("GET")
+	r.HandleFunc("/authz/grants/{granter}/{grantee}/{msgType}", queryAuthorizationHandlerFn(clientCtx)).Methods("GET")
+	r.HandleFunc("/authz/grants/{grantee}", NewGrantAuthorizationRequestHandlerFn(clientCtx)).Methods("POST")
+	r.HandleFunc("/authz/grants/{grantee}/revoke", NewRevokeAuthorizationRequestHandlerFn(clientCtx)).Methods("POST")
+	r.HandleFunc("/authz/exec", NewExecAuthorizedRequestHandlerFn(clientCtx)).Methods("POST")
+}
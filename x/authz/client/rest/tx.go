@@ -0,0 +1,145 @@
+package rest
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+// GrantAuthorizationReq defines the properties of a grant authorization
+// request's body. If MsgType is set, a GenericAuthorization for it is granted,
+// otherwise a SendAuthorization with the given SpendLimit.
+type GrantAuthorizationReq struct {
+	BaseReq    rest.BaseReq `json:"base_req" yaml:"base_req"`
+	SpendLimit sdk.Coins    `json:"spend_limit,omitempty" yaml:"spend_limit,omitempty"`
+	MsgType    string       `json:"msg_type,omitempty" yaml:"msg_type,omitempty"`
+	Expiration time.Time    `json:"expiration" yaml:"expiration"`
+}
+
+// RevokeAuthorizationReq defines the properties of a revoke authorization
+// request's body.
+type RevokeAuthorizationReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	MsgType string       `json:"msg_type" yaml:"msg_type"`
+}
+
+// ExecAuthorizedReq defines the properties of an exec authorized request's
+// body. The grantee is the sender of the request.
+type ExecAuthorizedReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Msgs    []sdk.Msg    `json:"msgs" yaml:"msgs"`
+}
+
+// NewGrantAuthorizationRequestHandlerFn returns an HTTP REST handler for
+// creating a MsgGrantAuthorization transaction.
+func NewGrantAuthorizationRequestHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		grantee, err := sdk.AccAddressFromBech32(mux.Vars(r)["grantee"])
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		var req GrantAuthorizationReq
+		if !rest.ReadRESTReq(w, r, clientCtx.JSONMarshaler, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		granter, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		var authorization types.Authorization = types.NewSendAuthorization(req.SpendLimit)
+		if req.MsgType != "" {
+			authorization = types.NewGenericAuthorization(req.MsgType)
+		}
+
+		msg, err := types.NewMsgGrantAuthorization(granter, grantee, authorization, req.Expiration)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+// NewRevokeAuthorizationRequestHandlerFn returns an HTTP REST handler for
+// creating a MsgRevokeAuthorization transaction.
+func NewRevokeAuthorizationRequestHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		grantee, err := sdk.AccAddressFromBech32(mux.Vars(r)["grantee"])
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		var req RevokeAuthorizationReq
+		if !rest.ReadRESTReq(w, r, clientCtx.JSONMarshaler, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		granter, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		msg := types.NewMsgRevokeAuthorization(granter, grantee, req.MsgType)
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+// NewExecAuthorizedRequestHandlerFn returns an HTTP REST handler for creating
+// a MsgExecAuthorized transaction.
+func NewExecAuthorizedRequestHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ExecAuthorizedReq
+		if !rest.ReadRESTReq(w, r, clientCtx.JSONMarshaler, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		grantee, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		msg, err := types.NewMsgExecAuthorized(grantee, req.Msgs)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
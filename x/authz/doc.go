@@ -0,0 +1,24 @@
+/*
+Package authz provides functionality for granting arbitrary privileges from
+one account (the granter) to another account (the grantee).
+
+An authorization is an implementation of the Authorization interface, which
+grants the grantee permission to execute a single message type on behalf of
+the granter, and may restrict or update what it allows with each use. Two
+implementations are provided in this package: SendAuthorization, which allows
+the grantee to send coins from the granter's account up to a spend limit, and
+GenericAuthorization, which allows the grantee to execute any message of the
+given type without restrictions.
+
+Message types are identified by their fully qualified protobuf name, eg.
+cosmos.staking.MsgDelegate (see types.MsgTypeURL).
+
+A granter authorizes a grantee with MsgGrantAuthorization, until an expiration
+time, and revokes the authorization with MsgRevokeAuthorization. The grantee
+executes messages on behalf of the granter by wrapping them in a
+MsgExecAuthorized. Each wrapped message must have a single signer, the
+granter. The authorization is checked and updated, and the message is then
+dispatched through the application's message router, so it is executed by
+its module's handler exactly as if the granter had sent it.
+*/
+package authz
@@ -0,0 +1,38 @@
+package authz
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+// InitGenesis initializes the authz module's state from a provided genesis
+// state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
+	if err := gs.Validate(); err != nil {
+		panic(fmt.Sprintf("failed to validate %s genesis state: %s", types.ModuleName, err))
+	}
+
+	for _, entry := range gs.Authorizations {
+		if err := k.Grant(ctx, entry.Granter, entry.Grantee, entry.GetAuthorization(), entry.Expiration); err != nil {
+			panic(fmt.Sprintf("failed to grant authorization from %s to %s: %s", entry.Granter, entry.Grantee, err))
+		}
+	}
+}
+
+// ExportGenesis returns the authz module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
+	entries := []types.GrantAuthorization{}
+
+	err := k.IterateGrants(ctx, func(granter, grantee sdk.AccAddress, grant types.AuthorizationGrant) bool {
+		entries = append(entries, types.NewGrantAuthorization(granter, grantee, grant))
+		return false
+	})
+	if err != nil {
+		panic(fmt.Sprintf("failed to export %s genesis state: %s", types.ModuleName, err))
+	}
+
+	return types.NewGenesisState(entries)
+}
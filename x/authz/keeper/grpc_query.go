@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Authorization implements the Query/Authorization gRPC method
+func (k Keeper) Authorization(c context.Context, req *types.QueryAuthorizationRequest) (*types.QueryAuthorizationResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Granter.Empty() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid granter address")
+	}
+
+	if req.Grantee.Empty() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid grantee address")
+	}
+
+	if req.MsgType == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "empty message type")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	grant, found, err := k.GetAuthorizationGrant(ctx, req.Granter, req.Grantee, req.MsgType)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no %s authorization from %s to %s", req.MsgType, req.Granter, req.Grantee)
+	}
+
+	return &types.QueryAuthorizationResponse{Authorization: &grant}, nil
+}
+
+// Authorizations implements the Query/Authorizations gRPC method
+func (k Keeper) Authorizations(c context.Context, req *types.QueryAuthorizationsRequest) (*types.QueryAuthorizationsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Granter.Empty() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid granter address")
+	}
+
+	if req.Grantee.Empty() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid grantee address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var grants []*types.AuthorizationGrant
+	store := ctx.KVStore(k.storeKey)
+	grantsStore := prefix.NewStore(store, types.GetGranterGranteePrefix(req.Granter, req.Grantee))
+
+	res, err := query.Paginate(grantsStore, req.Req, func(key []byte, value []byte) error {
+		grant, err := k.unmarshalAuthorizationGrant(value)
+		if err != nil {
+			return err
+		}
+
+		grants = append(grants, &grant)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAuthorizationsResponse{Authorizations: grants, Res: res}, nil
+}
@@ -0,0 +1,58 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (suite *KeeperTestSuite) TestGRPCQueryAuthorization() {
+	ctx, k, queryClient := suite.ctx, suite.app.AuthzKeeper, suite.queryClient
+	granter, grantee := suite.addrs[0], suite.addrs[1]
+	sendMsgType := types.MsgTypeURL(&banktypes.MsgSend{})
+
+	_, err := queryClient.Authorization(gocontext.Background(), &types.QueryAuthorizationRequest{})
+	suite.Require().Error(err)
+
+	_, err = queryClient.Authorization(gocontext.Background(), types.NewQueryAuthorizationRequest(granter, grantee, sendMsgType))
+	suite.Require().Error(err)
+
+	sendAuth := types.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("steak", 100)))
+	suite.Require().NoError(k.Grant(ctx, granter, grantee, sendAuth, ctx.BlockTime().Add(time.Hour)))
+
+	res, err := queryClient.Authorization(gocontext.Background(), types.NewQueryAuthorizationRequest(granter, grantee, sendMsgType))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sendAuth, res.Authorization.GetAuthorizationGrant())
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryAuthorizations() {
+	ctx, k, queryClient := suite.ctx, suite.app.AuthzKeeper, suite.queryClient
+	granter, grantee := suite.addrs[0], suite.addrs[1]
+	exp := ctx.BlockTime().Add(time.Hour)
+
+	_, err := queryClient.Authorizations(gocontext.Background(), &types.QueryAuthorizationsRequest{})
+	suite.Require().Error(err)
+
+	res, err := queryClient.Authorizations(gocontext.Background(), types.NewQueryAuthorizationsRequest(granter, grantee, nil))
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Authorizations)
+
+	sendAuth := types.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("steak", 100)))
+	genericAuth := types.NewGenericAuthorization("cosmos.staking.MsgDelegate")
+	suite.Require().NoError(k.Grant(ctx, granter, grantee, sendAuth, exp))
+	suite.Require().NoError(k.Grant(ctx, granter, grantee, genericAuth, exp))
+	suite.Require().NoError(k.Grant(ctx, granter, suite.addrs[2], genericAuth, exp))
+
+	res, err = queryClient.Authorizations(gocontext.Background(), types.NewQueryAuthorizationsRequest(granter, grantee, nil))
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Authorizations, 2)
+
+	res, err = queryClient.Authorizations(gocontext.Background(), types.NewQueryAuthorizationsRequest(granter, grantee, &query.PageRequest{Limit: 1, CountTotal: true}))
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Authorizations, 1)
+	suite.Require().Equal(uint64(2), res.Res.Total)
+}
@@ -0,0 +1,265 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+// Keeper manages the authorization grants and dispatches the messages that
+// grantees execute on behalf of granters.
+type Keeper struct {
+	cdc      codec.Marshaler
+	storeKey sdk.StoreKey
+	router   sdk.Router
+}
+
+// NewKeeper creates an authz Keeper. Messages executed through
+// DispatchActions are routed to their handlers with the given router, which
+// is expected to be the application's (BaseApp) message router.
+func NewKeeper(cdc codec.Marshaler, storeKey sdk.StoreKey, router sdk.Router) Keeper {
+	return Keeper{
+		cdc:      cdc,
+		storeKey: storeKey,
+		router:   router,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// Grant creates a new authorization grant from the granter to the grantee,
+// replacing any existing grant for the same message type.
+func (k Keeper) Grant(
+	ctx sdk.Context, granter, grantee sdk.AccAddress, authorization types.Authorization, expiration time.Time,
+) error {
+	grant, err := types.NewAuthorizationGrant(authorization, expiration)
+	if err != nil {
+		return err
+	}
+
+	if err := k.setAuthorizationGrant(ctx, granter, grantee, authorization.MsgType(), grant); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeGrantAuthorization,
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+			sdk.NewAttribute(types.AttributeKeyMsgType, authorization.MsgType()),
+		),
+	)
+
+	return nil
+}
+
+// Revoke removes the authorization grant for the given message type from the
+// granter to the grantee. It returns ErrNoAuthorizationFound if there is none.
+func (k Keeper) Revoke(ctx sdk.Context, granter, grantee sdk.AccAddress, msgType string) error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetAuthorizationStoreKey(granter, grantee, msgType)
+
+	if !store.Has(key) {
+		return sdkerrors.Wrapf(types.ErrNoAuthorizationFound, "%s from %s to %s", msgType, granter, grantee)
+	}
+
+	store.Delete(key)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRevokeAuthorization,
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+			sdk.NewAttribute(types.AttributeKeyMsgType, msgType),
+		),
+	)
+
+	return nil
+}
+
+// GetAuthorizationGrant returns the authorization grant for the given message
+// type from the granter to the grantee, and whether it was found.
+func (k Keeper) GetAuthorizationGrant(
+	ctx sdk.Context, granter, grantee sdk.AccAddress, msgType string,
+) (types.AuthorizationGrant, bool, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetAuthorizationStoreKey(granter, grantee, msgType))
+	if len(bz) == 0 {
+		return types.AuthorizationGrant{}, false, nil
+	}
+
+	grant, err := k.unmarshalAuthorizationGrant(bz)
+	if err != nil {
+		return types.AuthorizationGrant{}, false, err
+	}
+
+	return grant, true, nil
+}
+
+// GetAuthorization returns the authorization for the given message type from
+// the granter to the grantee together with its expiration time. It returns a
+// nil Authorization if there is none, or if it has expired.
+func (k Keeper) GetAuthorization(
+	ctx sdk.Context, granter, grantee sdk.AccAddress, msgType string,
+) (types.Authorization, time.Time) {
+	grant, found, err := k.GetAuthorizationGrant(ctx, granter, grantee, msgType)
+	if err != nil || !found {
+		return nil, time.Time{}
+	}
+
+	if !grant.Expiration.After(ctx.BlockTime()) {
+		return nil, time.Time{}
+	}
+
+	return grant.GetAuthorizationGrant(), grant.Expiration
+}
+
+// DispatchActions attempts to execute the provided messages on behalf of
+// their signers. Messages signed by the grantee itself are executed directly;
+// any other message must have a single signer which has granted the grantee
+// an authorization that accepts it. Each message is then routed to its
+// module's handler through the router, in order, and the results are combined
+// as baseapp does for the messages of a transaction.
+func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, msgs []sdk.Msg) (*sdk.Result, error) {
+	txData := &sdk.TxData{
+		Data: make([]*sdk.MsgData, 0, len(msgs)),
+	}
+
+	for i, msg := range msgs {
+		signers := msg.GetSigners()
+		if len(signers) != 1 {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "authorization can be given to msg with only one signer; message index: %d", i)
+		}
+
+		granter := signers[0]
+
+		// if the granter is the grantee, no authorization is needed
+		if !granter.Equals(grantee) {
+			if err := k.useAuthorization(ctx, granter, grantee, msg); err != nil {
+				return nil, sdkerrors.Wrapf(err, "message index: %d", i)
+			}
+		}
+
+		handler := k.router.Route(ctx, msg.Route())
+		if handler == nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s; message index: %d", msg.Route(), i)
+		}
+
+		msgResult, err := handler(ctx, msg)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to execute message; message index: %d", i)
+		}
+
+		ctx.EventManager().EmitEvents(msgResult.GetEvents())
+		txData.Data = append(txData.Data, &sdk.MsgData{MsgType: msg.Type(), Data: msgResult.Data})
+	}
+
+	data, err := proto.Marshal(txData)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to marshal tx data")
+	}
+
+	return &sdk.Result{Data: data}, nil
+}
+
+// IterateGrants iterates over all the authorization grants in the store.
+// Callback to get all data, returns true to stop, false to keep reading.
+func (k Keeper) IterateGrants(
+	ctx sdk.Context, cb func(granter, grantee sdk.AccAddress, grant types.AuthorizationGrant) bool,
+) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GrantKeyPrefix)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		granter, grantee := types.ParseGranterGranteeKey(iter.Key())
+
+		grant, err := k.unmarshalAuthorizationGrant(iter.Value())
+		if err != nil {
+			return err
+		}
+
+		if cb(granter, grantee, grant) {
+			break
+		}
+	}
+
+	return nil
+}
+
+// useAuthorization checks that the granter has authorized the grantee to
+// execute msg and updates the authorization accordingly, deleting it if it
+// has expired or is used up.
+func (k Keeper) useAuthorization(ctx sdk.Context, granter, grantee sdk.AccAddress, msg sdk.Msg) error {
+	msgType := types.MsgTypeURL(msg)
+
+	grant, found, err := k.GetAuthorizationGrant(ctx, granter, grantee, msgType)
+	if err != nil {
+		return err
+	}
+
+	authorization := grant.GetAuthorizationGrant()
+	if !found || authorization == nil {
+		return sdkerrors.Wrapf(types.ErrNoAuthorizationFound, "%s from %s to %s", msgType, granter, grantee)
+	}
+
+	if !grant.Expiration.After(ctx.BlockTime()) {
+		// Ignoring the error since we know the grant exists
+		_ = k.Revoke(ctx, granter, grantee, msgType)
+		return sdkerrors.Wrapf(types.ErrAuthorizationExpired, "%s from %s to %s", msgType, granter, grantee)
+	}
+
+	updated, del, err := authorization.Accept(ctx, msg)
+	if del {
+		// Ignoring the error since we know the grant exists
+		_ = k.Revoke(ctx, granter, grantee, msgType)
+	}
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrUnauthorized, err.Error())
+	}
+	if del || updated == nil {
+		return nil
+	}
+
+	grant, err = types.NewAuthorizationGrant(updated, grant.Expiration)
+	if err != nil {
+		return err
+	}
+
+	return k.setAuthorizationGrant(ctx, granter, grantee, msgType, grant)
+}
+
+func (k Keeper) setAuthorizationGrant(
+	ctx sdk.Context, granter, grantee sdk.AccAddress, msgType string, grant types.AuthorizationGrant,
+) error {
+	bz, err := k.cdc.MarshalBinaryBare(&grant)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetAuthorizationStoreKey(granter, grantee, msgType), bz)
+
+	return nil
+}
+
+func (k Keeper) unmarshalAuthorizationGrant(bz []byte) (types.AuthorizationGrant, error) {
+	var grant types.AuthorizationGrant
+	if err := k.cdc.UnmarshalBinaryBare(bz, &grant); err != nil {
+		return types.AuthorizationGrant{}, err
+	}
+
+	return grant, nil
+}
@@ -0,0 +1,182 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app     *simapp.SimApp
+	ctx     sdk.Context
+	querier sdk.Querier
+	addrs   []sdk.AccAddress
+
+	queryClient types.QueryClient
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Time: time.Now().UTC()})
+
+	suite.app = app
+	suite.ctx = ctx
+	suite.querier = keeper.NewQuerier(app.AuthzKeeper)
+	suite.addrs = simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(30000000))
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.AuthzKeeper)
+	suite.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) TestKeeper() {
+	ctx, k := suite.ctx, suite.app.AuthzKeeper
+	granter, grantee := suite.addrs[0], suite.addrs[1]
+	sendMsgType := types.MsgTypeURL(&banktypes.MsgSend{})
+
+	// no authorization yet
+	authorization, _ := k.GetAuthorization(ctx, granter, grantee, sendMsgType)
+	suite.Require().Nil(authorization)
+
+	// grant an authorization
+	now := ctx.BlockTime()
+	newCoins := sdk.NewCoins(sdk.NewInt64Coin("steak", 100))
+	sendAuth := types.NewSendAuthorization(newCoins)
+	suite.Require().NoError(k.Grant(ctx, granter, grantee, sendAuth, now.Add(time.Hour)))
+
+	authorization, expiration := k.GetAuthorization(ctx, granter, grantee, sendMsgType)
+	suite.Require().NotNil(authorization)
+	suite.Require().Equal(sendMsgType, authorization.MsgType())
+	suite.Require().Equal(now.Add(time.Hour), expiration)
+
+	// authorizations are directional
+	authorization, _ = k.GetAuthorization(ctx, grantee, granter, sendMsgType)
+	suite.Require().Nil(authorization)
+
+	// expired authorizations are not returned
+	authorization, _ = k.GetAuthorization(ctx.WithBlockTime(now.Add(2*time.Hour)), granter, grantee, sendMsgType)
+	suite.Require().Nil(authorization)
+
+	// revoke the authorization
+	suite.Require().NoError(k.Revoke(ctx, granter, grantee, sendMsgType))
+	authorization, _ = k.GetAuthorization(ctx, granter, grantee, sendMsgType)
+	suite.Require().Nil(authorization)
+
+	// revoking again fails
+	suite.Require().Error(k.Revoke(ctx, granter, grantee, sendMsgType))
+}
+
+func (suite *KeeperTestSuite) TestKeeperIterateGrants() {
+	ctx, k := suite.ctx, suite.app.AuthzKeeper
+	exp := ctx.BlockTime().Add(time.Hour)
+
+	sendAuth := types.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("steak", 100)))
+	genericAuth := types.NewGenericAuthorization("cosmos.staking.MsgDelegate")
+
+	suite.Require().NoError(k.Grant(ctx, suite.addrs[0], suite.addrs[1], sendAuth, exp))
+	suite.Require().NoError(k.Grant(ctx, suite.addrs[0], suite.addrs[1], genericAuth, exp))
+	suite.Require().NoError(k.Grant(ctx, suite.addrs[2], suite.addrs[1], genericAuth, exp))
+
+	count := 0
+	err := k.IterateGrants(ctx, func(granter, grantee sdk.AccAddress, grant types.AuthorizationGrant) bool {
+		suite.Require().Equal(suite.addrs[1], grantee)
+		suite.Require().Contains([]sdk.AccAddress{suite.addrs[0], suite.addrs[2]}, granter)
+		suite.Require().Equal(exp, grant.Expiration)
+		count++
+		return false
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(3, count)
+}
+
+func (suite *KeeperTestSuite) TestKeeperDispatchActions() {
+	ctx, k := suite.ctx, suite.app.AuthzKeeper
+	granter, grantee, recipient := suite.addrs[0], suite.addrs[1], suite.addrs[2]
+	sendMsgType := types.MsgTypeURL(&banktypes.MsgSend{})
+
+	granterBalance := suite.app.BankKeeper.GetBalance(ctx, granter, sdk.DefaultBondDenom)
+	recipientBalance := suite.app.BankKeeper.GetBalance(ctx, recipient, sdk.DefaultBondDenom)
+
+	sendAmt := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 20))
+	msgs := []sdk.Msg{banktypes.NewMsgSend(granter, recipient, sendAmt)}
+
+	// executing without an authorization fails
+	_, err := k.DispatchActions(ctx, grantee, msgs)
+	suite.Require().Error(err)
+
+	// grant a send authorization with a limit of 50
+	limit := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50))
+	suite.Require().NoError(k.Grant(ctx, granter, grantee, types.NewSendAuthorization(limit), ctx.BlockTime().Add(time.Hour)))
+
+	// the send is executed through the bank handler, and deducted from the limit
+	res, err := k.DispatchActions(ctx, grantee, msgs)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res)
+
+	suite.Require().Equal(granterBalance.Sub(sdk.NewInt64Coin(sdk.DefaultBondDenom, 20)), suite.app.BankKeeper.GetBalance(ctx, granter, sdk.DefaultBondDenom))
+	suite.Require().Equal(recipientBalance.Add(sdk.NewInt64Coin(sdk.DefaultBondDenom, 20)), suite.app.BankKeeper.GetBalance(ctx, recipient, sdk.DefaultBondDenom))
+
+	authorization, _ := k.GetAuthorization(ctx, granter, grantee, sendMsgType)
+	suite.Require().NotNil(authorization)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 30)), authorization.(*types.SendAuthorization).SpendLimit)
+
+	// sending more than the remaining limit fails and leaves state untouched
+	bigMsgs := []sdk.Msg{banktypes.NewMsgSend(granter, recipient, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 40)))}
+	_, err = k.DispatchActions(ctx, grantee, bigMsgs)
+	suite.Require().Error(err)
+	suite.Require().Equal(recipientBalance.Add(sdk.NewInt64Coin(sdk.DefaultBondDenom, 20)), suite.app.BankKeeper.GetBalance(ctx, recipient, sdk.DefaultBondDenom))
+
+	// using up the limit removes the authorization
+	lastMsgs := []sdk.Msg{banktypes.NewMsgSend(granter, recipient, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 30)))}
+	_, err = k.DispatchActions(ctx, grantee, lastMsgs)
+	suite.Require().NoError(err)
+
+	authorization, _ = k.GetAuthorization(ctx, granter, grantee, sendMsgType)
+	suite.Require().Nil(authorization)
+
+	// the grantee can always execute its own messages
+	ownMsgs := []sdk.Msg{banktypes.NewMsgSend(grantee, recipient, sendAmt)}
+	_, err = k.DispatchActions(ctx, grantee, ownMsgs)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestKeeperDispatchActionsExpired() {
+	ctx, k := suite.ctx, suite.app.AuthzKeeper
+	granter, grantee, recipient := suite.addrs[0], suite.addrs[1], suite.addrs[2]
+	sendMsgType := types.MsgTypeURL(&banktypes.MsgSend{})
+
+	genericAuth := types.NewGenericAuthorization(sendMsgType)
+	suite.Require().NoError(k.Grant(ctx, granter, grantee, genericAuth, ctx.BlockTime().Add(time.Hour)))
+
+	msgs := []sdk.Msg{banktypes.NewMsgSend(granter, recipient, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 20)))}
+
+	// a generic authorization is not used up
+	_, err := k.DispatchActions(ctx, grantee, msgs)
+	suite.Require().NoError(err)
+	_, err = k.DispatchActions(ctx, grantee, msgs)
+	suite.Require().NoError(err)
+
+	// but it expires
+	expiredCtx := ctx.WithBlockTime(ctx.BlockTime().Add(2 * time.Hour))
+	_, err = k.DispatchActions(expiredCtx, grantee, msgs)
+	suite.Require().Error(err)
+
+	_, found, err := k.GetAuthorizationGrant(ctx, granter, grantee, sendMsgType)
+	suite.Require().NoError(err)
+	suite.Require().False(found)
+}
@@ -0,0 +1,57 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmkv "github.com/tendermint/tendermint/libs/kv"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/simulation"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+var (
+	granterAddr = sdk.AccAddress("granter_____________")
+	granteeAddr = sdk.AccAddress("grantee_____________")
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc, _ := simapp.MakeCodecs()
+	dec := simulation.NewDecodeStore(cdc)
+
+	authorization := types.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("atom", 100)))
+	grant, err := types.NewAuthorizationGrant(authorization, time.Now().UTC())
+	require.NoError(t, err)
+
+	grantBz, err := cdc.MarshalBinaryBare(&grant)
+	require.NoError(t, err)
+
+	kvPairs := tmkv.Pairs{
+		tmkv.Pair{Key: types.GetAuthorizationStoreKey(granterAddr, granteeAddr, authorization.MsgType()), Value: grantBz},
+		tmkv.Pair{Key: []byte{0x99}, Value: []byte{0x99}},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"AuthorizationGrant", fmt.Sprintf("%v\n%v", grant, grant)},
+		{"other", ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(kvPairs[i], kvPairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, dec(kvPairs[i], kvPairs[i]), tt.name)
+			}
+		})
+	}
+}
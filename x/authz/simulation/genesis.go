@@ -0,0 +1,36 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+// Simulation parameter constants
+const authorizations = "authorizations"
+
+// GenAuthorizations returns an empty slice of authorization grants.
+func GenAuthorizations(_ *rand.Rand, _ []simtypes.Account) []types.GrantAuthorization {
+	return []types.GrantAuthorization{}
+}
+
+// RandomizedGenState generates a random GenesisState for authz
+func RandomizedGenState(simState *module.SimulationState) {
+	var grants []types.GrantAuthorization
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, authorizations, &grants, simState.Rand,
+		func(r *rand.Rand) { grants = GenAuthorizations(r, simState.Accounts) },
+	)
+
+	authzGenesis := types.NewGenesisState(grants)
+
+	fmt.Printf("Selected randomly generated %s parameters:\n%s\n", types.ModuleName, codec.MustMarshalJSONIndent(simState.Cdc, authzGenesis))
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(authzGenesis)
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Authorization represents the interface of various Authorization types, each
+// of which grants a grantee permission to execute a single message type on
+// behalf of a granter.
+type Authorization interface {
+	proto.Message
+
+	// MsgType returns the fully qualified protobuf name of the message this
+	// authorization applies to.
+	MsgType() string
+
+	// Accept determines whether this grant permits the provided sdk.Msg to be
+	// performed, and if so provides an updated authorization instance to be
+	// saved in place of the current one. If delete is true, the grant is
+	// removed from storage (eg. when it is used up), regardless of the error.
+	Accept(ctx sdk.Context, msg sdk.Msg) (updated Authorization, delete bool, err error)
+
+	// ValidateBasic does a simple validation check that doesn't require
+	// access to any other information.
+	ValidateBasic() error
+}
+
+// MsgTypeURL returns the message type identifier authorizations use for the
+// provided message, which is its fully qualified protobuf name. It returns an
+// empty string if the message is not a protobuf message.
+func MsgTypeURL(msg sdk.Msg) string {
+	pm, ok := msg.(proto.Message)
+	if !ok {
+		return ""
+	}
+
+	return proto.MessageName(pm)
+}
@@ -0,0 +1,75 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var (
+	granter = sdk.AccAddress("granter_____________")
+	grantee = sdk.AccAddress("grantee_____________")
+)
+
+func TestSendAuthorization(t *testing.T) {
+	ctx := sdk.Context{}.WithBlockHeader(abci.Header{Time: time.Now()})
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+
+	authorization := types.NewSendAuthorization(atom)
+	require.NoError(t, authorization.ValidateBasic())
+	require.Equal(t, "cosmos.bank.MsgSend", authorization.MsgType())
+
+	// spending part of the limit updates it
+	updated, del, err := authorization.Accept(ctx, banktypes.NewMsgSend(granter, grantee, sdk.NewCoins(sdk.NewInt64Coin("atom", 40))))
+	require.NoError(t, err)
+	require.False(t, del)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 60)), updated.(*types.SendAuthorization).SpendLimit)
+
+	// spending more than the limit fails
+	_, _, err = authorization.Accept(ctx, banktypes.NewMsgSend(granter, grantee, sdk.NewCoins(sdk.NewInt64Coin("atom", 101))))
+	require.Error(t, err)
+
+	// spending other coins fails
+	_, _, err = authorization.Accept(ctx, banktypes.NewMsgSend(granter, grantee, sdk.NewCoins(sdk.NewInt64Coin("eth", 1))))
+	require.Error(t, err)
+
+	// spending the whole limit deletes it
+	_, del, err = authorization.Accept(ctx, banktypes.NewMsgSend(granter, grantee, atom))
+	require.NoError(t, err)
+	require.True(t, del)
+
+	// other messages are not accepted
+	delegate := stakingtypes.NewMsgDelegate(granter, sdk.ValAddress(grantee), sdk.NewInt64Coin("atom", 1))
+	_, _, err = authorization.Accept(ctx, delegate)
+	require.Error(t, err)
+
+	// invalid spend limits
+	require.Error(t, types.NewSendAuthorization(nil).ValidateBasic())
+	require.Error(t, types.NewSendAuthorization(sdk.Coins{sdk.NewInt64Coin("atom", 0)}).ValidateBasic())
+}
+
+func TestGenericAuthorization(t *testing.T) {
+	ctx := sdk.Context{}.WithBlockHeader(abci.Header{Time: time.Now()})
+	delegate := stakingtypes.NewMsgDelegate(granter, sdk.ValAddress(grantee), sdk.NewInt64Coin("atom", 1))
+
+	authorization := types.NewGenericAuthorization(types.MsgTypeURL(delegate))
+	require.NoError(t, authorization.ValidateBasic())
+	require.Equal(t, "cosmos.staking.MsgDelegate", authorization.MsgType())
+
+	updated, del, err := authorization.Accept(ctx, delegate)
+	require.NoError(t, err)
+	require.False(t, del)
+	require.Equal(t, authorization, updated)
+
+	_, _, err = authorization.Accept(ctx, banktypes.NewMsgSend(granter, grantee, sdk.NewCoins(sdk.NewInt64Coin("atom", 1))))
+	require.Error(t, err)
+
+	require.Error(t, types.NewGenericAuthorization("").ValidateBasic())
+}
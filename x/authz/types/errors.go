@@ -0,0 +1,13 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/authz module sentinel errors
+var (
+	ErrInvalidExpirationTime = sdkerrors.Register(ModuleName, 2, "expiration time of authorization should be more than current time")
+	ErrNoAuthorizationFound  = sdkerrors.Register(ModuleName, 3, "no authorization found")
+	ErrAuthorizationExpired  = sdkerrors.Register(ModuleName, 4, "authorization expired")
+	ErrUnauthorized          = sdkerrors.Register(ModuleName, 5, "authorization does not allow message")
+)
@@ -0,0 +1,14 @@
+package types
+
+// authz module event types and attribute keys
+const (
+	EventTypeGrantAuthorization  = "grant_authorization"
+	EventTypeRevokeAuthorization = "revoke_authorization"
+	EventTypeExecAuthorized      = "exec_authorized"
+
+	AttributeKeyGranter = "granter"
+	AttributeKeyGrantee = "grantee"
+	AttributeKeyMsgType = "msg_type"
+
+	AttributeValueCategory = ModuleName
+)
@@ -0,0 +1,40 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ Authorization = &GenericAuthorization{}
+
+// NewGenericAuthorization creates a new GenericAuthorization object for the
+// given message type, as returned by MsgTypeURL.
+func NewGenericAuthorization(msgType string) *GenericAuthorization {
+	return &GenericAuthorization{
+		MessageType: msgType,
+	}
+}
+
+// MsgType implements Authorization.MsgType.
+func (authorization GenericAuthorization) MsgType() string {
+	return authorization.MessageType
+}
+
+// Accept implements Authorization.Accept. It accepts any message of the
+// authorized type and never expires on use.
+func (authorization GenericAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (Authorization, bool, error) {
+	if msgType := MsgTypeURL(msg); msgType != authorization.MessageType {
+		return nil, false, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "type mismatch, expected %s, got %s", authorization.MessageType, msgType)
+	}
+
+	return &authorization, false, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (authorization GenericAuthorization) ValidateBasic() error {
+	if authorization.MessageType == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "message type cannot be empty")
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package types
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ types.UnpackInterfacesMessage = GenesisState{}
+	_ types.UnpackInterfacesMessage = GrantAuthorization{}
+)
+
+// GrantAuthorization defines a single authorization grant, with the granter
+// and grantee it is stored under, for genesis import and export.
+type GrantAuthorization struct {
+	Granter       sdk.AccAddress `json:"granter" yaml:"granter"`
+	Grantee       sdk.AccAddress `json:"grantee" yaml:"grantee"`
+	Authorization *types.Any     `json:"authorization" yaml:"authorization"`
+	Expiration    time.Time      `json:"expiration" yaml:"expiration"`
+}
+
+// NewGrantAuthorization creates a new GrantAuthorization instance.
+func NewGrantAuthorization(granter, grantee sdk.AccAddress, grant AuthorizationGrant) GrantAuthorization {
+	return GrantAuthorization{
+		Granter:       granter,
+		Grantee:       grantee,
+		Authorization: grant.Authorization,
+		Expiration:    grant.Expiration,
+	}
+}
+
+// GetAuthorization unpacks the cached authorization, returning nil if it is
+// missing or of an unexpected type.
+func (g GrantAuthorization) GetAuthorization() Authorization {
+	return unpackCachedAuthorization(g.Authorization)
+}
+
+// ValidateBasic performs basic validation on a GrantAuthorization.
+func (g GrantAuthorization) ValidateBasic() error {
+	if g.Granter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing granter address")
+	}
+	if g.Grantee.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing grantee address")
+	}
+
+	authorization := g.GetAuthorization()
+	if authorization == nil {
+		return sdkerrors.Wrap(ErrNoAuthorizationFound, "missing authorization")
+	}
+
+	return authorization.ValidateBasic()
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (g GrantAuthorization) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var authorization Authorization
+	return unpacker.UnpackAny(g.Authorization, &authorization)
+}
+
+// GenesisState contains the authorization grants, persisted from the store
+type GenesisState struct {
+	Authorizations []GrantAuthorization `json:"authorizations" yaml:"authorizations"`
+}
+
+// NewGenesisState creates a new GenesisState instance.
+func NewGenesisState(entries []GrantAuthorization) GenesisState {
+	return GenesisState{
+		Authorizations: entries,
+	}
+}
+
+// DefaultGenesisState returns the authz module's default genesis state.
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		Authorizations: []GrantAuthorization{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	for _, a := range gs.Authorizations {
+		if err := a.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (gs GenesisState) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, a := range gs.Authorizations {
+		if err := a.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
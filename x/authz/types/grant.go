@@ -0,0 +1,60 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+var _ types.UnpackInterfacesMessage = AuthorizationGrant{}
+
+// NewAuthorizationGrant creates a new AuthorizationGrant for the given
+// authorization, expiring at the given time.
+func NewAuthorizationGrant(authorization Authorization, expiration time.Time) (AuthorizationGrant, error) {
+	any, err := packAuthorization(authorization)
+	if err != nil {
+		return AuthorizationGrant{}, err
+	}
+
+	return AuthorizationGrant{
+		Authorization: any,
+		Expiration:    expiration,
+	}, nil
+}
+
+// GetAuthorizationGrant unpacks the cached authorization, returning nil if it
+// is missing or of an unexpected type.
+func (g AuthorizationGrant) GetAuthorizationGrant() Authorization {
+	return unpackCachedAuthorization(g.Authorization)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (g AuthorizationGrant) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var authorization Authorization
+	return unpacker.UnpackAny(g.Authorization, &authorization)
+}
+
+func packAuthorization(authorization Authorization) (*types.Any, error) {
+	msg, ok := authorization.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("cannot proto marshal %T", authorization)
+	}
+
+	return types.NewAnyWithValue(msg)
+}
+
+func unpackCachedAuthorization(any *types.Any) Authorization {
+	if any == nil {
+		return nil
+	}
+
+	authorization, ok := any.GetCachedValue().(Authorization)
+	if !ok {
+		return nil
+	}
+
+	return authorization
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "authz"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+// KVStore key prefixes
+var (
+	// GrantKeyPrefix is the prefix of the kvstore for authorization grants
+	GrantKeyPrefix = []byte{0x01}
+)
+
+// GetAuthorizationStoreKey returns the store key of an authorization grant
+// from granter to grantee for the given message type. Grants are keyed by
+// granter first so that all grants of a granter to a grantee can be iterated.
+func GetAuthorizationStoreKey(granter, grantee sdk.AccAddress, msgType string) []byte {
+	return append(GetGranterGranteePrefix(granter, grantee), []byte(msgType)...)
+}
+
+// GetGranterGranteePrefix returns the prefix to scan for all the grants from
+// granter to grantee.
+func GetGranterGranteePrefix(granter, grantee sdk.AccAddress) []byte {
+	return append(append(GrantKeyPrefix, granter.Bytes()...), grantee.Bytes()...)
+}
+
+// ParseGranterGranteeKey returns the granter and grantee addresses from an
+// authorization store key, without the GrantKeyPrefix.
+func ParseGranterGranteeKey(key []byte) (granter, grantee sdk.AccAddress) {
+	return sdk.AccAddress(key[:sdk.AddrLen]), sdk.AccAddress(key[sdk.AddrLen : 2*sdk.AddrLen])
+}
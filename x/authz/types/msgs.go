@@ -0,0 +1,259 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Message types for the authz module
+const (
+	TypeMsgGrantAuthorization  = "grant_authorization"
+	TypeMsgRevokeAuthorization = "revoke_authorization"
+	TypeMsgExecAuthorized      = "exec_authorized"
+)
+
+var (
+	_ sdk.Msg                       = &MsgGrantAuthorization{}
+	_ sdk.Msg                       = &MsgRevokeAuthorization{}
+	_ sdk.Msg                       = &MsgExecAuthorized{}
+	_ types.UnpackInterfacesMessage = MsgGrantAuthorization{}
+	_ types.UnpackInterfacesMessage = MsgExecAuthorized{}
+)
+
+// NewMsgGrantAuthorization creates a new MsgGrantAuthorization.
+func NewMsgGrantAuthorization(
+	granter, grantee sdk.AccAddress, authorization Authorization, expiration time.Time,
+) (*MsgGrantAuthorization, error) {
+	any, err := packAuthorization(authorization)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgGrantAuthorization{
+		Granter:       granter,
+		Grantee:       grantee,
+		Authorization: any,
+		Expiration:    expiration,
+	}, nil
+}
+
+// Route returns the MsgGrantAuthorization's route.
+func (msg MsgGrantAuthorization) Route() string { return RouterKey }
+
+// Type returns the MsgGrantAuthorization's type.
+func (msg MsgGrantAuthorization) Type() string { return TypeMsgGrantAuthorization }
+
+// ValidateBasic performs basic (non-state-dependant) validation on a MsgGrantAuthorization.
+func (msg MsgGrantAuthorization) ValidateBasic() error {
+	if msg.Granter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing granter address")
+	}
+	if msg.Grantee.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing grantee address")
+	}
+	if msg.Grantee.Equals(msg.Granter) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "granter and grantee cannot be the same")
+	}
+	if msg.Expiration.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidExpirationTime, "missing expiration time")
+	}
+
+	authorization := msg.GetGrantAuthorization()
+	if authorization == nil {
+		return sdkerrors.Wrap(ErrNoAuthorizationFound, "missing authorization")
+	}
+
+	return authorization.ValidateBasic()
+}
+
+// GetSignBytes returns the raw bytes a signer is expected to sign when submitting
+// a MsgGrantAuthorization message.
+func (msg MsgGrantAuthorization) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners returns the single expected signer for a MsgGrantAuthorization.
+func (msg MsgGrantAuthorization) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+// GetGrantAuthorization returns the unpacked authorization, or nil if it is missing.
+func (msg MsgGrantAuthorization) GetGrantAuthorization() Authorization {
+	return unpackCachedAuthorization(msg.Authorization)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgGrantAuthorization) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var authorization Authorization
+	return unpacker.UnpackAny(msg.Authorization, &authorization)
+}
+
+// NewMsgRevokeAuthorization creates a new MsgRevokeAuthorization.
+func NewMsgRevokeAuthorization(granter, grantee sdk.AccAddress, msgType string) *MsgRevokeAuthorization {
+	return &MsgRevokeAuthorization{
+		Granter: granter,
+		Grantee: grantee,
+		MsgType: msgType,
+	}
+}
+
+// Route returns the MsgRevokeAuthorization's route.
+func (msg MsgRevokeAuthorization) Route() string { return RouterKey }
+
+// Type returns the MsgRevokeAuthorization's type.
+func (msg MsgRevokeAuthorization) Type() string { return TypeMsgRevokeAuthorization }
+
+// ValidateBasic performs basic (non-state-dependant) validation on a MsgRevokeAuthorization.
+func (msg MsgRevokeAuthorization) ValidateBasic() error {
+	if msg.Granter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing granter address")
+	}
+	if msg.Grantee.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing grantee address")
+	}
+	if msg.Grantee.Equals(msg.Granter) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "granter and grantee cannot be the same")
+	}
+	if msg.MsgType == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "missing message type")
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the raw bytes a signer is expected to sign when submitting
+// a MsgRevokeAuthorization message.
+func (msg MsgRevokeAuthorization) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners returns the single expected signer for a MsgRevokeAuthorization.
+func (msg MsgRevokeAuthorization) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+// NewMsgExecAuthorized creates a new MsgExecAuthorized.
+func NewMsgExecAuthorized(grantee sdk.AccAddress, msgs []sdk.Msg) (*MsgExecAuthorized, error) {
+	anys := make([]*types.Any, len(msgs))
+	for i, msg := range msgs {
+		pm, ok := msg.(proto.Message)
+		if !ok {
+			return nil, fmt.Errorf("cannot proto marshal %T", msg)
+		}
+
+		any, err := types.NewAnyWithValue(pm)
+		if err != nil {
+			return nil, err
+		}
+
+		anys[i] = any
+	}
+
+	return &MsgExecAuthorized{
+		Grantee: grantee,
+		Msgs:    anys,
+	}, nil
+}
+
+// Route returns the MsgExecAuthorized's route.
+func (msg MsgExecAuthorized) Route() string { return RouterKey }
+
+// Type returns the MsgExecAuthorized's type.
+func (msg MsgExecAuthorized) Type() string { return TypeMsgExecAuthorized }
+
+// ValidateBasic performs basic (non-state-dependant) validation on a
+// MsgExecAuthorized, including the validation of every message it wraps.
+func (msg MsgExecAuthorized) ValidateBasic() error {
+	if msg.Grantee.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing grantee address")
+	}
+
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return err
+	}
+	if len(msgs) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "messages cannot be empty")
+	}
+
+	for _, m := range msgs {
+		if err := m.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the raw bytes a signer is expected to sign when submitting
+// a MsgExecAuthorized message. The wrapped messages are included using their own
+// sign bytes, as in the StdSignDoc, so that they need not be registered with the
+// authz codec.
+func (msg MsgExecAuthorized) GetSignBytes() []byte {
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		panic(err)
+	}
+
+	msgsBytes := make([]json.RawMessage, 0, len(msgs))
+	for _, m := range msgs {
+		msgsBytes = append(msgsBytes, json.RawMessage(m.GetSignBytes()))
+	}
+
+	bz, err := json.Marshal(struct {
+		Grantee sdk.AccAddress    `json:"grantee"`
+		Msgs    []json.RawMessage `json:"msgs"`
+	}{
+		Grantee: msg.Grantee,
+		Msgs:    msgsBytes,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the single expected signer for a MsgExecAuthorized,
+// which is the grantee.
+func (msg MsgExecAuthorized) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Grantee}
+}
+
+// GetMessages returns the unpacked messages to execute.
+func (msg MsgExecAuthorized) GetMessages() ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(msg.Msgs))
+	for i, any := range msg.Msgs {
+		if any == nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "message %d is empty", i)
+		}
+
+		m, ok := any.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "message %d: expected %T, got %T", i, (sdk.Msg)(nil), any.GetCachedValue())
+		}
+
+		msgs[i] = m
+	}
+
+	return msgs, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgExecAuthorized) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, any := range msg.Msgs {
+		var m sdk.Msg
+		if err := unpacker.UnpackAny(any, &m); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestMsgGrantAuthorization(t *testing.T) {
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	exp := time.Now().Add(time.Hour)
+
+	cases := map[string]struct {
+		granter       sdk.AccAddress
+		grantee       sdk.AccAddress
+		authorization types.Authorization
+		expiration    time.Time
+		valid         bool
+	}{
+		"valid send":          {granter, grantee, types.NewSendAuthorization(atom), exp, true},
+		"valid generic":       {granter, grantee, types.NewGenericAuthorization("cosmos.bank.MsgSend"), exp, true},
+		"missing granter":     {nil, grantee, types.NewSendAuthorization(atom), exp, false},
+		"missing grantee":     {granter, nil, types.NewSendAuthorization(atom), exp, false},
+		"self grant":          {granter, granter, types.NewSendAuthorization(atom), exp, false},
+		"missing expiration":  {granter, grantee, types.NewSendAuthorization(atom), time.Time{}, false},
+		"invalid spend limit": {granter, grantee, types.NewSendAuthorization(nil), exp, false},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			msg, err := types.NewMsgGrantAuthorization(tc.granter, tc.grantee, tc.authorization, tc.expiration)
+			require.NoError(t, err)
+
+			err = msg.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, []sdk.AccAddress{tc.granter}, msg.GetSigners())
+			require.NotPanics(t, func() { msg.GetSignBytes() })
+		})
+	}
+}
+
+func TestMsgRevokeAuthorization(t *testing.T) {
+	cases := map[string]struct {
+		granter sdk.AccAddress
+		grantee sdk.AccAddress
+		msgType string
+		valid   bool
+	}{
+		"valid":            {granter, grantee, "cosmos.bank.MsgSend", true},
+		"missing granter":  {nil, grantee, "cosmos.bank.MsgSend", false},
+		"missing grantee":  {granter, nil, "cosmos.bank.MsgSend", false},
+		"self revoke":      {granter, granter, "cosmos.bank.MsgSend", false},
+		"missing msg type": {granter, grantee, "", false},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			msg := types.NewMsgRevokeAuthorization(tc.granter, tc.grantee, tc.msgType)
+			err := msg.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, []sdk.AccAddress{tc.granter}, msg.GetSigners())
+			require.NotPanics(t, func() { msg.GetSignBytes() })
+		})
+	}
+}
+
+func TestMsgExecAuthorized(t *testing.T) {
+	send := banktypes.NewMsgSend(granter, grantee, sdk.NewCoins(sdk.NewInt64Coin("atom", 10)))
+	invalidSend := banktypes.NewMsgSend(granter, grantee, sdk.Coins{})
+
+	cases := map[string]struct {
+		grantee sdk.AccAddress
+		msgs    []sdk.Msg
+		valid   bool
+	}{
+		"valid":           {grantee, []sdk.Msg{send}, true},
+		"missing grantee": {nil, []sdk.Msg{send}, false},
+		"no messages":     {grantee, []sdk.Msg{}, false},
+		"invalid message": {grantee, []sdk.Msg{invalidSend}, false},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			msg, err := types.NewMsgExecAuthorized(tc.grantee, tc.msgs)
+			require.NoError(t, err)
+
+			err = msg.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, []sdk.AccAddress{tc.grantee}, msg.GetSigners())
+
+			msgs, err := msg.GetMessages()
+			require.NoError(t, err)
+			require.Equal(t, tc.msgs, msgs)
+
+			// the wrapped messages are signed with their own sign bytes
+			require.Contains(t, string(msg.GetSignBytes()), string(send.GetSignBytes()))
+		})
+	}
+}
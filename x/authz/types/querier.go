@@ -0,0 +1,57 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// Querier routes for the authz module
+const (
+	QueryAuthorization  = "authorization"
+	QueryAuthorizations = "authorizations"
+)
+
+// NewQueryAuthorizationRequest creates a new instance of QueryAuthorizationRequest.
+func NewQueryAuthorizationRequest(granter, grantee sdk.AccAddress, msgType string) *QueryAuthorizationRequest {
+	return &QueryAuthorizationRequest{Granter: granter, Grantee: grantee, MsgType: msgType}
+}
+
+// NewQueryAuthorizationsRequest creates a new instance of QueryAuthorizationsRequest.
+func NewQueryAuthorizationsRequest(granter, grantee sdk.AccAddress, req *query.PageRequest) *QueryAuthorizationsRequest {
+	return &QueryAuthorizationsRequest{Granter: granter, Grantee: grantee, Req: req}
+}
+
+// QueryAuthorizationsParams defines the parameters necessary for querying all
+// the authorizations granted by a granter to a grantee.
+type QueryAuthorizationsParams struct {
+	Granter sdk.AccAddress `json:"granter" yaml:"granter"`
+	Grantee sdk.AccAddress `json:"grantee" yaml:"grantee"`
+	Page    int            `json:"page" yaml:"page"`
+	Limit   int            `json:"limit" yaml:"limit"`
+}
+
+// NewQueryAuthorizationsParams creates a new instance of QueryAuthorizationsParams.
+func NewQueryAuthorizationsParams(granter, grantee sdk.AccAddress, page, limit int) QueryAuthorizationsParams {
+	return QueryAuthorizationsParams{Granter: granter, Grantee: grantee, Page: page, Limit: limit}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *QueryAuthorizationResponse) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	if m.Authorization == nil {
+		return nil
+	}
+
+	return m.Authorization.UnpackInterfaces(unpacker)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *QueryAuthorizationsResponse) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, grant := range m.Authorizations {
+		if err := grant.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
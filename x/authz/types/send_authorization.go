@@ -0,0 +1,53 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var _ Authorization = &SendAuthorization{}
+
+// NewSendAuthorization creates a new SendAuthorization object.
+func NewSendAuthorization(spendLimit sdk.Coins) *SendAuthorization {
+	return &SendAuthorization{
+		SpendLimit: spendLimit,
+	}
+}
+
+// MsgType implements Authorization.MsgType.
+func (authorization SendAuthorization) MsgType() string {
+	return MsgTypeURL(&banktypes.MsgSend{})
+}
+
+// Accept implements Authorization.Accept. It deducts the amount sent from the
+// spend limit and deletes the authorization once it is used up.
+func (authorization SendAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (Authorization, bool, error) {
+	switch msg := msg.(type) {
+	case *banktypes.MsgSend:
+		limitLeft, isNegative := authorization.SpendLimit.SafeSub(msg.Amount)
+		if isNegative {
+			return nil, false, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "requested amount is more than spend limit")
+		}
+		if limitLeft.IsZero() {
+			return nil, true, nil
+		}
+
+		return &SendAuthorization{SpendLimit: limitLeft}, false, nil
+
+	default:
+		return nil, false, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "type mismatch, expected %T, got %T", &banktypes.MsgSend{}, msg)
+	}
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (authorization SendAuthorization) ValidateBasic() error {
+	if !authorization.SpendLimit.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "spend limit is invalid: %s", authorization.SpendLimit)
+	}
+	if !authorization.SpendLimit.IsAllPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "spend limit must be positive")
+	}
+
+	return nil
+}
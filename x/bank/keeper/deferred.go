@@ -0,0 +1,195 @@
+package keeper
+
+import (
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// DeferredSendKeeper defines a module interface sending coins to module
+// accounts at the end of the block rather than immediately, so that the txs
+// sending coins to the same module account, like the fees sent to the fee
+// collector, don't conflict with each other when executed in parallel.
+type DeferredSendKeeper interface {
+	SendCoinsFromAccountToModuleDeferred(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendDeferredCoinsToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	CreditDeferredCoins(ctx sdk.Context)
+	GetAllDeferredCoins(ctx sdk.Context) sdk.Coins
+}
+
+var _ DeferredSendKeeper = BaseKeeper{}
+
+// SendCoinsFromAccountToModuleDeferred transfers coins from an AccAddress to a
+// ModuleAccount. The coins are deducted from the sender immediately, but are
+// only credited to the module account by CreditDeferredCoins, at the end of
+// the block. Until then, they are held for the tx being executed, so that the
+// deferred sends of different txs never access the same keys.
+func (k BaseKeeper) SendCoinsFromAccountToModuleDeferred(
+	ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins,
+) error {
+
+	recipientAddr := k.ak.GetModuleAddress(recipientModule)
+	if recipientAddr == nil {
+		panic(sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", recipientModule))
+	}
+
+	if _, err := k.SubtractCoins(ctx, senderAddr, amt); err != nil {
+		return err
+	}
+
+	store := k.deferredTxStore(ctx, recipientModule)
+	for _, coin := range amt {
+		deferred := k.getDeferredCoin(store, coin.Denom).Add(coin)
+		store.Set([]byte(coin.Denom), k.cdc.MustMarshalBinaryBare(&deferred))
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTransfer,
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipientAddr.String()),
+			sdk.NewAttribute(types.AttributeKeySender, senderAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amt.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(types.AttributeKeySender, senderAddr.String()),
+		),
+	})
+
+	return nil
+}
+
+// SendDeferredCoinsToAccount transfers coins sent to a ModuleAccount with
+// SendCoinsFromAccountToModuleDeferred by the tx being executed, and not yet
+// credited, to an AccAddress.
+func (k BaseKeeper) SendDeferredCoinsToAccount(
+	ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins,
+) error {
+
+	senderAddr := k.ak.GetModuleAddress(senderModule)
+	if senderAddr == nil {
+		panic(sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", senderModule))
+	}
+
+	if !amt.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
+	}
+
+	store := k.deferredTxStore(ctx, senderModule)
+	for _, coin := range amt {
+		deferred := k.getDeferredCoin(store, coin.Denom)
+		if deferred.IsLT(coin) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "%s is smaller than %s", deferred, coin)
+		}
+
+		deferred = deferred.Sub(coin)
+		if deferred.IsZero() {
+			store.Delete([]byte(coin.Denom))
+		} else {
+			store.Set([]byte(coin.Denom), k.cdc.MustMarshalBinaryBare(&deferred))
+		}
+	}
+
+	if _, err := k.AddCoins(ctx, recipientAddr, amt); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTransfer,
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipientAddr.String()),
+			sdk.NewAttribute(types.AttributeKeySender, senderAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amt.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(types.AttributeKeySender, senderAddr.String()),
+		),
+	})
+
+	return nil
+}
+
+// CreditDeferredCoins credits the coins sent with
+// SendCoinsFromAccountToModuleDeferred to their module accounts. It is meant to
+// be the BlockFinalizer of the app, so that the deferred coins are credited in
+// the block they were sent in, before any EndBlocker.
+func (k BaseKeeper) CreditDeferredCoins(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DeferredPrefix)
+
+	var (
+		modules []string
+		credits = make(map[string]sdk.Coins)
+		keys    [][]byte
+	)
+
+	iterator := store.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		module := types.ModuleFromDeferredStore(iterator.Key())
+		if _, ok := credits[module]; !ok {
+			modules = append(modules, module)
+		}
+
+		var coin sdk.Coin
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &coin)
+
+		credits[module] = credits[module].Add(coin)
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	for _, module := range modules {
+		moduleAcc := k.ak.GetModuleAccount(ctx, module)
+		if moduleAcc == nil {
+			panic(sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", module))
+		}
+
+		if _, err := k.AddCoins(ctx, moduleAcc.GetAddress(), credits[module]); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// GetAllDeferredCoins returns the coins sent with
+// SendCoinsFromAccountToModuleDeferred and not yet credited.
+func (k BaseKeeper) GetAllDeferredCoins(ctx sdk.Context) sdk.Coins {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DeferredPrefix)
+	deferred := sdk.NewCoins()
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var coin sdk.Coin
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &coin)
+
+		deferred = deferred.Add(coin)
+	}
+
+	return deferred
+}
+
+// deferredTxStore returns the store of the coins sent to the given module by
+// the tx being executed, identified by the hash of its bytes.
+func (k BaseKeeper) deferredTxStore(ctx sdk.Context, module string) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.DeferredTxKey(module, tmhash.Sum(ctx.TxBytes())))
+}
+
+func (k BaseKeeper) getDeferredCoin(store prefix.Store, denom string) sdk.Coin {
+	bz := store.Get([]byte(denom))
+	if bz == nil {
+		return sdk.NewCoin(denom, sdk.ZeroInt())
+	}
+
+	var coin sdk.Coin
+	k.cdc.MustUnmarshalBinaryBare(bz, &coin)
+
+	return coin
+}
@@ -57,6 +57,10 @@ func TotalSupply(k Keeper) sdk.Invariant {
 			return false
 		})
 
+		// the coins sent to module accounts and not yet credited are part of
+		// the supply
+		expectedTotal = expectedTotal.Add(k.GetAllDeferredCoins(ctx)...)
+
 		broken := !expectedTotal.IsEqual(supply.GetTotal())
 
 		return sdk.FormatInvariant(types.ModuleName, "total supply",
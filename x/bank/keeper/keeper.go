@@ -23,6 +23,7 @@ var _ Keeper = (*BaseKeeper)(nil)
 // between accounts.
 type Keeper interface {
 	SendKeeper
+	DeferredSendKeeper
 
 	InitGenesis(sdk.Context, types.GenesisState)
 	ExportGenesis(sdk.Context) types.GenesisState
@@ -16,6 +16,7 @@ import (
 	vesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
 const (
@@ -968,3 +969,47 @@ func (suite *IntegrationTestSuite) TestUndelegateCoins_Invalid() {
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
 }
+
+func (suite *IntegrationTestSuite) TestDeferredSendCoins() {
+	app, ctx := suite.app, suite.ctx
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+
+	coins := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
+	suite.Require().NoError(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+	suite.Require().NoError(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr1, coins))
+
+	suite.Require().Panics(func() {
+		app.BankKeeper.SendCoinsFromAccountToModuleDeferred(ctx, addr1, "", coins) // nolint:errcheck
+	})
+	suite.Require().Error(app.BankKeeper.SendCoinsFromAccountToModuleDeferred(ctx, addr1, authtypes.FeeCollectorName, coins.Add(coins...)))
+
+	// the coins sent by different txs are held separately, and are part of
+	// the supply until they're credited
+	ctx1 := ctx.WithTxBytes([]byte("tx1"))
+	ctx2 := ctx.WithTxBytes([]byte("tx2"))
+	suite.Require().NoError(app.BankKeeper.SendCoinsFromAccountToModuleDeferred(ctx1, addr1, authtypes.FeeCollectorName, sdk.NewCoins(newFooCoin(30))))
+	suite.Require().NoError(app.BankKeeper.SendCoinsFromAccountToModuleDeferred(ctx2, addr1, authtypes.FeeCollectorName, sdk.NewCoins(newFooCoin(20), newBarCoin(50))))
+
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(50)), app.BankKeeper.GetAllBalances(ctx, addr1))
+	suite.Require().Empty(app.BankKeeper.GetAllBalances(ctx, feeCollector))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(50), newBarCoin(50)), app.BankKeeper.GetAllDeferredCoins(ctx))
+
+	_, broken := keeper.TotalSupply(app.BankKeeper)(ctx)
+	suite.Require().False(broken)
+
+	// only the coins sent by the same tx can be sent back
+	suite.Require().Error(app.BankKeeper.SendDeferredCoinsToAccount(ctx1, authtypes.FeeCollectorName, addr2, sdk.NewCoins(newFooCoin(31))))
+	suite.Require().Error(app.BankKeeper.SendDeferredCoinsToAccount(ctx1, authtypes.FeeCollectorName, addr2, sdk.NewCoins(newBarCoin(1))))
+	suite.Require().NoError(app.BankKeeper.SendDeferredCoinsToAccount(ctx1, authtypes.FeeCollectorName, addr2, sdk.NewCoins(newFooCoin(10))))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(10)), app.BankKeeper.GetAllBalances(ctx, addr2))
+
+	// the coins are credited to the module account at the end of the block
+	app.BankKeeper.CreditDeferredCoins(ctx)
+	suite.Require().Empty(app.BankKeeper.GetAllDeferredCoins(ctx))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(40), newBarCoin(50)), app.BankKeeper.GetAllBalances(ctx, feeCollector))
+
+	_, broken = keeper.TotalSupply(app.BankKeeper)(ctx)
+	suite.Require().False(broken)
+}
@@ -24,8 +24,30 @@ const (
 var (
 	BalancesPrefix = []byte("balances")
 	SupplyKey      = []byte{0x00}
+	DeferredPrefix = []byte{0x01}
 )
 
+// DeferredTxKey returns the key prefix of the coins sent to a module account
+// by a tx, given the hash of the tx bytes.
+func DeferredTxKey(module string, txHash []byte) []byte {
+	key := make([]byte, 0, len(DeferredPrefix)+1+len(module)+len(txHash))
+	key = append(key, DeferredPrefix...)
+	key = append(key, byte(len(module)))
+	key = append(key, module...)
+
+	return append(key, txHash...)
+}
+
+// ModuleFromDeferredStore returns the name of the module account of a key of
+// a deferred prefix store. The key must not contain the prefix DeferredPrefix.
+func ModuleFromDeferredStore(key []byte) string {
+	if len(key) == 0 || len(key) < 1+int(key[0]) {
+		panic(fmt.Sprintf("unexpected deferred coin key: %X", key))
+	}
+
+	return string(key[1 : 1+key[0]])
+}
+
 // AddressFromBalancesStore returns an account address from a balances prefix
 // store. The key must not contain the perfix BalancesPrefix as the prefix store
 // iterator discards the actual prefix.
@@ -0,0 +1,26 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing/textual"
+)
+
+func init() {
+	textual.RegisterMsgRenderer(&MsgSend{}, renderMsgSend)
+}
+
+// renderMsgSend renders a MsgSend for SIGN_MODE_TEXTUAL.
+func renderMsgSend(msg sdk.Msg) ([]string, error) {
+	m, ok := msg.(*MsgSend)
+	if !ok {
+		return nil, fmt.Errorf("expected %T, got %T", &MsgSend{}, msg)
+	}
+
+	return []string{
+		fmt.Sprintf("From: %s", m.FromAddress),
+		fmt.Sprintf("To: %s", m.ToAddress),
+		fmt.Sprintf("Amount: %s", m.Amount),
+	}, nil
+}
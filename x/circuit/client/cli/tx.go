@@ -0,0 +1,197 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	gov "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// NewTxCmd returns a root CLI command handler for all x/circuit transaction commands.
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Circuit breaker transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewCmdDisableMsgs(),
+		NewCmdEnableMsgs(),
+	)
+
+	return txCmd
+}
+
+// NewCmdDisableMsgs returns a CLI command handler for creating a MsgDisableMsgs transaction.
+func NewCmdDisableMsgs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "disable [type_url_or_route]...",
+		Short: "Disable messages by their type URL or route",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Disable messages by their type URL, or all the messages of a module by its
+route. The sender must be one of the circuit breaker authorities.
+
+Example:
+  $ %s tx %s disable /cosmos.bank.MsgSend staking --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDisableMsgs(clientCtx.GetFromAddress(), args)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdEnableMsgs returns a CLI command handler for creating a MsgEnableMsgs transaction.
+func NewCmdEnableMsgs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "enable [type_url_or_route]...",
+		Short: "Enable disabled messages by their type URL or route",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Enable messages which were disabled by their type URL or route. The sender
+must be one of the circuit breaker authorities.
+
+Example:
+  $ %s tx %s enable /cosmos.bank.MsgSend staking --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgEnableMsgs(clientCtx.GetFromAddress(), args)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdSubmitDisableMsgsProposal implements a command handler for submitting
+// a proposal disabling messages.
+func NewCmdSubmitDisableMsgsProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "disable-msgs [type_url_or_route]... [flags]",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "Submit a proposal disabling messages",
+		Long: "Submit a proposal disabling messages by their type URL, or all the messages of a module\n" +
+			"by its route, along with an initial deposit.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitProposal(cmd, func(title, description string) gov.Content {
+				return types.NewDisableMsgsProposal(title, description, args)
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+// NewCmdSubmitEnableMsgsProposal implements a command handler for submitting
+// a proposal enabling disabled messages.
+func NewCmdSubmitEnableMsgsProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "enable-msgs [type_url_or_route]... [flags]",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "Submit a proposal enabling disabled messages",
+		Long: "Submit a proposal enabling messages which were disabled by their type URL or route,\n" +
+			"along with an initial deposit.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitProposal(cmd, func(title, description string) gov.Content {
+				return types.NewEnableMsgsProposal(title, description, args)
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	flags.AddTxFlagsToCmd(cmd)
+}
+
+func submitProposal(cmd *cobra.Command, newContent func(title, description string) gov.Content) error {
+	clientCtx := client.GetClientContextFromCmd(cmd)
+	clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+	if err != nil {
+		return err
+	}
+
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagDescription)
+	if err != nil {
+		return err
+	}
+
+	depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+	if err != nil {
+		return err
+	}
+
+	deposit, err := sdk.ParseCoins(depositStr)
+	if err != nil {
+		return err
+	}
+
+	msg, err := gov.NewMsgSubmitProposal(newContent(title, description), deposit, clientCtx.GetFromAddress())
+	if err != nil {
+		return err
+	}
+
+	if err = msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
@@ -0,0 +1,13 @@
+package client
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/circuit/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/circuit/client/rest"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+)
+
+// DisableMsgsProposalHandler is the proposal handler disabling messages.
+var DisableMsgsProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitDisableMsgsProposal, rest.DisableMsgsProposalRESTHandler)
+
+// EnableMsgsProposalHandler is the proposal handler enabling disabled messages.
+var EnableMsgsProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitEnableMsgsProposal, rest.EnableMsgsProposalRESTHandler)
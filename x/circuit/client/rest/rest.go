@@ -0,0 +1,15 @@
+package rest
+
+import (
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+// RegisterHandlers registers all x/circuit query HTTP REST handlers on the
+// provided mux router.
+func RegisterHandlers(clientCtx client.Context, r *mux.Router) {
+	r.HandleFunc("/circuit/parameters", queryHandlerFn(clientCtx, types.QueryParams)).Methods("GET")
+	r.HandleFunc("/circuit/disabled_msgs", queryHandlerFn(clientCtx, types.QueryDisabledMsgs)).Methods("GET")
+}
@@ -0,0 +1,74 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	gov "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// MsgsProposalReq defines the properties of a request's body for a proposal
+// disabling or enabling messages.
+type MsgsProposalReq struct {
+	BaseReq     rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title       string       `json:"title" yaml:"title"`
+	Description string       `json:"description" yaml:"description"`
+	Deposit     sdk.Coins    `json:"deposit" yaml:"deposit"`
+	Msgs        []string     `json:"msgs" yaml:"msgs"`
+}
+
+// DisableMsgsProposalRESTHandler returns a ProposalRESTHandler that exposes
+// the disable messages REST handler with a given sub-route.
+func DisableMsgsProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "disable_msgs",
+		Handler:  postMsgsProposalHandlerFn(clientCtx, types.NewDisableMsgsProposal),
+	}
+}
+
+// EnableMsgsProposalRESTHandler returns a ProposalRESTHandler that exposes
+// the enable messages REST handler with a given sub-route.
+func EnableMsgsProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "enable_msgs",
+		Handler:  postMsgsProposalHandlerFn(clientCtx, types.NewEnableMsgsProposal),
+	}
+}
+
+func postMsgsProposalHandlerFn(
+	clientCtx client.Context, newContent func(title, description string, msgs []string) gov.Content,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req MsgsProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.JSONMarshaler, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		content := newContent(req.Title, req.Description, req.Msgs)
+		msg, err := gov.NewMsgSubmitProposal(content, req.Deposit, fromAddr)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
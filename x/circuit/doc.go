@@ -0,0 +1,19 @@
+/*
+Package circuit provides a circuit breaker for the messages of the other
+modules, so that the messages of a module in which a bug is found can be
+stopped without halting the chain.
+
+A message is disabled either by its type URL, such as "/cosmos.bank.MsgSend",
+or by its route, such as "bank", which disables all the messages of a module.
+Messages are disabled and enabled again through a DisableMsgsProposal and an
+EnableMsgsProposal governance proposal, or by one of the authorities set in the
+module parameters through MsgDisableMsgs and MsgEnableMsgs. The messages of
+the circuit module itself and of the gov module cannot be disabled, so that the
+disabled messages can always be enabled again.
+
+The CircuitBreakerDecorator in x/auth/ante calls Keeper.AssertMsgEnabled for
+each message of a transaction, including the messages nested in an authz
+MsgExecAuthorized, and rejects the transaction with ErrMsgDisabled in both
+CheckTx and DeliverTx if any of them is disabled.
+*/
+package circuit
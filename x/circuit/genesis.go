@@ -0,0 +1,30 @@
+package circuit
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit/keeper"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+// InitGenesis initializes the circuit module's state from a provided genesis
+// state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
+	if err := gs.Validate(); err != nil {
+		panic(fmt.Sprintf("failed to validate %s genesis state: %s", types.ModuleName, err))
+	}
+
+	k.SetParams(ctx, gs.Params)
+
+	if len(gs.DisabledMsgs) > 0 {
+		if err := k.DisableMsgs(ctx, gs.DisabledMsgs); err != nil {
+			panic(fmt.Sprintf("failed to disable messages: %s", err))
+		}
+	}
+}
+
+// ExportGenesis returns the circuit module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
+	return types.NewGenesisState(k.GetParams(ctx), k.GetDisabledMsgs(ctx))
+}
@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params returns the parameters of the circuit module.
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// DisabledMsgs returns the type URLs and routes of the disabled messages.
+func (k Keeper) DisabledMsgs(c context.Context, _ *types.QueryDisabledMsgsRequest) (*types.QueryDisabledMsgsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryDisabledMsgsResponse{Msgs: k.GetDisabledMsgs(ctx)}, nil
+}
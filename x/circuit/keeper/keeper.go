@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Keeper manages the messages disabled by the circuit breaker. A message is
+// disabled if either its type URL or its route is disabled.
+type Keeper struct {
+	cdc        codec.Marshaler
+	storeKey   sdk.StoreKey
+	paramSpace paramtypes.Subspace
+}
+
+// NewKeeper creates a circuit Keeper
+func NewKeeper(cdc codec.Marshaler, storeKey sdk.StoreKey, paramSpace paramtypes.Subspace) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		cdc:        cdc,
+		storeKey:   storeKey,
+		paramSpace: paramSpace,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// DisableMsgs disables the messages with the given type URLs or routes. The
+// entries which are already disabled are left as is.
+func (k Keeper) DisableMsgs(ctx sdk.Context, entries []string) error {
+	if err := types.ValidateMsgEntries(entries); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, entry := range entries {
+		store.Set(types.DisabledMsgKey(entry), []byte{})
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeDisableMsg,
+				sdk.NewAttribute(types.AttributeKeyMsg, entry),
+			),
+		)
+	}
+
+	k.Logger(ctx).Info("disabled messages", "msgs", entries)
+
+	return nil
+}
+
+// EnableMsgs enables the messages with the given type URLs or routes. It
+// returns ErrNotDisabled if any of them is not disabled.
+func (k Keeper) EnableMsgs(ctx sdk.Context, entries []string) error {
+	if err := types.ValidateMsgEntries(entries); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, entry := range entries {
+		if !store.Has(types.DisabledMsgKey(entry)) {
+			return sdkerrors.Wrap(types.ErrNotDisabled, entry)
+		}
+	}
+
+	for _, entry := range entries {
+		store.Delete(types.DisabledMsgKey(entry))
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeEnableMsg,
+				sdk.NewAttribute(types.AttributeKeyMsg, entry),
+			),
+		)
+	}
+
+	k.Logger(ctx).Info("enabled messages", "msgs", entries)
+
+	return nil
+}
+
+// IsDisabled returns true if the given message type URL or route is disabled.
+func (k Keeper) IsDisabled(ctx sdk.Context, entry string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.DisabledMsgKey(entry))
+}
+
+// AssertMsgEnabled returns ErrMsgDisabled if either the type URL or the route
+// of the given message is disabled.
+func (k Keeper) AssertMsgEnabled(ctx sdk.Context, msg sdk.Msg) error {
+	typeURL := sdk.MsgTypeURL(msg)
+	if k.IsDisabled(ctx, typeURL) {
+		return sdkerrors.Wrapf(types.ErrMsgDisabled, "message %s is disabled", typeURL)
+	}
+
+	if route := msg.Route(); k.IsDisabled(ctx, route) {
+		return sdkerrors.Wrapf(types.ErrMsgDisabled, "message %s of route %s is disabled", typeURL, route)
+	}
+
+	return nil
+}
+
+// IterateDisabledMsgs iterates over the type URLs and routes of all the
+// disabled messages, in order, until cb returns true.
+func (k Keeper) IterateDisabledMsgs(ctx sdk.Context, cb func(entry string) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DisabledMsgKeyPrefix)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if cb(string(iter.Key())) {
+			break
+		}
+	}
+}
+
+// GetDisabledMsgs returns the type URLs and routes of all the disabled
+// messages.
+func (k Keeper) GetDisabledMsgs(ctx sdk.Context) []string {
+	entries := []string{}
+	k.IterateDisabledMsgs(ctx, func(entry string) bool {
+		entries = append(entries, entry)
+		return false
+	})
+
+	return entries
+}
@@ -0,0 +1,169 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit"
+	"github.com/cosmos/cosmos-sdk/x/circuit/keeper"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app   *simapp.SimApp
+	ctx   sdk.Context
+	addrs []sdk.AccAddress
+
+	queryClient types.QueryClient
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	suite.app = app
+	suite.ctx = ctx
+	suite.addrs = simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(30000000))
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.CircuitKeeper)
+	suite.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) TestDisableEnableMsgs() {
+	ctx, k := suite.ctx, suite.app.CircuitKeeper
+
+	msgSend := banktypes.NewMsgSend(suite.addrs[0], suite.addrs[1], nil)
+	msgDelegate := &stakingtypes.MsgDelegate{}
+
+	suite.Require().Empty(k.GetDisabledMsgs(ctx))
+	suite.Require().NoError(k.AssertMsgEnabled(ctx, msgSend))
+	suite.Require().NoError(k.AssertMsgEnabled(ctx, msgDelegate))
+
+	suite.Require().NoError(k.DisableMsgs(ctx, []string{"/cosmos.bank.MsgSend", "staking"}))
+	suite.Require().Equal([]string{"/cosmos.bank.MsgSend", "staking"}, k.GetDisabledMsgs(ctx))
+	suite.Require().True(k.IsDisabled(ctx, "staking"))
+	suite.Require().False(k.IsDisabled(ctx, "bank"))
+
+	err := k.AssertMsgEnabled(ctx, msgSend)
+	suite.Require().True(types.ErrMsgDisabled.Is(err), err)
+	suite.Require().Contains(err.Error(), "/cosmos.bank.MsgSend")
+
+	err = k.AssertMsgEnabled(ctx, msgDelegate)
+	suite.Require().True(types.ErrMsgDisabled.Is(err), err)
+	suite.Require().Contains(err.Error(), "route staking")
+
+	// disabling a message twice is a no-op
+	suite.Require().NoError(k.DisableMsgs(ctx, []string{"staking"}))
+
+	// the circuit breaker cannot disable itself, nor the governance through
+	// which it can be reset
+	suite.Require().Error(k.DisableMsgs(ctx, []string{types.RouterKey}))
+	suite.Require().Error(k.DisableMsgs(ctx, []string{"/cosmos.circuit.MsgEnableMsgs"}))
+	suite.Require().Error(k.DisableMsgs(ctx, []string{"/cosmos.circuit.MsgDisableMsgs"}))
+	suite.Require().Error(k.DisableMsgs(ctx, []string{govtypes.RouterKey}))
+	suite.Require().Error(k.DisableMsgs(ctx, []string{"/cosmos.gov.MsgSubmitProposal"}))
+	suite.Require().Error(k.DisableMsgs(ctx, []string{"/cosmos.gov.MsgVote"}))
+	suite.Require().Error(k.DisableMsgs(ctx, []string{"/cosmos.gov.MsgDeposit"}))
+	suite.Require().Error(k.DisableMsgs(ctx, []string{"bank", govtypes.RouterKey}))
+	suite.Require().False(k.IsDisabled(ctx, "bank"))
+	suite.Require().Error(k.DisableMsgs(ctx, []string{"not a route"}))
+
+	// enabling fails as a whole if any message is not disabled
+	err = k.EnableMsgs(ctx, []string{"staking", "bank"})
+	suite.Require().True(types.ErrNotDisabled.Is(err), err)
+	suite.Require().True(k.IsDisabled(ctx, "staking"))
+
+	suite.Require().NoError(k.EnableMsgs(ctx, []string{"staking"}))
+	suite.Require().Equal([]string{"/cosmos.bank.MsgSend"}, k.GetDisabledMsgs(ctx))
+	suite.Require().NoError(k.AssertMsgEnabled(ctx, msgDelegate))
+}
+
+func (suite *KeeperTestSuite) TestMsgServer() {
+	ctx, k := suite.ctx, suite.app.CircuitKeeper
+	authority, other := suite.addrs[0], suite.addrs[1]
+
+	k.SetParams(ctx, types.NewParams([]sdk.AccAddress{authority}))
+
+	msgServer := keeper.NewMsgServerImpl(k)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	_, err := msgServer.DisableMsgs(goCtx, types.NewMsgDisableMsgs(other, []string{"bank"}))
+	suite.Require().True(sdkerrors.ErrUnauthorized.Is(err), err)
+	suite.Require().Empty(k.GetDisabledMsgs(ctx))
+
+	_, err = msgServer.DisableMsgs(goCtx, types.NewMsgDisableMsgs(authority, []string{"bank"}))
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{"bank"}, k.GetDisabledMsgs(ctx))
+
+	_, err = msgServer.EnableMsgs(goCtx, types.NewMsgEnableMsgs(other, []string{"bank"}))
+	suite.Require().True(sdkerrors.ErrUnauthorized.Is(err), err)
+
+	_, err = msgServer.EnableMsgs(goCtx, types.NewMsgEnableMsgs(authority, []string{"bank"}))
+	suite.Require().NoError(err)
+	suite.Require().Empty(k.GetDisabledMsgs(ctx))
+
+	// the authority cannot disable the messages needed to reset the circuit
+	// breaker
+	for _, entry := range []string{
+		types.RouterKey, "/cosmos.circuit.MsgDisableMsgs", "/cosmos.circuit.MsgEnableMsgs",
+		govtypes.RouterKey, "/cosmos.gov.MsgSubmitProposal", "/cosmos.gov.MsgVote", "/cosmos.gov.MsgDeposit",
+	} {
+		_, err = msgServer.DisableMsgs(goCtx, types.NewMsgDisableMsgs(authority, []string{entry}))
+		suite.Require().True(types.ErrInvalidMsgEntry.Is(err), entry)
+	}
+
+	suite.Require().Empty(k.GetDisabledMsgs(ctx))
+}
+
+func (suite *KeeperTestSuite) TestProposalHandler() {
+	ctx, k := suite.ctx, suite.app.CircuitKeeper
+	handler := circuit.NewProposalHandler(k)
+
+	err := handler(ctx, types.NewDisableMsgsProposal("title", "description", []string{"/cosmos.bank.MsgSend"}))
+	suite.Require().NoError(err)
+	suite.Require().True(k.IsDisabled(ctx, "/cosmos.bank.MsgSend"))
+
+	err = handler(ctx, types.NewEnableMsgsProposal("title", "description", []string{"/cosmos.bank.MsgSend"}))
+	suite.Require().NoError(err)
+	suite.Require().False(k.IsDisabled(ctx, "/cosmos.bank.MsgSend"))
+}
+
+func (suite *KeeperTestSuite) TestGRPCQuery() {
+	ctx, k := suite.ctx, suite.app.CircuitKeeper
+	params := types.NewParams([]sdk.AccAddress{suite.addrs[0]})
+
+	k.SetParams(ctx, params)
+	suite.Require().NoError(k.DisableMsgs(ctx, []string{"bank", "staking"}))
+
+	paramsRes, err := suite.queryClient.Params(sdk.WrapSDKContext(ctx), &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(params, paramsRes.Params)
+
+	msgsRes, err := suite.queryClient.DisabledMsgs(sdk.WrapSDKContext(ctx), &types.QueryDisabledMsgsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{"bank", "staking"}, msgsRes.Msgs)
+}
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	ctx, k := suite.ctx, suite.app.CircuitKeeper
+	gs := types.NewGenesisState(types.NewParams([]sdk.AccAddress{suite.addrs[0]}), []string{"/cosmos.bank.MsgSend", "staking"})
+
+	circuit.InitGenesis(ctx, k, gs)
+	suite.Require().Equal(gs, circuit.ExportGenesis(ctx, k))
+}
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the circuit MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// DisableMsgs implements the Msg/DisableMsgs method.
+func (k msgServer) DisableMsgs(goCtx context.Context, msg *types.MsgDisableMsgs) (*types.MsgDisableMsgsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.assertAuthority(ctx, msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.DisableMsgs(ctx, msg.Msgs); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority.String()),
+		),
+	)
+
+	return &types.MsgDisableMsgsResponse{}, nil
+}
+
+// EnableMsgs implements the Msg/EnableMsgs method.
+func (k msgServer) EnableMsgs(goCtx context.Context, msg *types.MsgEnableMsgs) (*types.MsgEnableMsgsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.assertAuthority(ctx, msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.EnableMsgs(ctx, msg.Msgs); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority.String()),
+		),
+	)
+
+	return &types.MsgEnableMsgsResponse{}, nil
+}
+
+func (k msgServer) assertAuthority(ctx sdk.Context, addr sdk.AccAddress) error {
+	if !k.GetParams(ctx).IsAuthority(addr) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not a %s authority", addr, types.ModuleName)
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// RegisterCodec registers all the necessary types and interfaces for the
+// circuit module.
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(&MsgDisableMsgs{}, "cosmos-sdk/MsgDisableMsgs", nil)
+	cdc.RegisterConcrete(&MsgEnableMsgs{}, "cosmos-sdk/MsgEnableMsgs", nil)
+	cdc.RegisterConcrete(&DisableMsgsProposal{}, "cosmos-sdk/DisableMsgsProposal", nil)
+	cdc.RegisterConcrete(&EnableMsgsProposal{}, "cosmos-sdk/EnableMsgsProposal", nil)
+}
+
+// RegisterInterfaces registers the circuit module's interface types and
+// their concrete implementations.
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgDisableMsgs{},
+		&MsgEnableMsgs{},
+	)
+
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&DisableMsgsProposal{},
+		&EnableMsgsProposal{},
+	)
+}
+
+var (
+	amino = codec.New()
+
+	// ModuleCdc references the global x/circuit module codec. Note, the codec
+	// should ONLY be used in certain instances of tests and for JSON encoding as
+	// Amino is still used for that purpose.
+	//
+	// The actual codec used for serialization should be provided to x/circuit
+	// and defined at the application level.
+	ModuleCdc = codec.NewHybridCodec(amino, types.NewInterfaceRegistry())
+)
+
+func init() {
+	RegisterCodec(amino)
+	amino.Seal()
+}
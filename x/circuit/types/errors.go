@@ -0,0 +1,15 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/circuit module sentinel errors
+var (
+	// ErrMsgDisabled error if a message is disabled by the circuit breaker
+	ErrMsgDisabled = sdkerrors.Register(ModuleName, 2, "message disabled by the circuit breaker")
+	// ErrInvalidMsgEntry error if a message type URL or route is invalid
+	ErrInvalidMsgEntry = sdkerrors.Register(ModuleName, 3, "invalid message type URL or route")
+	// ErrNotDisabled error if a message type URL or route to enable is not disabled
+	ErrNotDisabled = sdkerrors.Register(ModuleName, 4, "message type URL or route is not disabled")
+)
@@ -0,0 +1,11 @@
+package types
+
+// circuit module events
+const (
+	EventTypeDisableMsg = "disable_msg"
+	EventTypeEnableMsg  = "enable_msg"
+
+	AttributeKeyMsg = "msg"
+
+	AttributeValueCategory = ModuleName
+)
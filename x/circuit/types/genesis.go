@@ -0,0 +1,34 @@
+package types
+
+// GenesisState - circuit genesis state
+type GenesisState struct {
+	Params       Params   `json:"params" yaml:"params"`
+	DisabledMsgs []string `json:"disabled_msgs" yaml:"disabled_msgs"`
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, disabledMsgs []string) GenesisState {
+	return GenesisState{
+		Params:       params,
+		DisabledMsgs: disabledMsgs,
+	}
+}
+
+// DefaultGenesisState returns the circuit module's default genesis state, in
+// which no message is disabled.
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		Params:       DefaultParams(),
+		DisabledMsgs: []string{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	return ValidateMsgEntries(gs.DisabledMsgs)
+}
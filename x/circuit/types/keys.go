@@ -0,0 +1,27 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "circuit"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+// KVStore key prefixes
+var (
+	// DisabledMsgKeyPrefix is the prefix of the type URLs and routes of the
+	// disabled messages
+	DisabledMsgKeyPrefix = []byte{0x00}
+)
+
+// DisabledMsgKey returns the store key of a disabled message type URL or route.
+func DisabledMsgKey(entry string) []byte {
+	return append(DisabledMsgKeyPrefix, []byte(entry)...)
+}
@@ -0,0 +1,135 @@
+package types
+
+import (
+	"regexp"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gov "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// Message types for the circuit module
+const (
+	TypeMsgDisableMsgs = "disable_msgs"
+	TypeMsgEnableMsgs  = "enable_msgs"
+)
+
+var (
+	_ sdk.Msg = &MsgDisableMsgs{}
+	_ sdk.Msg = &MsgEnableMsgs{}
+)
+
+// isTypeURL matches the type URLs of messages, such as "/cosmos.bank.MsgSend".
+var isTypeURL = regexp.MustCompile(`^/[a-zA-Z0-9_.]+$`).MatchString
+
+// ValidateMsgEntry checks that entry is either the type URL of a message, such
+// as "/cosmos.bank.MsgSend", or a message route, such as "bank". The messages
+// of the circuit and gov modules cannot be disabled, so that the circuit
+// breaker can always be reset.
+func ValidateMsgEntry(entry string) error {
+	if !isTypeURL(entry) && !sdk.IsAlphaNumeric(entry) {
+		return sdkerrors.Wrapf(ErrInvalidMsgEntry, "%q is neither a type URL nor a route", entry)
+	}
+
+	switch entry {
+	case RouterKey, sdk.MsgTypeURL(&MsgDisableMsgs{}), sdk.MsgTypeURL(&MsgEnableMsgs{}):
+		return sdkerrors.Wrapf(ErrInvalidMsgEntry, "%s messages cannot be disabled", ModuleName)
+
+	case gov.RouterKey, sdk.MsgTypeURL(&gov.MsgSubmitProposal{}), sdk.MsgTypeURL(&gov.MsgVote{}), sdk.MsgTypeURL(&gov.MsgDeposit{}):
+		return sdkerrors.Wrapf(ErrInvalidMsgEntry, "%s messages cannot be disabled", gov.ModuleName)
+	}
+
+	return nil
+}
+
+// ValidateMsgEntries checks that entries are valid message type URLs or
+// routes, without duplicates.
+func ValidateMsgEntries(entries []string) error {
+	seen := make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		if err := ValidateMsgEntry(entry); err != nil {
+			return err
+		}
+
+		if seen[entry] {
+			return sdkerrors.Wrapf(ErrInvalidMsgEntry, "duplicate entry %s", entry)
+		}
+		seen[entry] = true
+	}
+
+	return nil
+}
+
+// NewMsgDisableMsgs creates a new MsgDisableMsgs.
+func NewMsgDisableMsgs(authority sdk.AccAddress, msgs []string) *MsgDisableMsgs {
+	return &MsgDisableMsgs{
+		Authority: authority,
+		Msgs:      msgs,
+	}
+}
+
+// Route returns the MsgDisableMsgs's route.
+func (msg MsgDisableMsgs) Route() string { return RouterKey }
+
+// Type returns the MsgDisableMsgs's type.
+func (msg MsgDisableMsgs) Type() string { return TypeMsgDisableMsgs }
+
+// ValidateBasic performs basic (non-state-dependant) validation on a MsgDisableMsgs.
+func (msg MsgDisableMsgs) ValidateBasic() error {
+	if msg.Authority.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing authority address")
+	}
+	if len(msg.Msgs) == 0 {
+		return sdkerrors.Wrap(ErrInvalidMsgEntry, "no messages to disable")
+	}
+
+	return ValidateMsgEntries(msg.Msgs)
+}
+
+// GetSignBytes returns the raw bytes a signer is expected to sign when submitting
+// a MsgDisableMsgs message.
+func (msg MsgDisableMsgs) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners returns the single expected signer for a MsgDisableMsgs.
+func (msg MsgDisableMsgs) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Authority}
+}
+
+// NewMsgEnableMsgs creates a new MsgEnableMsgs.
+func NewMsgEnableMsgs(authority sdk.AccAddress, msgs []string) *MsgEnableMsgs {
+	return &MsgEnableMsgs{
+		Authority: authority,
+		Msgs:      msgs,
+	}
+}
+
+// Route returns the MsgEnableMsgs's route.
+func (msg MsgEnableMsgs) Route() string { return RouterKey }
+
+// Type returns the MsgEnableMsgs's type.
+func (msg MsgEnableMsgs) Type() string { return TypeMsgEnableMsgs }
+
+// ValidateBasic performs basic (non-state-dependant) validation on a MsgEnableMsgs.
+func (msg MsgEnableMsgs) ValidateBasic() error {
+	if msg.Authority.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing authority address")
+	}
+	if len(msg.Msgs) == 0 {
+		return sdkerrors.Wrap(ErrInvalidMsgEntry, "no messages to enable")
+	}
+
+	return ValidateMsgEntries(msg.Msgs)
+}
+
+// GetSignBytes returns the raw bytes a signer is expected to sign when submitting
+// a MsgEnableMsgs message.
+func (msg MsgEnableMsgs) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners returns the single expected signer for a MsgEnableMsgs.
+func (msg MsgEnableMsgs) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Authority}
+}
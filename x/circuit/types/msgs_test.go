@@ -0,0 +1,60 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+func TestValidateMsgEntries(t *testing.T) {
+	cases := map[string]struct {
+		entries []string
+		valid   bool
+	}{
+		"type URL":            {[]string{"/cosmos.bank.MsgSend"}, true},
+		"route":               {[]string{"bank"}, true},
+		"type URL and route":  {[]string{"/cosmos.bank.MsgSend", "bank"}, true},
+		"empty":               {[]string{""}, false},
+		"slash only":          {[]string{"/"}, false},
+		"invalid route":       {[]string{"ibc-transfer"}, false},
+		"invalid type URL":    {[]string{"/cosmos.bank MsgSend"}, false},
+		"duplicate":           {[]string{"bank", "staking", "bank"}, false},
+		"circuit route":       {[]string{types.RouterKey}, false},
+		"circuit msg":         {[]string{"/cosmos.circuit.MsgDisableMsgs"}, false},
+		"circuit enable msgs": {[]string{"/cosmos.circuit.MsgEnableMsgs"}, false},
+		"gov route":           {[]string{"gov"}, false},
+		"gov submit proposal": {[]string{"/cosmos.gov.MsgSubmitProposal"}, false},
+		"gov vote":            {[]string{"/cosmos.gov.MsgVote"}, false},
+		"gov deposit":         {[]string{"/cosmos.gov.MsgDeposit"}, false},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+
+		t.Run(name, func(t *testing.T) {
+			err := types.ValidateMsgEntries(tc.entries)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgDisableMsgsValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress("authority")
+
+	require.NoError(t, types.NewMsgDisableMsgs(addr, []string{"bank"}).ValidateBasic())
+	require.Error(t, types.NewMsgDisableMsgs(nil, []string{"bank"}).ValidateBasic())
+	require.Error(t, types.NewMsgDisableMsgs(addr, nil).ValidateBasic())
+	require.Error(t, types.NewMsgDisableMsgs(addr, []string{"circuit"}).ValidateBasic())
+	require.Error(t, types.NewMsgDisableMsgs(addr, []string{"gov"}).ValidateBasic())
+
+	require.NoError(t, types.NewMsgEnableMsgs(addr, []string{"bank"}).ValidateBasic())
+	require.Error(t, types.NewMsgEnableMsgs(nil, []string{"bank"}).ValidateBasic())
+	require.Error(t, types.NewMsgEnableMsgs(addr, nil).ValidateBasic())
+}
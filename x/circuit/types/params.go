@@ -0,0 +1,81 @@
+package types
+
+import (
+	"fmt"
+
+	yaml "gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter keys
+var (
+	KeyAuthorities = []byte("Authorities")
+)
+
+var _ paramtypes.ParamSet = &Params{}
+
+// NewParams creates a new Params object
+func NewParams(authorities []sdk.AccAddress) Params {
+	return Params{
+		Authorities: authorities,
+	}
+}
+
+// DefaultParams returns the default circuit parameters, without any authority.
+func DefaultParams() Params {
+	return Params{
+		Authorities: []sdk.AccAddress{},
+	}
+}
+
+// ParamKeyTable for circuit module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
+// pairs of circuit module's parameters.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyAuthorities, &p.Authorities, validateAuthorities),
+	}
+}
+
+// IsAuthority returns true if the given address is one of the authorities.
+func (p Params) IsAuthority(addr sdk.AccAddress) bool {
+	for _, authority := range p.Authorities {
+		if authority.Equals(addr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// String implements the stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// Validate checks that the parameters have valid values.
+func (p Params) Validate() error {
+	return validateAuthorities(p.Authorities)
+}
+
+func validateAuthorities(i interface{}) error {
+	v, ok := i.([]sdk.AccAddress)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for _, authority := range v {
+		if authority.Empty() {
+			return fmt.Errorf("empty authority address")
+		}
+	}
+
+	return nil
+}
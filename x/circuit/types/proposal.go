@@ -0,0 +1,83 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gov "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	ProposalTypeDisableMsgs string = "DisableMsgs"
+	ProposalTypeEnableMsgs  string = "EnableMsgs"
+)
+
+func init() {
+	gov.RegisterProposalType(ProposalTypeDisableMsgs)
+	gov.RegisterProposalTypeCodec(&DisableMsgsProposal{}, "cosmos-sdk/DisableMsgsProposal")
+	gov.RegisterProposalType(ProposalTypeEnableMsgs)
+	gov.RegisterProposalTypeCodec(&EnableMsgsProposal{}, "cosmos-sdk/EnableMsgsProposal")
+}
+
+// NewDisableMsgsProposal creates a proposal disabling the messages with the
+// given type URLs or routes.
+func NewDisableMsgsProposal(title, description string, msgs []string) gov.Content {
+	return &DisableMsgsProposal{title, description, msgs}
+}
+
+// Implements Proposal Interface
+var _ gov.Content = &DisableMsgsProposal{}
+
+func (p *DisableMsgsProposal) GetTitle() string       { return p.Title }
+func (p *DisableMsgsProposal) GetDescription() string { return p.Description }
+func (p *DisableMsgsProposal) ProposalRoute() string  { return RouterKey }
+func (p *DisableMsgsProposal) ProposalType() string   { return ProposalTypeDisableMsgs }
+func (p *DisableMsgsProposal) ValidateBasic() error {
+	if len(p.Msgs) == 0 {
+		return sdkerrors.Wrap(ErrInvalidMsgEntry, "no messages to disable")
+	}
+	if err := ValidateMsgEntries(p.Msgs); err != nil {
+		return err
+	}
+	return gov.ValidateAbstract(p)
+}
+
+func (p DisableMsgsProposal) String() string {
+	return fmt.Sprintf(`Disable Msgs Proposal:
+  Title:       %s
+  Description: %s
+  Msgs:        %s
+`, p.Title, p.Description, strings.Join(p.Msgs, ", "))
+}
+
+// NewEnableMsgsProposal creates a proposal enabling the disabled messages with
+// the given type URLs or routes.
+func NewEnableMsgsProposal(title, description string, msgs []string) gov.Content {
+	return &EnableMsgsProposal{title, description, msgs}
+}
+
+// Implements Proposal Interface
+var _ gov.Content = &EnableMsgsProposal{}
+
+func (p *EnableMsgsProposal) GetTitle() string       { return p.Title }
+func (p *EnableMsgsProposal) GetDescription() string { return p.Description }
+func (p *EnableMsgsProposal) ProposalRoute() string  { return RouterKey }
+func (p *EnableMsgsProposal) ProposalType() string   { return ProposalTypeEnableMsgs }
+func (p *EnableMsgsProposal) ValidateBasic() error {
+	if len(p.Msgs) == 0 {
+		return sdkerrors.Wrap(ErrInvalidMsgEntry, "no messages to enable")
+	}
+	if err := ValidateMsgEntries(p.Msgs); err != nil {
+		return err
+	}
+	return gov.ValidateAbstract(p)
+}
+
+func (p EnableMsgsProposal) String() string {
+	return fmt.Sprintf(`Enable Msgs Proposal:
+  Title:       %s
+  Description: %s
+  Msgs:        %s
+`, p.Title, p.Description, strings.Join(p.Msgs, ", "))
+}
@@ -0,0 +1,7 @@
+package types
+
+// Querier routes for the circuit module
+const (
+	QueryParams       = "params"
+	QueryDisabledMsgs = "disabled_msgs"
+)
@@ -114,7 +114,11 @@ func (suite *HandlerTestSuite) TestMsgSubmitEvidence() {
 			suite.Require().NotNil(res, "expected non-nil result; tc #%d", i)
 
 			msg := tc.msg.(exported.MsgSubmitEvidence)
-			suite.Require().Equal(msg.GetEvidence().Hash().Bytes(), res.Data, "invalid hash; tc #%d", i)
+
+			var resData types.MsgSubmitEvidenceResponse
+			err := resData.Unmarshal(res.Data)
+			suite.Require().NoError(err, "unexpected error; tc #%d", i)
+			suite.Require().Equal(msg.GetEvidence().Hash().Bytes(), resData.Hash, "invalid hash; tc #%d", i)
 		}
 	}
 }
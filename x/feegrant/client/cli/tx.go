@@ -0,0 +1,186 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+// flags for feegrant module
+const (
+	FlagExpiration  = "expiration"
+	FlagPeriod      = "period"
+	FlagPeriodLimit = "period-limit"
+)
+
+// NewTxCmd returns a root CLI command handler for all x/feegrant transaction commands.
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Feegrant transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewCmdFeeGrant(),
+		NewCmdRevokeFeegrant(),
+	)
+
+	return txCmd
+}
+
+// NewCmdFeeGrant returns a CLI command handler for creating a MsgGrantFeeAllowance transaction.
+func NewCmdFeeGrant() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant [granter_key_or_address] [grantee] [spend_limit]",
+		Short: "Grant Fee allowance to an address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Grant authorization to pay fees from your address. Note, the '--from' flag is
+ignored as it is implied from [granter_key_or_address].
+
+Examples:
+  $ %s tx %s grant cosmos1skjw... cosmos1skjw... 100stake --expiration 2021-01-01T00:00:00Z
+  $ %s tx %s grant cosmos1skjw... cosmos1skjw... 100stake --period 3600 --period-limit 10stake
+`,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			spendLimit, err := sdk.ParseCoins(args[2])
+			if err != nil {
+				return err
+			}
+
+			basic := types.BasicFeeAllowance{
+				SpendLimit: spendLimit,
+			}
+
+			expStr, err := cmd.Flags().GetString(FlagExpiration)
+			if err != nil {
+				return err
+			}
+
+			if expStr != "" {
+				expiration, err := time.Parse(time.RFC3339, expStr)
+				if err != nil {
+					return err
+				}
+
+				basic.Expiration = &expiration
+			}
+
+			var grant types.FeeAllowanceI = &basic
+
+			periodClock, err := cmd.Flags().GetInt64(FlagPeriod)
+			if err != nil {
+				return err
+			}
+
+			periodLimitStr, err := cmd.Flags().GetString(FlagPeriodLimit)
+			if err != nil {
+				return err
+			}
+
+			if periodClock > 0 || periodLimitStr != "" {
+				periodLimit, err := sdk.ParseCoins(periodLimitStr)
+				if err != nil {
+					return err
+				}
+
+				period := time.Duration(periodClock) * time.Second
+				if basic.Expiration != nil && time.Now().Add(period).After(*basic.Expiration) {
+					return fmt.Errorf("period (%s) cannot be longer than the time until expiration", period)
+				}
+
+				grant = types.NewPeriodicFeeAllowance(basic, period, periodLimit, time.Now())
+			}
+
+			msg, err := types.NewMsgGrantFeeAllowance(grant, clientCtx.GetFromAddress(), grantee)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagExpiration, "", "The RFC 3339 timestamp after which the grant expires for the user")
+	cmd.Flags().Int64(FlagPeriod, 0, "Period specifies the time duration in seconds in which period_spend_limit coins can be spent before that allowance is reset")
+	cmd.Flags().String(FlagPeriodLimit, "", "Period limit specifies the maximum number of coins that can be spent in the period")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdRevokeFeegrant returns a CLI command handler for creating a MsgRevokeFeeAllowance transaction.
+func NewCmdRevokeFeegrant() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke [granter_key_or_address] [grantee]",
+		Short: "Revoke a fee grant",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Revoke fee grant from a granter to a grantee. Note, the '--from' flag is
+ignored as it is implied from [granter_key_or_address].
+
+Example:
+  $ %s tx %s revoke cosmos1skjw... cosmos1skjw...
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeFeeAllowance(clientCtx.GetFromAddress(), grantee)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
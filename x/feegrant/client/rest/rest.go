@@ -0,0 +1,16 @@
+package rest
+
+import (
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+// RegisterHandlers registers all x/feegrant transaction and query HTTP REST
+// handlers on the provided mux router.
+func RegisterHandlers(clientCtx client.Context, r *mux.Router) {
+	r.HandleFunc("/feegrant/grants/{grantee}", queryFeeAllowancesHandlerFn(clientCtx)).Methods("GET")
+	r.HandleFunc("/feegrant/grants/{grantee}/{granter}", queryFeeAllowanceHandlerFn(clientCtx)).Methods("GET")
+	r.HandleFunc("/feegrant/grants/{grantee}", NewGrantFeeAllowanceRequestHandlerFn(clientCtx)).Methods("POST")
+	r.HandleFunc("/feegrant/grants/{grantee}/revoke", NewRevokeFeeAllowanceRequestHandlerFn(clientCtx)).Methods("POST")
+}
@@ -0,0 +1,112 @@
+package rest
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+// GrantFeeAllowanceReq defines the properties of a grant fee allowance
+// request's body. If Period is set, a PeriodicFeeAllowance is granted,
+// otherwise a BasicFeeAllowance.
+type GrantFeeAllowanceReq struct {
+	BaseReq     rest.BaseReq `json:"base_req" yaml:"base_req"`
+	SpendLimit  sdk.Coins    `json:"spend_limit" yaml:"spend_limit"`
+	Expiration  *time.Time   `json:"expiration,omitempty" yaml:"expiration,omitempty"`
+	Period      int64        `json:"period,omitempty" yaml:"period,omitempty"`
+	PeriodLimit sdk.Coins    `json:"period_limit,omitempty" yaml:"period_limit,omitempty"`
+}
+
+// RevokeFeeAllowanceReq defines the properties of a revoke fee allowance
+// request's body.
+type RevokeFeeAllowanceReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+}
+
+// NewGrantFeeAllowanceRequestHandlerFn returns an HTTP REST handler for
+// creating a MsgGrantFeeAllowance transaction.
+func NewGrantFeeAllowanceRequestHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		grantee, err := sdk.AccAddressFromBech32(mux.Vars(r)["grantee"])
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		var req GrantFeeAllowanceReq
+		if !rest.ReadRESTReq(w, r, clientCtx.JSONMarshaler, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		granter, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		basic := types.BasicFeeAllowance{
+			SpendLimit: req.SpendLimit,
+			Expiration: req.Expiration,
+		}
+
+		var grant types.FeeAllowanceI = &basic
+		if req.Period > 0 {
+			period := time.Duration(req.Period) * time.Second
+			grant = types.NewPeriodicFeeAllowance(basic, period, req.PeriodLimit, time.Now())
+		}
+
+		msg, err := types.NewMsgGrantFeeAllowance(grant, granter, grantee)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+// NewRevokeFeeAllowanceRequestHandlerFn returns an HTTP REST handler for
+// creating a MsgRevokeFeeAllowance transaction.
+func NewRevokeFeeAllowanceRequestHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		grantee, err := sdk.AccAddressFromBech32(mux.Vars(r)["grantee"])
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		var req RevokeFeeAllowanceReq
+		if !rest.ReadRESTReq(w, r, clientCtx.JSONMarshaler, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		granter, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		msg := types.NewMsgRevokeFeeAllowance(granter, grantee)
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
@@ -0,0 +1,38 @@
+package feegrant
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+// InitGenesis initializes the feegrant module's state from a provided genesis
+// state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
+	if err := gs.Validate(); err != nil {
+		panic(fmt.Sprintf("failed to validate %s genesis state: %s", types.ModuleName, err))
+	}
+
+	for _, f := range gs.FeeAllowances {
+		if err := k.GrantFeeAllowance(ctx, f.Granter, f.Grantee, f.GetFeeGrant()); err != nil {
+			panic(fmt.Sprintf("failed to grant fee allowance from %s to %s: %s", f.Granter, f.Grantee, err))
+		}
+	}
+}
+
+// ExportGenesis returns the feegrant module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
+	grants := []types.FeeAllowanceGrant{}
+
+	err := k.IterateAllFeeAllowances(ctx, func(grant types.FeeAllowanceGrant) bool {
+		grants = append(grants, grant)
+		return false
+	})
+	if err != nil {
+		panic(fmt.Sprintf("failed to export %s genesis state: %s", types.ModuleName, err))
+	}
+
+	return types.NewGenesisState(grants)
+}
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// FeeAllowance implements the Query/FeeAllowance gRPC method
+func (k Keeper) FeeAllowance(c context.Context, req *types.QueryFeeAllowanceRequest) (*types.QueryFeeAllowanceResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Granter.Empty() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid granter address")
+	}
+
+	if req.Grantee.Empty() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid grantee address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	grant, found, err := k.GetFeeGrant(ctx, req.Granter, req.Grantee)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no fee allowance from %s to %s", req.Granter, req.Grantee)
+	}
+
+	return &types.QueryFeeAllowanceResponse{FeeAllowance: &grant}, nil
+}
+
+// FeeAllowances implements the Query/FeeAllowances gRPC method
+func (k Keeper) FeeAllowances(c context.Context, req *types.QueryFeeAllowancesRequest) (*types.QueryFeeAllowancesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Grantee.Empty() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid grantee address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var grants []*types.FeeAllowanceGrant
+	store := ctx.KVStore(k.storeKey)
+	grantsStore := prefix.NewStore(store, types.FeeAllowancePrefixByGrantee(req.Grantee))
+
+	res, err := query.Paginate(grantsStore, req.Req, func(key []byte, value []byte) error {
+		grant, err := k.unmarshalFeeAllowanceGrant(value)
+		if err != nil {
+			return err
+		}
+
+		grants = append(grants, &grant)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFeeAllowancesResponse{FeeAllowances: grants, Res: res}, nil
+}
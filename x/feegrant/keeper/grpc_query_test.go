@@ -0,0 +1,143 @@
+package keeper_test
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+func (suite *KeeperTestSuite) TestFeeAllowance() {
+	var req *types.QueryFeeAllowanceRequest
+	allowance := &types.BasicFeeAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 555))}
+
+	testCases := []struct {
+		msg       string
+		malleate  func()
+		expPass   bool
+		posttests func(res *types.QueryFeeAllowanceResponse)
+	}{
+		{
+			"empty request",
+			func() {
+				req = &types.QueryFeeAllowanceRequest{}
+			},
+			false,
+			func(res *types.QueryFeeAllowanceResponse) {},
+		},
+		{
+			"no grant",
+			func() {
+				req = types.NewQueryFeeAllowanceRequest(suite.addrs[0], suite.addrs[1])
+			},
+			false,
+			func(res *types.QueryFeeAllowanceResponse) {},
+		},
+		{
+			"success",
+			func() {
+				err := suite.app.FeeGrantKeeper.GrantFeeAllowance(suite.ctx, suite.addrs[0], suite.addrs[1], allowance)
+				suite.Require().NoError(err)
+				req = types.NewQueryFeeAllowanceRequest(suite.addrs[0], suite.addrs[1])
+			},
+			true,
+			func(res *types.QueryFeeAllowanceResponse) {
+				suite.Require().Equal(suite.addrs[0], res.FeeAllowance.Granter)
+				suite.Require().Equal(suite.addrs[1], res.FeeAllowance.Grantee)
+
+				var feeAllowance types.FeeAllowanceI
+				err := suite.app.InterfaceRegistry().UnpackAny(res.FeeAllowance.Allowance, &feeAllowance)
+				suite.Require().NoError(err)
+				suite.Require().Equal(allowance, feeAllowance)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			res, err := suite.queryClient.FeeAllowance(context.Background(), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+
+			tc.posttests(res)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestFeeAllowances() {
+	var req *types.QueryFeeAllowancesRequest
+
+	testCases := []struct {
+		msg       string
+		malleate  func()
+		expPass   bool
+		posttests func(res *types.QueryFeeAllowancesResponse)
+	}{
+		{
+			"empty request",
+			func() {
+				req = &types.QueryFeeAllowancesRequest{}
+			},
+			false,
+			func(res *types.QueryFeeAllowancesResponse) {},
+		},
+		{
+			"no grants",
+			func() {
+				req = types.NewQueryFeeAllowancesRequest(suite.addrs[1], &query.PageRequest{})
+			},
+			true,
+			func(res *types.QueryFeeAllowancesResponse) {
+				suite.Require().Empty(res.FeeAllowances)
+			},
+		},
+		{
+			"success with pagination",
+			func() {
+				allowance := &types.BasicFeeAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 555))}
+				for _, granter := range []sdk.AccAddress{suite.addrs[0], suite.addrs[2], suite.addrs[3]} {
+					err := suite.app.FeeGrantKeeper.GrantFeeAllowance(suite.ctx, granter, suite.addrs[1], allowance)
+					suite.Require().NoError(err)
+				}
+				req = types.NewQueryFeeAllowancesRequest(suite.addrs[1], &query.PageRequest{Limit: 2, CountTotal: true})
+			},
+			true,
+			func(res *types.QueryFeeAllowancesResponse) {
+				suite.Require().Len(res.FeeAllowances, 2)
+				suite.Require().Equal(uint64(3), res.Res.Total)
+				for _, grant := range res.FeeAllowances {
+					suite.Require().Equal(suite.addrs[1], grant.Grantee)
+				}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			res, err := suite.queryClient.FeeAllowances(context.Background(), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+
+			tc.posttests(res)
+		})
+	}
+}
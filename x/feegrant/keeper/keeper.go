@@ -0,0 +1,250 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+// Keeper manages state of all fee grants, as well as calculating approval.
+// It must have a codec with all available allowances registered.
+type Keeper struct {
+	cdc        codec.Marshaler
+	storeKey   sdk.StoreKey
+	authKeeper types.AccountKeeper
+}
+
+// NewKeeper creates a fee grant Keeper
+func NewKeeper(cdc codec.Marshaler, storeKey sdk.StoreKey, ak types.AccountKeeper) Keeper {
+	return Keeper{
+		cdc:        cdc,
+		storeKey:   storeKey,
+		authKeeper: ak,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GrantFeeAllowance creates a new grant, replacing any existing grant from
+// the granter to the grantee. If the grantee account does not exist yet it is
+// created, so that it can sign transactions paid for by the granter.
+func (k Keeper) GrantFeeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress, feeAllowance types.FeeAllowanceI) error {
+	// create the account if it is not in account state
+	granteeAcc := k.authKeeper.GetAccount(ctx, grantee)
+	if granteeAcc == nil {
+		granteeAcc = k.authKeeper.NewAccountWithAddress(ctx, grantee)
+		k.authKeeper.SetAccount(ctx, granteeAcc)
+	}
+
+	grant, err := types.NewFeeAllowanceGrant(granter, grantee, feeAllowance)
+	if err != nil {
+		return err
+	}
+
+	if err := k.setFeeAllowanceGrant(ctx, grant); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetFeeGrant,
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+		),
+	)
+
+	return nil
+}
+
+// RevokeFeeAllowance removes an existing grant. It returns ErrNoAllowance if
+// there is no grant from the granter to the grantee.
+func (k Keeper) RevokeFeeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.FeeAllowanceKey(granter, grantee)
+
+	if !store.Has(key) {
+		return sdkerrors.Wrapf(types.ErrNoAllowance, "grant from %s to %s", granter, grantee)
+	}
+
+	store.Delete(key)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRevokeFeeGrant,
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+		),
+	)
+
+	return nil
+}
+
+// GetFeeAllowance returns the allowance between the granter and grantee.
+// If there is none, it returns nil, nil.
+// Returns an error on parsing issues
+func (k Keeper) GetFeeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) (types.FeeAllowanceI, error) {
+	grant, found, err := k.GetFeeGrant(ctx, granter, grantee)
+	if err != nil || !found {
+		return nil, err
+	}
+
+	return grant.GetFeeGrant(), nil
+}
+
+// GetFeeGrant returns the entire FeeAllowanceGrant between both accounts, and
+// whether it was found.
+func (k Keeper) GetFeeGrant(ctx sdk.Context, granter, grantee sdk.AccAddress) (types.FeeAllowanceGrant, bool, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.FeeAllowanceKey(granter, grantee))
+	if len(bz) == 0 {
+		return types.FeeAllowanceGrant{}, false, nil
+	}
+
+	grant, err := k.unmarshalFeeAllowanceGrant(bz)
+	if err != nil {
+		return types.FeeAllowanceGrant{}, false, err
+	}
+
+	return grant, true, nil
+}
+
+// IterateAllGranteeFeeAllowances iterates over all the grants from anyone to
+// the given grantee. Callback to get all data, returns true to stop, false to
+// keep reading.
+func (k Keeper) IterateAllGranteeFeeAllowances(
+	ctx sdk.Context, grantee sdk.AccAddress, cb func(types.FeeAllowanceGrant) bool,
+) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FeeAllowancePrefixByGrantee(grantee))
+	return k.iterateFeeAllowances(store, cb)
+}
+
+// IterateAllFeeAllowances iterates over all the grants in the store.
+// Callback to get all data, returns true to stop, false to keep reading.
+// Calling this without pagination is very expensive and only designed for
+// export genesis.
+func (k Keeper) IterateAllFeeAllowances(ctx sdk.Context, cb func(types.FeeAllowanceGrant) bool) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FeeAllowanceKeyPrefix)
+	return k.iterateFeeAllowances(store, cb)
+}
+
+// UseGrantedFees will try to pay the given fee from the granter's account as
+// requested by the grantee. It returns an error if the grant does not exist or
+// does not allow the fee. The updated allowance is saved, or removed if it is
+// used up or expired.
+func (k Keeper) UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins) error {
+	grant, found, err := k.GetFeeGrant(ctx, granter, grantee)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return sdkerrors.Wrapf(types.ErrNoAllowance, "grant from %s to %s", granter, grantee)
+	}
+
+	allowance := grant.GetFeeGrant()
+	if allowance == nil {
+		return sdkerrors.Wrapf(types.ErrNoAllowance, "grant from %s to %s", granter, grantee)
+	}
+
+	remove, err := allowance.Accept(fee, ctx.BlockTime())
+	if err == nil {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeUseFeeGrant,
+				sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+				sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+			),
+		)
+	}
+
+	if remove {
+		// Ignoring the error since we know the grant exists
+		_ = k.RevokeFeeAllowance(ctx, granter, grantee)
+		// note this returns nil if err == nil
+		return sdkerrors.Wrap(err, "removed grant")
+	}
+
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid grant")
+	}
+
+	// if we accepted, store the updated state of the allowance
+	grant, err = types.NewFeeAllowanceGrant(granter, grantee, allowance)
+	if err != nil {
+		return err
+	}
+
+	return k.setFeeAllowanceGrant(ctx, grant)
+}
+
+// RestoreGrantedFees gives back to the allowance of the grantee a part of a
+// fee paid with UseGrantedFees, which was refunded to the granter. Nothing is
+// restored if the grant was removed, e.g. because the fee used it up.
+func (k Keeper) RestoreGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins) error {
+	grant, found, err := k.GetFeeGrant(ctx, granter, grantee)
+	if err != nil || !found {
+		return err
+	}
+
+	allowance := grant.GetFeeGrant()
+	if allowance == nil {
+		return nil
+	}
+
+	allowance.Restore(fee)
+
+	grant, err = types.NewFeeAllowanceGrant(granter, grantee, allowance)
+	if err != nil {
+		return err
+	}
+
+	return k.setFeeAllowanceGrant(ctx, grant)
+}
+
+func (k Keeper) setFeeAllowanceGrant(ctx sdk.Context, grant types.FeeAllowanceGrant) error {
+	bz, err := k.cdc.MarshalBinaryBare(&grant)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.FeeAllowanceKey(grant.Granter, grant.Grantee), bz)
+
+	return nil
+}
+
+func (k Keeper) unmarshalFeeAllowanceGrant(bz []byte) (types.FeeAllowanceGrant, error) {
+	var grant types.FeeAllowanceGrant
+	if err := k.cdc.UnmarshalBinaryBare(bz, &grant); err != nil {
+		return types.FeeAllowanceGrant{}, err
+	}
+
+	return grant, nil
+}
+
+func (k Keeper) iterateFeeAllowances(store sdk.KVStore, cb func(types.FeeAllowanceGrant) bool) error {
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		grant, err := k.unmarshalFeeAllowanceGrant(iter.Value())
+		if err != nil {
+			return err
+		}
+
+		if cb(grant) {
+			break
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,302 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app     *simapp.SimApp
+	ctx     sdk.Context
+	querier sdk.Querier
+	addrs   []sdk.AccAddress
+
+	queryClient types.QueryClient
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Time: time.Now().UTC()})
+
+	suite.app = app
+	suite.ctx = ctx
+	suite.querier = keeper.NewQuerier(app.FeeGrantKeeper)
+	suite.addrs = simapp.AddTestAddrsIncremental(app, ctx, 4, sdk.NewInt(30000000))
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.FeeGrantKeeper)
+	suite.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) TestKeeperCrud() {
+	ctx := suite.ctx
+	k := suite.app.FeeGrantKeeper
+
+	// some helpers
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 123))
+	exp := ctx.BlockTime().AddDate(1, 0, 0)
+
+	basic := &types.BasicFeeAllowance{SpendLimit: atom, Expiration: &exp}
+	basic2 := &types.BasicFeeAllowance{SpendLimit: eth, Expiration: &exp}
+
+	// let's set up some initial state here
+	suite.Require().NoError(k.GrantFeeAllowance(ctx, suite.addrs[0], suite.addrs[1], basic))
+	suite.Require().NoError(k.GrantFeeAllowance(ctx, suite.addrs[0], suite.addrs[2], basic2))
+	suite.Require().NoError(k.GrantFeeAllowance(ctx, suite.addrs[1], suite.addrs[2], basic))
+	suite.Require().NoError(k.GrantFeeAllowance(ctx, suite.addrs[1], suite.addrs[3], basic))
+	suite.Require().NoError(k.GrantFeeAllowance(ctx, suite.addrs[3], suite.addrs[2], basic2))
+
+	// remove some, overwrite other
+	suite.Require().NoError(k.RevokeFeeAllowance(ctx, suite.addrs[0], suite.addrs[1]))
+	suite.Require().NoError(k.RevokeFeeAllowance(ctx, suite.addrs[0], suite.addrs[2]))
+	suite.Require().NoError(k.GrantFeeAllowance(ctx, suite.addrs[0], suite.addrs[2], basic))
+	suite.Require().NoError(k.GrantFeeAllowance(ctx, suite.addrs[1], suite.addrs[2], basic2))
+
+	// revoking a missing grant fails
+	suite.Require().Error(k.RevokeFeeAllowance(ctx, suite.addrs[0], suite.addrs[1]))
+
+	// end state:
+	// addr -> addr3 (basic)
+	// addr2 -> addr3 (basic2), addr4(basic)
+	// addr4 -> addr3 (basic2)
+
+	// then lots of queries
+	cases := map[string]struct {
+		grantee   sdk.AccAddress
+		granter   sdk.AccAddress
+		allowance types.FeeAllowanceI
+	}{
+		"addr revoked": {
+			granter: suite.addrs[0],
+			grantee: suite.addrs[1],
+		},
+		"addr revoked and added": {
+			granter:   suite.addrs[0],
+			grantee:   suite.addrs[2],
+			allowance: basic,
+		},
+		"addr never there": {
+			granter: suite.addrs[0],
+			grantee: suite.addrs[3],
+		},
+		"addr modified": {
+			granter:   suite.addrs[1],
+			grantee:   suite.addrs[2],
+			allowance: basic2,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		suite.Run(name, func() {
+			allow, err := k.GetFeeAllowance(ctx, tc.granter, tc.grantee)
+			suite.Require().NoError(err)
+			if tc.allowance == nil {
+				suite.Require().Nil(allow)
+				return
+			}
+			suite.Require().NotNil(allow)
+			suite.Require().Equal(tc.allowance, allow)
+		})
+	}
+
+	grantCases := map[string]struct {
+		grantee sdk.AccAddress
+		grants  []types.FeeAllowanceGrant
+	}{
+		"addr2 has none": {
+			grantee: suite.addrs[1],
+		},
+		"addr has one": {
+			grantee: suite.addrs[3],
+			grants:  []types.FeeAllowanceGrant{mustGrant(suite.addrs[1], suite.addrs[3], basic)},
+		},
+		"addr3 has three": {
+			grantee: suite.addrs[2],
+			grants: []types.FeeAllowanceGrant{
+				mustGrant(suite.addrs[0], suite.addrs[2], basic),
+				mustGrant(suite.addrs[1], suite.addrs[2], basic2),
+				mustGrant(suite.addrs[3], suite.addrs[2], basic2),
+			},
+		},
+	}
+
+	for name, tc := range grantCases {
+		tc := tc
+		suite.Run(name, func() {
+			var grants []types.FeeAllowanceGrant
+			err := k.IterateAllGranteeFeeAllowances(ctx, tc.grantee, func(grant types.FeeAllowanceGrant) bool {
+				grants = append(grants, grant)
+				return false
+			})
+			suite.Require().NoError(err)
+			suite.Require().Len(grants, len(tc.grants))
+			for i, grant := range grants {
+				suite.Require().Equal(tc.grants[i].Granter, grant.Granter)
+				suite.Require().Equal(tc.grants[i].Grantee, grant.Grantee)
+				suite.Require().Equal(tc.grants[i].GetFeeGrant(), grant.GetFeeGrant())
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGrantCreatesGranteeAccount() {
+	ctx := suite.ctx
+	k := suite.app.FeeGrantKeeper
+
+	grantee := sdk.AccAddress([]byte("fresh-grantee-addr--"))
+	suite.Require().Nil(suite.app.AccountKeeper.GetAccount(ctx, grantee))
+
+	allowance := &types.BasicFeeAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 10))}
+	suite.Require().NoError(k.GrantFeeAllowance(ctx, suite.addrs[0], grantee, allowance))
+
+	suite.Require().NotNil(suite.app.AccountKeeper.GetAccount(ctx, grantee))
+}
+
+func (suite *KeeperTestSuite) TestUseGrantedFee() {
+	ctx := suite.ctx
+	k := suite.app.FeeGrantKeeper
+
+	// some helpers
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 123))
+	blockTime := ctx.BlockTime()
+	oneYear := blockTime.AddDate(1, 0, 0)
+	yesterday := blockTime.AddDate(0, 0, -1)
+
+	// for testing limits of the contract
+	hugeAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 9999))
+	smallAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 1))
+	futureAfterSmall := &types.BasicFeeAllowance{
+		SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 554)),
+		Expiration: &oneYear,
+	}
+
+	// then lots of queries
+	cases := map[string]struct {
+		grantee sdk.AccAddress
+		granter sdk.AccAddress
+		fee     sdk.Coins
+		allowed bool
+		final   types.FeeAllowanceI
+	}{
+		"use entire pot": {
+			granter: suite.addrs[0],
+			grantee: suite.addrs[1],
+			fee:     atom,
+			allowed: true,
+			final:   nil,
+		},
+		"expired and removed": {
+			granter: suite.addrs[0],
+			grantee: suite.addrs[2],
+			fee:     eth,
+			allowed: false,
+			final:   nil,
+		},
+		"too high": {
+			granter: suite.addrs[0],
+			grantee: suite.addrs[1],
+			fee:     hugeAtom,
+			allowed: false,
+			final:   &types.BasicFeeAllowance{SpendLimit: atom, Expiration: &oneYear},
+		},
+		"use a little": {
+			granter: suite.addrs[0],
+			grantee: suite.addrs[1],
+			fee:     smallAtom,
+			allowed: true,
+			final:   futureAfterSmall,
+		},
+		"no grant": {
+			granter: suite.addrs[1],
+			grantee: suite.addrs[0],
+			fee:     smallAtom,
+			allowed: false,
+			final:   nil,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		suite.Run(name, func() {
+			// let's set up some initial state here
+			// addr -> addr2 (future)
+			// addr -> addr3 (expired)
+			err := k.GrantFeeAllowance(ctx, suite.addrs[0], suite.addrs[1], &types.BasicFeeAllowance{
+				SpendLimit: atom, Expiration: &oneYear,
+			})
+			suite.Require().NoError(err)
+			err = k.GrantFeeAllowance(ctx, suite.addrs[0], suite.addrs[2], &types.BasicFeeAllowance{
+				SpendLimit: eth, Expiration: &yesterday,
+			})
+			suite.Require().NoError(err)
+
+			err = k.UseGrantedFees(ctx, tc.granter, tc.grantee, tc.fee)
+			if tc.allowed {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+
+			loaded, err := k.GetFeeAllowance(ctx, tc.granter, tc.grantee)
+			suite.Require().NoError(err)
+			if tc.final == nil {
+				suite.Require().Nil(loaded)
+			} else {
+				suite.Require().Equal(tc.final, loaded)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRestoreGrantedFees() {
+	ctx := suite.ctx
+	k := suite.app.FeeGrantKeeper
+
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+	refund := sdk.NewCoins(sdk.NewInt64Coin("atom", 40))
+
+	suite.Require().NoError(k.GrantFeeAllowance(ctx, suite.addrs[0], suite.addrs[1], &types.BasicFeeAllowance{SpendLimit: atom}))
+	suite.Require().NoError(k.UseGrantedFees(ctx, suite.addrs[0], suite.addrs[1], fee))
+	suite.Require().NoError(k.RestoreGrantedFees(ctx, suite.addrs[0], suite.addrs[1], refund))
+
+	loaded, err := k.GetFeeAllowance(ctx, suite.addrs[0], suite.addrs[1])
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.BasicFeeAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 495))}, loaded)
+
+	// a grant used up by the fee was removed, and isn't restored
+	suite.Require().NoError(k.GrantFeeAllowance(ctx, suite.addrs[0], suite.addrs[2], &types.BasicFeeAllowance{SpendLimit: fee}))
+	suite.Require().NoError(k.UseGrantedFees(ctx, suite.addrs[0], suite.addrs[2], fee))
+	suite.Require().NoError(k.RestoreGrantedFees(ctx, suite.addrs[0], suite.addrs[2], refund))
+
+	loaded, err = k.GetFeeAllowance(ctx, suite.addrs[0], suite.addrs[2])
+	suite.Require().NoError(err)
+	suite.Require().Nil(loaded)
+}
+
+func mustGrant(granter, grantee sdk.AccAddress, allowance types.FeeAllowanceI) types.FeeAllowanceGrant {
+	grant, err := types.NewFeeAllowanceGrant(granter, grantee, allowance)
+	if err != nil {
+		panic(err)
+	}
+
+	return grant
+}
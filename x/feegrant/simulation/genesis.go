@@ -0,0 +1,36 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+// Simulation parameter constants
+const feeAllowances = "fee_allowances"
+
+// GenFeeAllowances returns an empty slice of fee allowance grants.
+func GenFeeAllowances(_ *rand.Rand, _ []simtypes.Account) []types.FeeAllowanceGrant {
+	return []types.FeeAllowanceGrant{}
+}
+
+// RandomizedGenState generates a random GenesisState for feegrant
+func RandomizedGenState(simState *module.SimulationState) {
+	var grants []types.FeeAllowanceGrant
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, feeAllowances, &grants, simState.Rand,
+		func(r *rand.Rand) { grants = GenFeeAllowances(r, simState.Accounts) },
+	)
+
+	feegrantGenesis := types.NewGenesisState(grants)
+
+	fmt.Printf("Selected randomly generated %s parameters:\n%s\n", types.ModuleName, codec.MustMarshalJSONIndent(simState.Cdc, feegrantGenesis))
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(feegrantGenesis)
+}
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ FeeAllowanceI = (*BasicFeeAllowance)(nil)
+
+// NewBasicFeeAllowance creates a new BasicFeeAllowance with the given spend
+// limit and an optional expiration.
+func NewBasicFeeAllowance(spendLimit sdk.Coins, expiration *time.Time) *BasicFeeAllowance {
+	return &BasicFeeAllowance{
+		SpendLimit: spendLimit,
+		Expiration: expiration,
+	}
+}
+
+// Accept can use fee payment requested as well as timestamp of the current block
+// to determine whether or not to process this. This is checked in
+// Keeper.UseGrantedFees and the return values should match how it is handled there.
+//
+// If it returns an error, the fee payment is rejected, otherwise it is accepted.
+// The FeeAllowance implementation is expected to update its internal state
+// and will be saved again after an acceptance.
+//
+// If remove is true (regardless of the error), the FeeAllowance will be deleted from storage
+// (eg. when it is used up). (See call to RevokeFeeAllowance in Keeper.UseGrantedFees)
+func (a *BasicFeeAllowance) Accept(fee sdk.Coins, blockTime time.Time) (bool, error) {
+	if a.Expiration != nil && !blockTime.Before(*a.Expiration) {
+		return true, sdkerrors.Wrap(ErrFeeLimitExpired, "basic allowance")
+	}
+
+	if a.SpendLimit != nil {
+		left, invalid := a.SpendLimit.SafeSub(fee)
+		if invalid {
+			return false, sdkerrors.Wrap(ErrFeeLimitExceeded, "basic allowance")
+		}
+
+		a.SpendLimit = left
+		return left.IsZero(), nil
+	}
+
+	return false, nil
+}
+
+// Restore implements FeeAllowanceI. It adds the fee back to the spend limit,
+// if any.
+func (a *BasicFeeAllowance) Restore(fee sdk.Coins) {
+	if a.SpendLimit != nil {
+		a.SpendLimit = a.SpendLimit.Add(fee...)
+	}
+}
+
+// ValidateBasic implements FeeAllowanceI. It returns an error if the spend
+// limit is invalid or not positive.
+func (a BasicFeeAllowance) ValidateBasic() error {
+	if a.SpendLimit != nil {
+		if !a.SpendLimit.IsValid() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "send amount is invalid: %s", a.SpendLimit)
+		}
+		if !a.SpendLimit.IsAllPositive() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "spend limit must be positive")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,147 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+func TestBasicFeeValidAllow(t *testing.T) {
+	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 10))
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	smallAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 43))
+	bigAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 1000))
+	leftAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 512))
+
+	now := time.Now().UTC()
+	oneHour := now.Add(1 * time.Hour)
+
+	cases := map[string]struct {
+		allow *types.BasicFeeAllowance
+		// all other checks are ignored if valid=false
+		valid     bool
+		fee       sdk.Coins
+		blockTime time.Time
+		accept    bool
+		remove    bool
+		remains   sdk.Coins
+	}{
+		"empty": {
+			allow:  &types.BasicFeeAllowance{},
+			valid:  true,
+			fee:    atom,
+			accept: true,
+		},
+		"small fee without expire": {
+			allow: &types.BasicFeeAllowance{
+				SpendLimit: atom,
+			},
+			valid:   true,
+			fee:     smallAtom,
+			accept:  true,
+			remove:  false,
+			remains: leftAtom,
+		},
+		"all fee without expire": {
+			allow: &types.BasicFeeAllowance{
+				SpendLimit: smallAtom,
+			},
+			valid:  true,
+			fee:    smallAtom,
+			accept: true,
+			remove: true,
+		},
+		"wrong fee": {
+			allow: &types.BasicFeeAllowance{
+				SpendLimit: smallAtom,
+			},
+			valid:  true,
+			fee:    eth,
+			accept: false,
+		},
+		"non-expired": {
+			allow: &types.BasicFeeAllowance{
+				SpendLimit: atom,
+				Expiration: &oneHour,
+			},
+			valid:     true,
+			fee:       smallAtom,
+			blockTime: now,
+			accept:    true,
+			remove:    false,
+			remains:   leftAtom,
+		},
+		"expired": {
+			allow: &types.BasicFeeAllowance{
+				SpendLimit: atom,
+				Expiration: &now,
+			},
+			valid:     true,
+			fee:       smallAtom,
+			blockTime: oneHour,
+			accept:    false,
+			remove:    true,
+		},
+		"fee more than allowed": {
+			allow: &types.BasicFeeAllowance{
+				SpendLimit: atom,
+				Expiration: &oneHour,
+			},
+			valid:     true,
+			fee:       bigAtom,
+			blockTime: now,
+			accept:    false,
+		},
+		"zero spend limit": {
+			allow: &types.BasicFeeAllowance{
+				SpendLimit: sdk.Coins{sdk.NewInt64Coin("atom", 0)},
+			},
+			valid: false,
+		},
+	}
+
+	for name, stc := range cases {
+		tc := stc // to make scopelint happy
+		t.Run(name, func(t *testing.T) {
+			err := tc.allow.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			// now try to deduct
+			remove, err := tc.allow.Accept(tc.fee, tc.blockTime)
+			if !tc.accept {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, tc.remove, remove)
+			if !remove {
+				assert.Equal(t, tc.allow.SpendLimit, tc.remains)
+			}
+		})
+	}
+}
+
+func TestBasicFeeRestore(t *testing.T) {
+	allow := types.NewBasicFeeAllowance(sdk.NewCoins(sdk.NewInt64Coin("atom", 555)), nil)
+
+	_, err := allow.Accept(sdk.NewCoins(sdk.NewInt64Coin("atom", 60)), time.Now())
+	require.NoError(t, err)
+
+	allow.Restore(sdk.NewCoins(sdk.NewInt64Coin("atom", 20)))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 515)), allow.SpendLimit)
+
+	// an allowance without spend limit stays unlimited
+	unlimited := types.NewBasicFeeAllowance(nil, nil)
+	unlimited.Restore(sdk.NewCoins(sdk.NewInt64Coin("atom", 20)))
+	require.Nil(t, unlimited.SpendLimit)
+}
@@ -0,0 +1,135 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Message types for the feegrant module
+const (
+	TypeMsgGrantFeeAllowance  = "grant_fee_allowance"
+	TypeMsgRevokeFeeAllowance = "revoke_fee_allowance"
+)
+
+var (
+	_ sdk.Msg                       = &MsgGrantFeeAllowance{}
+	_ sdk.Msg                       = &MsgRevokeFeeAllowance{}
+	_ types.UnpackInterfacesMessage = MsgGrantFeeAllowance{}
+)
+
+// NewMsgGrantFeeAllowance creates a new MsgGrantFeeAllowance.
+func NewMsgGrantFeeAllowance(feeAllowance FeeAllowanceI, granter, grantee sdk.AccAddress) (*MsgGrantFeeAllowance, error) {
+	msg, ok := feeAllowance.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("cannot proto marshal %T", feeAllowance)
+	}
+	any, err := types.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgGrantFeeAllowance{
+		Granter:   granter,
+		Grantee:   grantee,
+		Allowance: any,
+	}, nil
+}
+
+// Route returns the MsgGrantFeeAllowance's route.
+func (msg MsgGrantFeeAllowance) Route() string { return RouterKey }
+
+// Type returns the MsgGrantFeeAllowance's type.
+func (msg MsgGrantFeeAllowance) Type() string { return TypeMsgGrantFeeAllowance }
+
+// ValidateBasic performs basic (non-state-dependant) validation on a MsgGrantFeeAllowance.
+func (msg MsgGrantFeeAllowance) ValidateBasic() error {
+	if msg.Granter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing granter address")
+	}
+	if msg.Grantee.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing grantee address")
+	}
+	if msg.Grantee.Equals(msg.Granter) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "cannot self-grant fee authorization")
+	}
+
+	allowance := msg.GetFeeAllowanceI()
+	if allowance == nil {
+		return sdkerrors.Wrap(ErrNoAllowance, "missing fee allowance")
+	}
+
+	return allowance.ValidateBasic()
+}
+
+// GetSignBytes returns the raw bytes a signer is expected to sign when submitting
+// a MsgGrantFeeAllowance message.
+func (msg MsgGrantFeeAllowance) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners returns the single expected signer for a MsgGrantFeeAllowance.
+func (msg MsgGrantFeeAllowance) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+// GetFeeAllowanceI returns the unpacked fee allowance, or nil if it is missing.
+func (msg MsgGrantFeeAllowance) GetFeeAllowanceI() FeeAllowanceI {
+	if msg.Allowance == nil {
+		return nil
+	}
+
+	allowance, ok := msg.Allowance.GetCachedValue().(FeeAllowanceI)
+	if !ok {
+		return nil
+	}
+
+	return allowance
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgGrantFeeAllowance) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var allowance FeeAllowanceI
+	return unpacker.UnpackAny(msg.Allowance, &allowance)
+}
+
+// NewMsgRevokeFeeAllowance creates a new MsgRevokeFeeAllowance.
+func NewMsgRevokeFeeAllowance(granter sdk.AccAddress, grantee sdk.AccAddress) *MsgRevokeFeeAllowance {
+	return &MsgRevokeFeeAllowance{Granter: granter, Grantee: grantee}
+}
+
+// Route returns the MsgRevokeFeeAllowance's route.
+func (msg MsgRevokeFeeAllowance) Route() string { return RouterKey }
+
+// Type returns the MsgRevokeFeeAllowance's type.
+func (msg MsgRevokeFeeAllowance) Type() string { return TypeMsgRevokeFeeAllowance }
+
+// ValidateBasic performs basic (non-state-dependant) validation on a MsgRevokeFeeAllowance.
+func (msg MsgRevokeFeeAllowance) ValidateBasic() error {
+	if msg.Granter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing granter address")
+	}
+	if msg.Grantee.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing grantee address")
+	}
+	if msg.Grantee.Equals(msg.Granter) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "addresses must be different")
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the raw bytes a signer is expected to sign when submitting
+// a MsgRevokeFeeAllowance message.
+func (msg MsgRevokeFeeAllowance) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners returns the single expected signer for a MsgRevokeFeeAllowance.
+func (msg MsgRevokeFeeAllowance) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
@@ -0,0 +1,78 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+func TestMsgGrantFeeAllowance(t *testing.T) {
+	granter := sdk.AccAddress("granter_____________")
+	grantee := sdk.AccAddress("grantee_____________")
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+
+	cases := map[string]struct {
+		granter sdk.AccAddress
+		grantee sdk.AccAddress
+		limit   sdk.Coins
+		valid   bool
+	}{
+		"valid":            {granter, grantee, atom, true},
+		"no spend limit":   {granter, grantee, nil, true},
+		"missing granter":  {nil, grantee, atom, false},
+		"missing grantee":  {granter, nil, atom, false},
+		"self grant":       {granter, granter, atom, false},
+		"zero spend limit": {granter, grantee, sdk.Coins{sdk.NewInt64Coin("atom", 0)}, false},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			msg, err := types.NewMsgGrantFeeAllowance(&types.BasicFeeAllowance{SpendLimit: tc.limit}, tc.granter, tc.grantee)
+			require.NoError(t, err)
+
+			err = msg.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, []sdk.AccAddress{tc.granter}, msg.GetSigners())
+			require.NotPanics(t, func() { msg.GetSignBytes() })
+		})
+	}
+}
+
+func TestMsgRevokeFeeAllowance(t *testing.T) {
+	granter := sdk.AccAddress("granter_____________")
+	grantee := sdk.AccAddress("grantee_____________")
+
+	cases := map[string]struct {
+		granter sdk.AccAddress
+		grantee sdk.AccAddress
+		valid   bool
+	}{
+		"valid":           {granter, grantee, true},
+		"missing granter": {nil, grantee, false},
+		"missing grantee": {granter, nil, false},
+		"self revoke":     {granter, granter, false},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			msg := types.NewMsgRevokeFeeAllowance(tc.granter, tc.grantee)
+			err := msg.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, []sdk.AccAddress{tc.granter}, msg.GetSigners())
+			require.NotPanics(t, func() { msg.GetSignBytes() })
+		})
+	}
+}
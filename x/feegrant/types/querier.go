@@ -0,0 +1,56 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// Querier routes for the feegrant module
+const (
+	QueryFeeAllowance  = "fee_allowance"
+	QueryFeeAllowances = "fee_allowances"
+)
+
+// NewQueryFeeAllowanceRequest creates a new instance of QueryFeeAllowanceRequest.
+func NewQueryFeeAllowanceRequest(granter, grantee sdk.AccAddress) *QueryFeeAllowanceRequest {
+	return &QueryFeeAllowanceRequest{Granter: granter, Grantee: grantee}
+}
+
+// NewQueryFeeAllowancesRequest creates a new instance of QueryFeeAllowancesRequest.
+func NewQueryFeeAllowancesRequest(grantee sdk.AccAddress, req *query.PageRequest) *QueryFeeAllowancesRequest {
+	return &QueryFeeAllowancesRequest{Grantee: grantee, Req: req}
+}
+
+// QueryFeeAllowancesParams defines the parameters necessary for querying all
+// the fee allowances granted to a grantee.
+type QueryFeeAllowancesParams struct {
+	Grantee sdk.AccAddress `json:"grantee" yaml:"grantee"`
+	Page    int            `json:"page" yaml:"page"`
+	Limit   int            `json:"limit" yaml:"limit"`
+}
+
+// NewQueryFeeAllowancesParams creates a new instance of QueryFeeAllowancesParams.
+func NewQueryFeeAllowancesParams(grantee sdk.AccAddress, page, limit int) QueryFeeAllowancesParams {
+	return QueryFeeAllowancesParams{Grantee: grantee, Page: page, Limit: limit}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *QueryFeeAllowanceResponse) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	if m.FeeAllowance == nil {
+		return nil
+	}
+
+	return m.FeeAllowance.UnpackInterfaces(unpacker)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *QueryFeeAllowancesResponse) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, grant := range m.FeeAllowances {
+		if err := grant.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
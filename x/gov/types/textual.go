@@ -0,0 +1,26 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing/textual"
+)
+
+func init() {
+	textual.RegisterMsgRenderer(&MsgVote{}, renderMsgVote)
+}
+
+// renderMsgVote renders a MsgVote for SIGN_MODE_TEXTUAL.
+func renderMsgVote(msg sdk.Msg) ([]string, error) {
+	m, ok := msg.(*MsgVote)
+	if !ok {
+		return nil, fmt.Errorf("expected %T, got %T", &MsgVote{}, msg)
+	}
+
+	return []string{
+		fmt.Sprintf("Proposal: %d", m.ProposalID),
+		fmt.Sprintf("Voter: %s", m.Voter),
+		fmt.Sprintf("Option: %s", m.Option),
+	}, nil
+}
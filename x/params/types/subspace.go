@@ -26,16 +26,21 @@ type Subspace struct {
 	tkey  sdk.StoreKey // []byte -> bool, stores parameter change
 	name  []byte
 	table KeyTable
+
+	// prefix of the parameters in the stores, i.e. name followed by '/',
+	// never modified so that the Subspace can be used concurrently
+	prefix []byte
 }
 
 // NewSubspace constructs a store with namestore
 func NewSubspace(cdc codec.Marshaler, key sdk.StoreKey, tkey sdk.StoreKey, name string) Subspace {
 	return Subspace{
-		cdc:   cdc,
-		key:   key,
-		tkey:  tkey,
-		name:  []byte(name),
-		table: NewKeyTable(),
+		cdc:    cdc,
+		key:    key,
+		tkey:   tkey,
+		name:   []byte(name),
+		table:  NewKeyTable(),
+		prefix: []byte(name + "/"),
 	}
 }
 
@@ -57,27 +62,17 @@ func (s Subspace) WithKeyTable(table KeyTable) Subspace {
 		s.table.m[k] = v
 	}
 
-	// Allocate additional capacity for Subspace.name
-	// So we don't have to allocate extra space each time appending to the key
-	name := s.name
-	s.name = make([]byte, len(name), len(name)+table.maxKeyLength())
-	copy(s.name, name)
-
 	return s
 }
 
 // Returns a KVStore identical with ctx.KVStore(s.key).Prefix()
 func (s Subspace) kvStore(ctx sdk.Context) sdk.KVStore {
-	// append here is safe, appends within a function won't cause
-	// weird side effects when its singlethreaded
-	return prefix.NewStore(ctx.KVStore(s.key), append(s.name, '/'))
+	return prefix.NewStore(ctx.KVStore(s.key), s.prefix)
 }
 
 // Returns a transient store for modification
 func (s Subspace) transientStore(ctx sdk.Context) sdk.KVStore {
-	// append here is safe, appends within a function won't cause
-	// weird side effects when its singlethreaded
-	return prefix.NewStore(ctx.TransientStore(s.tkey), append(s.name, '/'))
+	return prefix.NewStore(ctx.TransientStore(s.tkey), s.prefix)
 }
 
 // Validate attempts to validate a parameter value by its key. If the key is not
@@ -67,14 +67,3 @@ func (t KeyTable) RegisterParamSet(ps ParamSet) KeyTable {
 	}
 	return t
 }
-
-func (t KeyTable) maxKeyLength() (res int) {
-	for k := range t.m {
-		l := len(k)
-		if l > res {
-			res = l
-		}
-	}
-
-	return
-}
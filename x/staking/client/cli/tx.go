@@ -55,7 +55,12 @@ func NewCreateValidatorCmd(clientCtx client.Context) *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx.WithInput(cmd.InOrStdin())
 
-			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxGenerator(clientCtx.TxGenerator).WithAccountRetriever(clientCtx.AccountRetriever)
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			txf = txf.WithTxGenerator(clientCtx.TxGenerator).WithAccountRetriever(clientCtx.AccountRetriever)
 
 			txf, msg, err := NewBuildCreateValidatorMsg(clientCtx, txf, cmd.Flags())
 			if err != nil {
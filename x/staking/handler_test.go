@@ -7,7 +7,6 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec/testdata"
 
-	gogotypes "github.com/gogo/protobuf/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -117,12 +116,12 @@ func TestValidatorByPowerIndex(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
-	ts := &gogotypes.Timestamp{}
-	types.ModuleCdc.MustUnmarshalBinaryLengthPrefixed(res.Data, ts)
-
-	finishTime, err := gogotypes.TimestampFromProto(ts)
+	var resData types.MsgUndelegateResponse
+	err = resData.Unmarshal(res.Data)
 	require.NoError(t, err)
 
+	finishTime := resData.CompletionTime
+
 	ctx = ctx.WithBlockTime(finishTime)
 	staking.EndBlocker(ctx, app.StakingKeeper)
 	staking.EndBlocker(ctx, app.StakingKeeper)
@@ -263,12 +262,12 @@ func TestLegacyValidatorDelegations(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
-	ts := &gogotypes.Timestamp{}
-	types.ModuleCdc.MustUnmarshalBinaryLengthPrefixed(res.Data, ts)
-
-	finishTime, err := gogotypes.TimestampFromProto(ts)
+	var resData types.MsgUndelegateResponse
+	err = resData.Unmarshal(res.Data)
 	require.NoError(t, err)
 
+	finishTime := resData.CompletionTime
+
 	ctx = ctx.WithBlockTime(finishTime)
 	staking.EndBlocker(ctx, app.StakingKeeper)
 
@@ -508,12 +507,12 @@ func TestIncrementsMsgUnbond(t *testing.T) {
 		require.NoError(t, err)
 		require.NotNil(t, res)
 
-		ts := &gogotypes.Timestamp{}
-		types.ModuleCdc.MustUnmarshalBinaryLengthPrefixed(res.Data, ts)
-
-		finishTime, err := gogotypes.TimestampFromProto(ts)
+		var resData types.MsgUndelegateResponse
+		err = resData.Unmarshal(res.Data)
 		require.NoError(t, err)
 
+		finishTime := resData.CompletionTime
+
 		ctx = ctx.WithBlockTime(finishTime)
 		staking.EndBlocker(ctx, app.StakingKeeper)
 
@@ -626,10 +625,8 @@ func TestMultipleMsgCreateValidator(t *testing.T) {
 		require.NoError(t, err)
 		require.NotNil(t, res)
 
-		ts := &gogotypes.Timestamp{}
-		types.ModuleCdc.MustUnmarshalBinaryLengthPrefixed(res.Data, ts)
-
-		_, err = gogotypes.TimestampFromProto(ts)
+		var resData types.MsgUndelegateResponse
+		err = resData.Unmarshal(res.Data)
 		require.NoError(t, err)
 
 		// adds validator into unbonding queue
@@ -684,12 +681,12 @@ func TestMultipleMsgDelegate(t *testing.T) {
 		require.NoError(t, err)
 		require.NotNil(t, res)
 
-		ts := &gogotypes.Timestamp{}
-		types.ModuleCdc.MustUnmarshalBinaryLengthPrefixed(res.Data, ts)
-
-		finishTime, err := gogotypes.TimestampFromProto(ts)
+		var resData types.MsgUndelegateResponse
+		err = resData.Unmarshal(res.Data)
 		require.NoError(t, err)
 
+		finishTime := resData.CompletionTime
+
 		ctx = ctx.WithBlockTime(finishTime)
 		staking.EndBlocker(ctx, app.StakingKeeper)
 
@@ -723,12 +720,12 @@ func TestJailValidator(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
-	ts := &gogotypes.Timestamp{}
-	types.ModuleCdc.MustUnmarshalBinaryLengthPrefixed(res.Data, ts)
-
-	finishTime, err := gogotypes.TimestampFromProto(ts)
+	var resData types.MsgUndelegateResponse
+	err = resData.Unmarshal(res.Data)
 	require.NoError(t, err)
 
+	finishTime := resData.CompletionTime
+
 	ctx = ctx.WithBlockTime(finishTime)
 	staking.EndBlocker(ctx, app.StakingKeeper)
 
@@ -743,12 +740,12 @@ func TestJailValidator(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
-	ts = &gogotypes.Timestamp{}
-	types.ModuleCdc.MustUnmarshalBinaryLengthPrefixed(res.Data, ts)
-
-	finishTime, err = gogotypes.TimestampFromProto(ts)
+	resData = types.MsgUndelegateResponse{}
+	err = resData.Unmarshal(res.Data)
 	require.NoError(t, err)
 
+	finishTime = resData.CompletionTime
+
 	ctx = ctx.WithBlockTime(finishTime)
 	staking.EndBlocker(ctx, app.StakingKeeper)
 
@@ -791,12 +788,12 @@ func TestValidatorQueue(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
-	ts := &gogotypes.Timestamp{}
-	types.ModuleCdc.MustUnmarshalBinaryLengthPrefixed(res.Data, ts)
-
-	finishTime, err := gogotypes.TimestampFromProto(ts)
+	var resData types.MsgUndelegateResponse
+	err = resData.Unmarshal(res.Data)
 	require.NoError(t, err)
 
+	finishTime := resData.CompletionTime
+
 	ctx = ctx.WithBlockTime(finishTime)
 	staking.EndBlocker(ctx, app.StakingKeeper)
 
@@ -897,12 +894,12 @@ func TestUnbondingFromUnbondingValidator(t *testing.T) {
 	require.NotNil(t, res)
 
 	// change the ctx to Block Time one second before the validator would have unbonded
-	ts := &gogotypes.Timestamp{}
-	types.ModuleCdc.MustUnmarshalBinaryLengthPrefixed(res.Data, ts)
-
-	finishTime, err := gogotypes.TimestampFromProto(ts)
+	var resData types.MsgUndelegateResponse
+	err = resData.Unmarshal(res.Data)
 	require.NoError(t, err)
 
+	finishTime := resData.CompletionTime
+
 	ctx = ctx.WithBlockTime(finishTime.Add(time.Second * -1))
 
 	// unbond the delegator from the validator
@@ -0,0 +1,26 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing/textual"
+)
+
+func init() {
+	textual.RegisterMsgRenderer(&MsgDelegate{}, renderMsgDelegate)
+}
+
+// renderMsgDelegate renders a MsgDelegate for SIGN_MODE_TEXTUAL.
+func renderMsgDelegate(msg sdk.Msg) ([]string, error) {
+	m, ok := msg.(*MsgDelegate)
+	if !ok {
+		return nil, fmt.Errorf("expected %T, got %T", &MsgDelegate{}, msg)
+	}
+
+	return []string{
+		fmt.Sprintf("Delegator: %s", m.DelegatorAddress),
+		fmt.Sprintf("Validator: %s", m.ValidatorAddress),
+		fmt.Sprintf("Amount: %s", m.Amount),
+	}, nil
+}
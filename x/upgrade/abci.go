@@ -11,10 +11,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
-// BeginBlock will check if there is a scheduled plan and if it is ready to be executed.
-// If the current height is in the provided set of heights to skip, it will skip and clear the upgrade plan.
-// If it is ready, it will execute it if the handler is installed, and panic/abort otherwise.
-// If the plan is not ready, it will ensure the handler is not registered too early (and abort otherwise).
+// BeginBlock will check if there are scheduled plans and if they are ready to be executed, in the order of the
+// queue of pending plans.
+// If the current height is in the provided set of heights to skip, it will skip and remove the due upgrade plans.
+// If a plan is ready, it will execute it if the handler is installed, and panic/abort otherwise.
+// If a plan is not ready, it will ensure its handler is not registered too early (and abort otherwise).
 //
 // The purpose is to ensure the binary is switched EXACTLY at the desired block, and to allow
 // a migration to be executed if needed upon this switch (migration defined in the new binary)
@@ -22,20 +23,24 @@ import (
 func BeginBlocker(k keeper.Keeper, ctx sdk.Context, _ abci.RequestBeginBlock) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, telemetry.MetricKeyBeginBlocker)
 
-	plan, found := k.GetUpgradePlan(ctx)
-	if !found {
-		return
+	for _, plan := range k.GetUpgradePlans(ctx) {
+		beginBlockPlan(k, ctx, plan)
 	}
+}
 
+// beginBlockPlan handles a single pending plan for BeginBlocker
+func beginBlockPlan(k keeper.Keeper, ctx sdk.Context, plan types.Plan) {
 	// To make sure clear upgrade is executed at the same block
 	if plan.ShouldExecute(ctx) {
-		// If skip upgrade has been set for current height, we clear the upgrade plan
+		// If skip upgrade has been set for current height, we remove the upgrade plan
 		if k.IsSkipHeight(ctx.BlockHeight()) {
 			skipUpgradeMsg := fmt.Sprintf("UPGRADE \"%s\" SKIPPED at %d: %s", plan.Name, plan.Height, plan.Info)
 			ctx.Logger().Info(skipUpgradeMsg)
 
-			// Clear the upgrade plan at current height
-			k.ClearUpgradePlan(ctx)
+			// Remove the upgrade plan at current height
+			if err := k.CancelUpgradePlan(ctx, plan.Name); err != nil {
+				panic(err)
+			}
 			return
 		}
 
@@ -109,8 +109,8 @@ func TestDoHeightUpgrade(t *testing.T) {
 
 func TestCanOverwriteScheduleUpgrade(t *testing.T) {
 	s := setupTest(10, map[int64]bool{})
-	t.Log("Can overwrite plan")
-	err := s.handler(s.ctx, &types.SoftwareUpgradeProposal{Title: "prop", Plan: types.Plan{Name: "bad_test", Height: s.ctx.BlockHeight() + 10}})
+	t.Log("Can overwrite plan with the same name")
+	err := s.handler(s.ctx, &types.SoftwareUpgradeProposal{Title: "prop", Plan: types.Plan{Name: "test", Height: s.ctx.BlockHeight() + 10}})
 	require.Nil(t, err)
 	err = s.handler(s.ctx, &types.SoftwareUpgradeProposal{Title: "prop", Plan: types.Plan{Name: "test", Height: s.ctx.BlockHeight() + 1}})
 	require.Nil(t, err)
@@ -118,6 +118,69 @@ func TestCanOverwriteScheduleUpgrade(t *testing.T) {
 	VerifyDoUpgrade(t)
 }
 
+func TestQueueUpgradePlans(t *testing.T) {
+	s := setupTest(10, map[int64]bool{})
+	t.Log("Verify plans with different names are queued by height")
+	err := s.handler(s.ctx, &types.SoftwareUpgradeProposal{Title: "prop", Plan: types.Plan{Name: "later", Height: s.ctx.BlockHeight() + 10}})
+	require.NoError(t, err)
+	err = s.handler(s.ctx, &types.SoftwareUpgradeProposal{Title: "prop", Plan: types.Plan{Name: "test", Height: s.ctx.BlockHeight() + 1}})
+	require.NoError(t, err)
+
+	bz, err := s.querier(s.ctx, []string{types.QueryPending}, abci.RequestQuery{})
+	require.NoError(t, err)
+	var res types.QueryPendingPlansResponse
+	require.NoError(t, simapp.MakeEncodingConfig().Marshaler.UnmarshalJSON(bz, &res))
+	require.Equal(t, []types.Plan{
+		{Name: "test", Height: s.ctx.BlockHeight() + 1},
+		{Name: "later", Height: s.ctx.BlockHeight() + 10},
+	}, res.Plans)
+
+	t.Log("Verify the first plan is applied and the later one is kept")
+	newCtx := s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1).WithBlockTime(time.Now())
+	req := abci.RequestBeginBlock{Header: newCtx.BlockHeader()}
+	s.keeper.SetUpgradeHandler("test", func(ctx sdk.Context, plan types.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		return vm, nil
+	})
+	require.NotPanics(t, func() {
+		s.module.BeginBlock(newCtx, req)
+	})
+	VerifyDone(t, newCtx, "test")
+	require.Equal(t, []types.Plan{{Name: "later", Height: s.ctx.BlockHeight() + 10}}, s.keeper.GetUpgradePlans(newCtx))
+
+	t.Log("Verify the later plan halts the chain at its height")
+	laterCtx := s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 10).WithBlockTime(time.Now())
+	require.Panics(t, func() {
+		s.module.BeginBlock(laterCtx, abci.RequestBeginBlock{Header: laterCtx.BlockHeader()})
+	})
+}
+
+func TestCancelUpgradeByName(t *testing.T) {
+	s := setupTest(10, map[int64]bool{})
+	err := s.handler(s.ctx, &types.SoftwareUpgradeProposal{Title: "prop", Plan: types.Plan{Name: "first", Height: s.ctx.BlockHeight() + 5}})
+	require.NoError(t, err)
+	err = s.handler(s.ctx, &types.SoftwareUpgradeProposal{Title: "prop", Plan: types.Plan{Name: "second", Time: time.Now().Add(time.Hour)}})
+	require.NoError(t, err)
+
+	t.Log("Verify cancelling an unknown plan fails")
+	err = s.handler(s.ctx, &types.CancelSoftwareUpgradeProposal{Title: "cancel", Name: "unknown"})
+	require.True(t, errors.Is(err, sdkerrors.ErrNotFound), err)
+	require.Len(t, s.keeper.GetUpgradePlans(s.ctx), 2)
+
+	t.Log("Verify only the named plan is cancelled")
+	err = s.handler(s.ctx, &types.CancelSoftwareUpgradeProposal{Title: "cancel", Name: "first"})
+	require.NoError(t, err)
+	plans := s.keeper.GetUpgradePlans(s.ctx)
+	require.Len(t, plans, 1)
+	require.Equal(t, "second", plans[0].Name)
+
+	t.Log("Verify a proposal without name cancels all the plans")
+	err = s.handler(s.ctx, &types.SoftwareUpgradeProposal{Title: "prop", Plan: types.Plan{Name: "third", Height: s.ctx.BlockHeight() + 5}})
+	require.NoError(t, err)
+	err = s.handler(s.ctx, &types.CancelSoftwareUpgradeProposal{Title: "cancel"})
+	require.NoError(t, err)
+	require.Empty(t, s.keeper.GetUpgradePlans(s.ctx))
+}
+
 func VerifyDoUpgrade(t *testing.T) {
 	t.Log("Verify that a panic happens at the upgrade time/height")
 	newCtx := s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1).WithBlockTime(time.Now())
@@ -128,7 +191,9 @@ func VerifyDoUpgrade(t *testing.T) {
 	})
 
 	t.Log("Verify that the upgrade can be successfully applied with a handler")
-	s.keeper.SetUpgradeHandler("test", func(ctx sdk.Context, plan types.Plan) {})
+	s.keeper.SetUpgradeHandler("test", func(ctx sdk.Context, plan types.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		return vm, nil
+	})
 	require.NotPanics(t, func() {
 		s.module.BeginBlock(newCtx, req)
 	})
@@ -144,7 +209,9 @@ func VerifyDoUpgradeWithCtx(t *testing.T, newCtx sdk.Context, proposalName strin
 	})
 
 	t.Log("Verify that the upgrade can be successfully applied with a handler")
-	s.keeper.SetUpgradeHandler(proposalName, func(ctx sdk.Context, plan types.Plan) {})
+	s.keeper.SetUpgradeHandler(proposalName, func(ctx sdk.Context, plan types.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		return vm, nil
+	})
 	require.NotPanics(t, func() {
 		s.module.BeginBlock(newCtx, req)
 	})
@@ -156,7 +223,10 @@ func TestHaltIfTooNew(t *testing.T) {
 	s := setupTest(10, map[int64]bool{})
 	t.Log("Verify that we don't panic with registered plan not in database at all")
 	var called int
-	s.keeper.SetUpgradeHandler("future", func(ctx sdk.Context, plan types.Plan) { called++ })
+	s.keeper.SetUpgradeHandler("future", func(ctx sdk.Context, plan types.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		called++
+		return vm, nil
+	})
 
 	newCtx := s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1).WithBlockTime(time.Now())
 	req := abci.RequestBeginBlock{Header: newCtx.BlockHeader()}
@@ -427,3 +497,41 @@ func TestDumpUpgradeInfoToFile(t *testing.T) {
 	err = os.Remove(upgradeInfoFilePath)
 	require.Nil(t, err)
 }
+
+func TestUpgradeVersionMap(t *testing.T) {
+	s := setupTest(10, map[int64]bool{})
+
+	t.Log("Verify the module version map is set at genesis")
+	vm := s.keeper.GetModuleVersionMap(s.ctx)
+	require.Equal(t, uint64(1), vm["bank"])
+	require.Equal(t, uint64(2), vm[types.ModuleName])
+
+	err := s.handler(s.ctx, &types.SoftwareUpgradeProposal{Title: "prop", Plan: types.Plan{Name: "test", Height: s.ctx.BlockHeight() + 1}})
+	require.NoError(t, err)
+
+	newCtx := s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1).WithBlockTime(time.Now())
+	req := abci.RequestBeginBlock{Header: newCtx.BlockHeader()}
+
+	t.Log("Verify a failing upgrade handler halts the chain")
+	s.keeper.SetUpgradeHandler("test", func(ctx sdk.Context, plan types.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		return nil, errors.New("migration failed")
+	})
+	require.Panics(t, func() {
+		s.module.BeginBlock(newCtx, req)
+	})
+
+	t.Log("Verify the version map returned by the upgrade handler is persisted")
+	s.keeper.SetUpgradeHandler("test", func(ctx sdk.Context, plan types.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		require.Equal(t, vm, fromVM)
+		fromVM["bank"] = 2
+		return fromVM, nil
+	})
+	require.NotPanics(t, func() {
+		s.module.BeginBlock(newCtx, req)
+	})
+
+	vm = s.keeper.GetModuleVersionMap(newCtx)
+	require.Equal(t, uint64(2), vm["bank"])
+	require.Equal(t, uint64(2), vm[types.ModuleName])
+	VerifyCleared(t, newCtx)
+}
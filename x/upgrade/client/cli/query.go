@@ -20,6 +20,7 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetCurrentPlanCmd(),
+		GetPendingPlansCmd(),
 		GetAppliedPlanCmd(),
 	)
 
@@ -59,6 +60,32 @@ func GetCurrentPlanCmd() *cobra.Command {
 	return cmd
 }
 
+// GetPendingPlansCmd returns the query pending upgrade plans command
+func GetPendingPlansCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-plans",
+		Short: "get all the pending upgrade plans",
+		Long:  "Gets all the scheduled upgrade plans, in the order they will be executed",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := types.NewQueryPendingPlansRequest()
+			res, err := queryClient.PendingPlans(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetAppliedPlanCmd returns information about the block at which a completed
 // upgrade was applied
 func GetAppliedPlanCmd() *cobra.Command {
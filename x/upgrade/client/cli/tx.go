@@ -93,10 +93,11 @@ func NewCmdSubmitUpgradeProposal() *cobra.Command {
 // NewCmdSubmitCancelUpgradeProposal implements a command handler for submitting a software upgrade cancel proposal transaction.
 func NewCmdSubmitCancelUpgradeProposal(clientCtx client.Context) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "cancel-software-upgrade [flags]",
-		Args:  cobra.ExactArgs(0),
+		Use:   "cancel-software-upgrade [name] [flags]",
+		Args:  cobra.MaximumNArgs(1),
 		Short: "Submit a software upgrade proposal",
-		Long:  "Cancel a software upgrade along with an initial deposit.",
+		Long: "Cancel the pending software upgrade with the given name along with an initial deposit.\n" +
+			"If no name is provided, all the pending software upgrades are cancelled.",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx := clientCtx.InitWithInput(cmd.InOrStdin())
 			from := clientCtx.GetFromAddress()
@@ -121,7 +122,12 @@ func NewCmdSubmitCancelUpgradeProposal(clientCtx client.Context) *cobra.Command
 				return err
 			}
 
-			content := types.NewCancelSoftwareUpgradeProposal(title, description)
+			var name string
+			if len(args) > 0 {
+				name = args[0]
+			}
+
+			content := types.NewCancelSoftwareUpgradeProposal(title, description, name)
 
 			msg, err := gov.NewMsgSubmitProposal(content, deposit, from)
 			if err != nil {
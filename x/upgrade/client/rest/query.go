@@ -15,6 +15,7 @@ import (
 // RegisterRoutes registers REST routes for the upgrade module under the path specified by routeName.
 func RegisterRoutes(clientCtx client.Context, r *mux.Router) {
 	r.HandleFunc("/upgrade/current", getCurrentPlanHandler(clientCtx)).Methods("GET")
+	r.HandleFunc("/upgrade/pending", getPendingPlansHandler(clientCtx)).Methods("GET")
 	r.HandleFunc("/upgrade/applied/{name}", getDonePlanHandler(clientCtx)).Methods("GET")
 	registerTxRoutes(clientCtx, r)
 }
@@ -41,6 +42,23 @@ func getCurrentPlanHandler(clientCtx client.Context) func(http.ResponseWriter, *
 	}
 }
 
+func getPendingPlansHandler(clientCtx client.Context) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		res, _, err := clientCtx.Query(fmt.Sprintf("custom/%s/%s", types.QuerierKey, types.QueryPending))
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		var pending types.QueryPendingPlansResponse
+		err = clientCtx.JSONMarshaler.UnmarshalJSON(res, &pending)
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		rest.PostProcessResponse(w, clientCtx, pending.Plans)
+	}
+}
+
 func getDonePlanHandler(clientCtx client.Context) func(http.ResponseWriter, *http.Request) {
 	return func(w http.ResponseWriter, r *http.Request) {
 		name := mux.Vars(r)["name"]
@@ -45,12 +45,14 @@ type PlanRequest struct {
 	UpgradeInfo   string       `json:"upgrade_info" yaml:"upgrade_info"`
 }
 
-// CancelRequest defines a proposal to cancel a current plan.
+// CancelRequest defines a proposal to cancel a pending plan by name, or all
+// pending plans if UpgradeName is empty.
 type CancelRequest struct {
 	BaseReq     rest.BaseReq `json:"base_req" yaml:"base_req"`
 	Title       string       `json:"title" yaml:"title"`
 	Description string       `json:"description" yaml:"description"`
 	Deposit     sdk.Coins    `json:"deposit" yaml:"deposit"`
+	UpgradeName string       `json:"upgrade_name" yaml:"upgrade_name"`
 }
 
 func ProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
@@ -124,7 +126,7 @@ func newCancelPlanHandler(clientCtx client.Context, txg client.TxGenerator, newM
 			return
 		}
 
-		content := types.NewCancelSoftwareUpgradeProposal(req.Title, req.Description)
+		content := types.NewCancelSoftwareUpgradeProposal(req.Title, req.Description, req.UpgradeName)
 
 		msg := newMsgFn()
 		err = msg.SetContent(content)
@@ -199,7 +201,7 @@ func cancelPlanHandler(clientCtx client.Context) http.HandlerFunc {
 			return
 		}
 
-		content := types.NewCancelSoftwareUpgradeProposal(req.Title, req.Description)
+		content := types.NewCancelSoftwareUpgradeProposal(req.Title, req.Description, req.UpgradeName)
 		msg, err := gov.NewMsgSubmitProposal(content, req.Deposit, fromAddr)
 		if rest.CheckBadRequestError(w, err) {
 			return
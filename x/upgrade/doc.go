@@ -50,7 +50,10 @@ keeper's BeginBlocker method:
     }
 
 The app must then integrate the upgrade keeper with its governance module as appropriate. The governance module
-should call ScheduleUpgrade to schedule an upgrade and ClearUpgradePlan to cancel a pending upgrade.
+should call ScheduleUpgrade to schedule an upgrade, CancelUpgradePlan to cancel a pending upgrade by name and
+ClearUpgradePlan to cancel all pending upgrades. Upgrades are kept in a queue ordered by height, so a future
+upgrade can be scheduled while an earlier one is still pending; scheduling a plan with the name of a pending
+plan replaces it.
 
 Performing Upgrades
 
@@ -59,8 +62,9 @@ existing software will cease to process ABCI messages and a new version with cod
 All upgrades are coordinated by a unique upgrade name that cannot be reused on the same blockchain. In order for the upgrade
 module to know that the upgrade has been safely applied, a handler with the name of the upgrade must be installed.
 Here is an example handler for an upgrade named "my-fancy-upgrade":
-	app.upgradeKeeper.SetUpgradeHandler("my-fancy-upgrade", func(ctx sdk.Context, plan upgrade.Plan) {
+	app.upgradeKeeper.SetUpgradeHandler("my-fancy-upgrade", func(ctx sdk.Context, plan upgrade.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
 		// Perform any migrations of the state store needed for this upgrade
+		return app.mm.RunMigrations(ctx, app.configurator, fromVM)
 	})
 
 This upgrade handler performs the dual function of alerting the upgrade module that the named upgrade has been applied,
@@ -68,11 +72,41 @@ as well as providing the opportunity for the upgraded software to perform any ne
 (with the old binary) and applying the migration (with the new binary) are enforced in the state machine. Actually
 switching the binaries is an ops task and not handled inside the sdk / abci app.
 
+Module Migrations
+
+Each module declares its ConsensusVersion, which must be incremented on every state-breaking change of the
+module, and registers in-place store migrations from each version to the next with a module.Configurator:
+
+	func (am AppModule) ConsensusVersion() uint64 { return 2 }
+
+	func (am AppModule) RegisterMigrations(cfg module.Configurator) {
+		cfg.RegisterMigration(types.ModuleName, 1, am.keeper.Migrate1to2)
+	}
+
+The upgrade module persists the consensus version of every module, set at genesis from module.Manager#GetVersionMap
+and updated with the VersionMap returned by each upgrade handler. This map is passed to the upgrade handler as
+fromVM, so that module.Manager#RunMigrations can run, for each module, all the migrations from its persisted version
+up to its current ConsensusVersion. Modules absent from fromVM are considered new and are initialized with their
+default genesis state.
+
+A chain started before the upgrade module persisted the module versions has no version map in state, so the fromVM of
+its first upgrade handler is empty, and RunMigrations rejects it rather than initializing every module again. This
+first handler must set fromVM explicitly to the consensus versions of the modules of the previous binary:
+
+	app.upgradeKeeper.SetUpgradeHandler("first-upgrade", func(ctx sdk.Context, plan upgrade.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		if len(fromVM) == 0 {
+			fromVM = module.VersionMap{"auth": 1, "bank": 1, "staking": 1, ...}
+		}
+
+		return app.mm.RunMigrations(ctx, app.configurator, fromVM)
+	})
+
 Here is a sample code to set store migrations with an upgrade:
 
 	// this configures a no-op upgrade handler for the "my-fancy-upgrade" upgrade
-	app.UpgradeKeeper.SetUpgradeHandler("my-fancy-upgrade",  func(ctx sdk.Context, plan upgrade.Plan) {
+	app.UpgradeKeeper.SetUpgradeHandler("my-fancy-upgrade",  func(ctx sdk.Context, plan upgrade.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
 		// upgrade changes here
+		return fromVM, nil
 	})
 
 	upgradeInfo := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
@@ -113,7 +147,7 @@ Cancelling Upgrades
 
 There are two ways to cancel a planned upgrade - with on-chain governance or off-chain social consensus.
 For the first one, there is a CancelSoftwareUpgrade proposal type, which can be voted on and will
-remove the scheduled upgrade plan. Of course this requires that the upgrade was known to be a bad idea
+remove the scheduled upgrade plan with the given name, or all the scheduled plans if no name is given. Of course this requires that the upgrade was known to be a bad idea
 well before the upgrade itself, to allow time for a vote. If you want to allow such a possibility, you
 should set the upgrade height to be 2 * (votingperiod + depositperiod) + (safety delta) from the beginning of
 the first upgrade proposal. Safety delta is the time available from the success of an upgrade proposal
@@ -30,7 +30,11 @@ func handleSoftwareUpgradeProposal(ctx sdk.Context, k keeper.Keeper, p *types.So
 	return k.ScheduleUpgrade(ctx, p.Plan)
 }
 
-func handleCancelSoftwareUpgradeProposal(ctx sdk.Context, k keeper.Keeper, _ *types.CancelSoftwareUpgradeProposal) error {
-	k.ClearUpgradePlan(ctx)
-	return nil
+func handleCancelSoftwareUpgradeProposal(ctx sdk.Context, k keeper.Keeper, p *types.CancelSoftwareUpgradeProposal) error {
+	if p.Name == "" {
+		k.ClearUpgradePlan(ctx)
+		return nil
+	}
+
+	return k.CancelUpgradePlan(ctx, p.Name)
 }
@@ -21,6 +21,13 @@ func (k Keeper) CurrentPlan(c context.Context, req *types.QueryCurrentPlanReques
 	return &types.QueryCurrentPlanResponse{Plan: &plan}, nil
 }
 
+// PendingPlans implements the Query/PendingPlans gRPC method
+func (k Keeper) PendingPlans(c context.Context, req *types.QueryPendingPlansRequest) (*types.QueryPendingPlansResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryPendingPlansResponse{Plans: k.GetUpgradePlans(ctx)}, nil
+}
+
 // AppliedPlan implements the Query/AppliedPlan gRPC method
 func (k Keeper) AppliedPlan(c context.Context, req *types.QueryAppliedPlanRequest) (*types.QueryAppliedPlanResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
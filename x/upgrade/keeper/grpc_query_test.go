@@ -12,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
@@ -83,6 +84,59 @@ func (suite *UpgradeTestSuite) TestQueryCurrentPlan() {
 	}
 }
 
+func (suite *UpgradeTestSuite) TestQueryPendingPlans() {
+	var (
+		req         *types.QueryPendingPlansRequest
+		expResponse types.QueryPendingPlansResponse
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"without pending upgrade plans",
+			func() {
+				req = types.NewQueryPendingPlansRequest()
+				expResponse = types.QueryPendingPlansResponse{}
+			},
+			true,
+		},
+		{
+			"with pending upgrade plans",
+			func() {
+				later := types.Plan{Name: "later-plan", Height: 10}
+				suite.app.UpgradeKeeper.ScheduleUpgrade(suite.ctx, later)
+				plan := types.Plan{Name: "test-plan", Height: 5}
+				suite.app.UpgradeKeeper.ScheduleUpgrade(suite.ctx, plan)
+
+				req = types.NewQueryPendingPlansRequest()
+				expResponse = types.QueryPendingPlansResponse{Plans: []types.Plan{plan, later}}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+
+			res, err := suite.queryClient.PendingPlans(gocontext.Background(), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(&expResponse, res)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *UpgradeTestSuite) TestAppliedCurrentPlan() {
 	var (
 		req       *types.QueryAppliedPlanRequest
@@ -111,7 +165,9 @@ func (suite *UpgradeTestSuite) TestAppliedCurrentPlan() {
 				suite.app.UpgradeKeeper.ScheduleUpgrade(suite.ctx, plan)
 
 				suite.ctx = suite.ctx.WithBlockHeight(expHeight)
-				suite.app.UpgradeKeeper.SetUpgradeHandler(planName, func(ctx sdk.Context, plan types.Plan) {})
+				suite.app.UpgradeKeeper.SetUpgradeHandler(planName, func(ctx sdk.Context, plan types.Plan, vm module.VersionMap) (module.VersionMap, error) {
+					return vm, nil
+				})
 				suite.app.UpgradeKeeper.ApplyUpgrade(suite.ctx, plan)
 
 				req = types.NewQueryAppliedPlanRequest(planName)
@@ -19,6 +19,7 @@ import (
 	store "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/module"
 )
 
 // UpgradeInfoFileName file to store upgrade information
@@ -50,9 +51,34 @@ func (k Keeper) SetUpgradeHandler(name string, upgradeHandler types.UpgradeHandl
 	k.upgradeHandlers[name] = upgradeHandler
 }
 
-// ScheduleUpgrade schedules an upgrade based on the specified plan.
-// If there is another Plan already scheduled, it will overwrite it
-// (implicitly cancelling the current plan)
+// SetModuleVersionMap saves a given version map to state
+func (k Keeper) SetModuleVersionMap(ctx sdk.Context, vm module.VersionMap) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.VersionMapByte})
+	for modName, ver := range vm {
+		bz := make([]byte, 8)
+		binary.BigEndian.PutUint64(bz, ver)
+		store.Set([]byte(modName), bz)
+	}
+}
+
+// GetModuleVersionMap returns the version map of all modules as persisted in
+// state by the last applied upgrade or at genesis.
+func (k Keeper) GetModuleVersionMap(ctx sdk.Context) module.VersionMap {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.VersionMapByte})
+	it := store.Iterator(nil, nil)
+	defer it.Close()
+
+	vm := make(module.VersionMap)
+	for ; it.Valid(); it.Next() {
+		vm[string(it.Key())] = binary.BigEndian.Uint64(it.Value())
+	}
+
+	return vm
+}
+
+// ScheduleUpgrade adds an upgrade based on the specified plan to the queue of
+// pending plans. If a Plan with the same name is already scheduled, it will be
+// replaced by the new one; plans with other names are left untouched.
 func (k Keeper) ScheduleUpgrade(ctx sdk.Context, plan types.Plan) error {
 	if err := plan.ValidateBasic(); err != nil {
 		return err
@@ -70,9 +96,11 @@ func (k Keeper) ScheduleUpgrade(ctx sdk.Context, plan types.Plan) error {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "upgrade with name %s has already been completed", plan.Name)
 	}
 
+	k.removeUpgradePlan(ctx, plan.Name)
+
 	bz := k.cdc.MustMarshalBinaryBare(&plan)
 	store := ctx.KVStore(k.storeKey)
-	store.Set(types.PlanKey(), bz)
+	store.Set(types.PlanQueueKey(plan), bz)
 
 	return nil
 }
@@ -88,10 +116,49 @@ func (k Keeper) GetDoneHeight(ctx sdk.Context, name string) int64 {
 	return int64(binary.BigEndian.Uint64(bz))
 }
 
-// ClearUpgradePlan clears any schedule upgrade
+// ClearUpgradePlan clears all the scheduled upgrades
 func (k Keeper) ClearUpgradePlan(ctx sdk.Context) {
+	for _, plan := range k.GetUpgradePlans(ctx) {
+		k.removeUpgradePlan(ctx, plan.Name)
+	}
+}
+
+// CancelUpgradePlan removes the scheduled upgrade with the given name from the
+// queue of pending plans. It returns an error if no such upgrade is scheduled.
+func (k Keeper) CancelUpgradePlan(ctx sdk.Context, name string) error {
+	if !k.removeUpgradePlan(ctx, name) {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "no pending upgrade with name %s", name)
+	}
+
+	return nil
+}
+
+// removeUpgradePlan deletes the pending plan with the given name, returning
+// false if there is none
+func (k Keeper) removeUpgradePlan(ctx sdk.Context, name string) bool {
 	store := ctx.KVStore(k.storeKey)
-	store.Delete(types.PlanKey())
+
+	if plan, found := k.getLegacyUpgradePlan(ctx); found && plan.Name == name {
+		store.Delete(types.PlanKey())
+		return true
+	}
+
+	var key []byte
+	it := sdk.KVStorePrefixIterator(store, []byte{types.PlanQueueByte})
+	for ; it.Valid(); it.Next() {
+		if string(it.Key()[9:]) == name {
+			key = it.Key()
+			break
+		}
+	}
+	it.Close()
+
+	if key == nil {
+		return false
+	}
+
+	store.Delete(key)
+	return true
 }
 
 // Logger returns a module-specific logger.
@@ -99,9 +166,53 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
-// GetUpgradePlan returns the currently scheduled Plan if any, setting havePlan to true if there is a scheduled
+// GetUpgradePlan returns the next scheduled Plan if any, setting havePlan to true if there is a scheduled
 // upgrade or false if there is none
 func (k Keeper) GetUpgradePlan(ctx sdk.Context) (plan types.Plan, havePlan bool) {
+	k.IterateUpgradePlans(ctx, func(p types.Plan) (stop bool) {
+		plan, havePlan = p, true
+		return true
+	})
+
+	return plan, havePlan
+}
+
+// GetUpgradePlans returns all the scheduled Plans, in the order they will be executed
+func (k Keeper) GetUpgradePlans(ctx sdk.Context) (plans []types.Plan) {
+	k.IterateUpgradePlans(ctx, func(plan types.Plan) (stop bool) {
+		plans = append(plans, plan)
+		return false
+	})
+
+	return plans
+}
+
+// IterateUpgradePlans iterates over the scheduled Plans ordered by height, plans
+// scheduled by time coming first, and calls cb on each of them until it returns true
+func (k Keeper) IterateUpgradePlans(ctx sdk.Context, cb func(plan types.Plan) (stop bool)) {
+	// a plan scheduled before plans were queued is always the first one
+	if plan, found := k.getLegacyUpgradePlan(ctx); found {
+		if cb(plan) {
+			return
+		}
+	}
+
+	it := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), []byte{types.PlanQueueByte})
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var plan types.Plan
+		k.cdc.MustUnmarshalBinaryBare(it.Value(), &plan)
+
+		if cb(plan) {
+			break
+		}
+	}
+}
+
+// getLegacyUpgradePlan returns the Plan stored under the single plan key used
+// before plans were queued, if any
+func (k Keeper) getLegacyUpgradePlan(ctx sdk.Context) (plan types.Plan, havePlan bool) {
 	store := ctx.KVStore(k.storeKey)
 	bz := store.Get(types.PlanKey())
 	if bz == nil {
@@ -126,16 +237,22 @@ func (k Keeper) HasHandler(name string) bool {
 	return ok
 }
 
-// ApplyUpgrade will execute the handler associated with the Plan and mark the plan as done.
+// ApplyUpgrade will execute the handler associated with the Plan, persist the
+// module version map it returns and mark the plan as done.
 func (k Keeper) ApplyUpgrade(ctx sdk.Context, plan types.Plan) {
 	handler := k.upgradeHandlers[plan.Name]
 	if handler == nil {
 		panic("ApplyUpgrade should never be called without first checking HasHandler")
 	}
 
-	handler(ctx, plan)
+	updatedVM, err := handler(ctx, plan, k.GetModuleVersionMap(ctx))
+	if err != nil {
+		panic(err)
+	}
+
+	k.SetModuleVersionMap(ctx, updatedVM)
 
-	k.ClearUpgradePlan(ctx)
+	k.removeUpgradePlan(ctx, plan.Name)
 	k.setDone(ctx, plan.Name)
 }
 
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2: the single pending plan, if any,
+// is moved into the queue of pending plans.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	plan, found := m.keeper.getLegacyUpgradePlan(ctx)
+	if !found {
+		return nil
+	}
+
+	store := ctx.KVStore(m.keeper.storeKey)
+	store.Delete(types.PlanKey())
+	store.Set(types.PlanQueueKey(plan), m.keeper.cdc.MustMarshalBinaryBare(&plan))
+
+	return nil
+}
@@ -0,0 +1,24 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+func (suite *UpgradeTestSuite) TestMigrate1to2() {
+	legacyPlan := types.Plan{Name: "legacy", Height: 20}
+	store := suite.ctx.KVStore(suite.app.GetKey(types.StoreKey))
+	store.Set(types.PlanKey(), suite.app.AppCodec().MustMarshalBinaryBare(&legacyPlan))
+
+	queuedPlan := types.Plan{Name: "queued", Height: 10}
+	suite.Require().NoError(suite.app.UpgradeKeeper.ScheduleUpgrade(suite.ctx, queuedPlan))
+
+	// the plan scheduled by the previous version of the module is pending first
+	suite.Require().Equal([]types.Plan{legacyPlan, queuedPlan}, suite.app.UpgradeKeeper.GetUpgradePlans(suite.ctx))
+
+	err := keeper.NewMigrator(suite.app.UpgradeKeeper).Migrate1to2(suite.ctx)
+	suite.Require().NoError(err)
+
+	suite.Require().Nil(store.Get(types.PlanKey()))
+	suite.Require().Equal([]types.Plan{queuedPlan, legacyPlan}, suite.app.UpgradeKeeper.GetUpgradePlans(suite.ctx))
+}
@@ -19,6 +19,9 @@ func NewQuerier(k Keeper) sdk.Querier {
 		case types.QueryCurrent:
 			return queryCurrent(ctx, req, k)
 
+		case types.QueryPending:
+			return queryPending(ctx, req, k)
+
 		case types.QueryApplied:
 			return queryApplied(ctx, req, k)
 
@@ -42,6 +45,15 @@ func queryCurrent(ctx sdk.Context, _ abci.RequestQuery, k Keeper) ([]byte, error
 	return res, nil
 }
 
+func queryPending(ctx sdk.Context, _ abci.RequestQuery, k Keeper) ([]byte, error) {
+	res, err := k.cdc.MarshalJSON(&types.QueryPendingPlansResponse{Plans: k.GetUpgradePlans(ctx)})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
 func queryApplied(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
 	var params types.QueryAppliedPlanRequest
 
@@ -32,6 +32,7 @@ var (
 	_ module.AppModule       = AppModule{}
 	_ module.AppModuleBasic  = AppModuleBasic{}
 	_ module.InterfaceModule = AppModuleBasic{}
+	_ module.MigrationModule = AppModule{}
 )
 
 // AppModuleBasic implements the sdk.AppModuleBasic interface
@@ -116,6 +117,17 @@ func (am AppModule) ExportGenesis(_ sdk.Context, cdc codec.JSONMarshaler) json.R
 	return am.DefaultGenesis(cdc)
 }
 
+// ConsensusVersion implements AppModule/ConsensusVersion.
+func (AppModule) ConsensusVersion() uint64 { return 2 }
+
+// RegisterMigrations registers the in-place store migrations of the upgrade module
+func (am AppModule) RegisterMigrations(cfg module.Configurator) {
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(err)
+	}
+}
+
 // BeginBlock calls the upgrade module hooks
 //
 // CONTRACT: this is registered in BeginBlocker *before* all other modules' BeginBlock functions
@@ -1,5 +1,7 @@
 package types
 
+import "encoding/binary"
+
 const (
 	// ModuleName is the name of this module
 	ModuleName = "upgrade"
@@ -15,10 +17,15 @@ const (
 )
 
 const (
-	// PlanByte specifies the Byte under which a pending upgrade plan is stored in the store
+	// PlanByte specifies the Byte under which the single pending upgrade plan was stored before
+	// plans were queued. It is only read to handle plans scheduled by a previous version of the module.
 	PlanByte = 0x0
 	// DoneByte is a prefix for to look up completed upgrade plan by name
 	DoneByte = 0x1
+	// VersionMapByte is a prefix to look up module names (key) and versions (value)
+	VersionMapByte = 0x2
+	// PlanQueueByte is a prefix for the queue of pending upgrade plans, ordered by height
+	PlanQueueByte = 0x3
 )
 
 // PlanKey is the key under which the current plan is saved
@@ -26,3 +33,15 @@ const (
 func PlanKey() []byte {
 	return []byte{PlanByte}
 }
+
+// PlanQueueKey is the key under which a pending plan is saved in the plan queue:
+// PlanQueueByte | BigEndian(Height) | Name. Plans scheduled by time have a zero
+// height and are therefore stored at the front of the queue.
+func PlanQueueKey(plan Plan) []byte {
+	bz := make([]byte, 9+len(plan.Name))
+	bz[0] = PlanQueueByte
+	binary.BigEndian.PutUint64(bz[1:9], uint64(plan.Height))
+	copy(bz[9:], plan.Name)
+
+	return bz
+}
@@ -43,8 +43,10 @@ func (sup SoftwareUpgradeProposal) String() string {
 `, sup.Title, sup.Description)
 }
 
-func NewCancelSoftwareUpgradeProposal(title, description string) gov.Content {
-	return &CancelSoftwareUpgradeProposal{title, description}
+// NewCancelSoftwareUpgradeProposal creates a proposal cancelling the pending
+// upgrade with the given name, or all pending upgrades if name is empty.
+func NewCancelSoftwareUpgradeProposal(title, description, name string) gov.Content {
+	return &CancelSoftwareUpgradeProposal{title, description, name}
 }
 
 // Implements Proposal Interface
@@ -61,8 +63,14 @@ func (sup *CancelSoftwareUpgradeProposal) ValidateBasic() error {
 }
 
 func (sup CancelSoftwareUpgradeProposal) String() string {
+	name := sup.Name
+	if name == "" {
+		name = "all pending upgrades"
+	}
+
 	return fmt.Sprintf(`Cancel Software Upgrade Proposal:
   Title:       %s
   Description: %s
-`, sup.Title, sup.Description)
+  Upgrade:     %s
+`, sup.Title, sup.Description, name)
 }
@@ -34,11 +34,18 @@ func TestContentAccessors(t *testing.T) {
 			str:   "Software Upgrade Proposal:\n  Title:       Title\n  Description: desc\n",
 		},
 		"cancel": {
-			p:     NewCancelSoftwareUpgradeProposal("Cancel", "bad idea"),
+			p:     NewCancelSoftwareUpgradeProposal("Cancel", "bad idea", "due_time"),
 			title: "Cancel",
 			desc:  "bad idea",
 			typ:   "CancelSoftwareUpgrade",
-			str:   "Cancel Software Upgrade Proposal:\n  Title:       Cancel\n  Description: bad idea\n",
+			str:   "Cancel Software Upgrade Proposal:\n  Title:       Cancel\n  Description: bad idea\n  Upgrade:     due_time\n",
+		},
+		"cancel all": {
+			p:     NewCancelSoftwareUpgradeProposal("Cancel", "bad idea", ""),
+			title: "Cancel",
+			desc:  "bad idea",
+			typ:   "CancelSoftwareUpgrade",
+			str:   "Cancel Software Upgrade Proposal:\n  Title:       Cancel\n  Description: bad idea\n  Upgrade:     all pending upgrades\n",
 		},
 	}
 
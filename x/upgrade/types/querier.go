@@ -3,6 +3,7 @@ package types
 // query endpoints supported by the upgrade Querier
 const (
 	QueryCurrent = "current"
+	QueryPending = "pending"
 	QueryApplied = "applied"
 )
 
@@ -11,6 +12,11 @@ func NewQueryCurrentPlanRequest() *QueryCurrentPlanRequest {
 	return &QueryCurrentPlanRequest{}
 }
 
+// NewQueryPendingPlansRequest creates a new instance of QueryPendingPlansRequest.
+func NewQueryPendingPlansRequest() *QueryPendingPlansRequest {
+	return &QueryPendingPlansRequest{}
+}
+
 // NewQueryAppliedPlanRequest creates a new instance of QueryAppliedPlanRequest.
 func NewQueryAppliedPlanRequest(name string) *QueryAppliedPlanRequest {
 	return &QueryAppliedPlanRequest{Name: name}
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
 	grpc "google.golang.org/grpc"
@@ -110,6 +111,89 @@ func (m *QueryCurrentPlanResponse) GetPlan() *Plan {
 	return nil
 }
 
+// QueryPendingPlansRequest is the request type for the Query/PendingPlans RPC method
+type QueryPendingPlansRequest struct {
+}
+
+func (m *QueryPendingPlansRequest) Reset()         { *m = QueryPendingPlansRequest{} }
+func (m *QueryPendingPlansRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingPlansRequest) ProtoMessage()    {}
+func (*QueryPendingPlansRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_569a61f8872b804e, []int{2}
+}
+func (m *QueryPendingPlansRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingPlansRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingPlansRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingPlansRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingPlansRequest.Merge(m, src)
+}
+func (m *QueryPendingPlansRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingPlansRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingPlansRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingPlansRequest proto.InternalMessageInfo
+
+// QueryPendingPlansResponse is the response type for the Query/PendingPlans RPC method
+type QueryPendingPlansResponse struct {
+	// plans are the pending upgrade plans, in the order they will be executed
+	Plans []Plan `protobuf:"bytes,1,rep,name=plans,proto3" json:"plans"`
+}
+
+func (m *QueryPendingPlansResponse) Reset()         { *m = QueryPendingPlansResponse{} }
+func (m *QueryPendingPlansResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingPlansResponse) ProtoMessage()    {}
+func (*QueryPendingPlansResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_569a61f8872b804e, []int{3}
+}
+func (m *QueryPendingPlansResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingPlansResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingPlansResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingPlansResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingPlansResponse.Merge(m, src)
+}
+func (m *QueryPendingPlansResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingPlansResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingPlansResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingPlansResponse proto.InternalMessageInfo
+
+func (m *QueryPendingPlansResponse) GetPlans() []Plan {
+	if m != nil {
+		return m.Plans
+	}
+	return nil
+}
+
 // QueryCurrentPlanRequest is the request type for the Query/AppliedPlan RPC method
 type QueryAppliedPlanRequest struct {
 	// name is the name of the applied plan to query for
@@ -120,7 +204,7 @@ func (m *QueryAppliedPlanRequest) Reset()         { *m = QueryAppliedPlanRequest
 func (m *QueryAppliedPlanRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAppliedPlanRequest) ProtoMessage()    {}
 func (*QueryAppliedPlanRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_569a61f8872b804e, []int{2}
+	return fileDescriptor_569a61f8872b804e, []int{4}
 }
 func (m *QueryAppliedPlanRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -166,7 +250,7 @@ func (m *QueryAppliedPlanResponse) Reset()         { *m = QueryAppliedPlanRespon
 func (m *QueryAppliedPlanResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAppliedPlanResponse) ProtoMessage()    {}
 func (*QueryAppliedPlanResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_569a61f8872b804e, []int{3}
+	return fileDescriptor_569a61f8872b804e, []int{5}
 }
 func (m *QueryAppliedPlanResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -205,6 +289,8 @@ func (m *QueryAppliedPlanResponse) GetHeight() int64 {
 func init() {
 	proto.RegisterType((*QueryCurrentPlanRequest)(nil), "cosmos.upgrade.QueryCurrentPlanRequest")
 	proto.RegisterType((*QueryCurrentPlanResponse)(nil), "cosmos.upgrade.QueryCurrentPlanResponse")
+	proto.RegisterType((*QueryPendingPlansRequest)(nil), "cosmos.upgrade.QueryPendingPlansRequest")
+	proto.RegisterType((*QueryPendingPlansResponse)(nil), "cosmos.upgrade.QueryPendingPlansResponse")
 	proto.RegisterType((*QueryAppliedPlanRequest)(nil), "cosmos.upgrade.QueryAppliedPlanRequest")
 	proto.RegisterType((*QueryAppliedPlanResponse)(nil), "cosmos.upgrade.QueryAppliedPlanResponse")
 }
@@ -212,25 +298,30 @@ func init() {
 func init() { proto.RegisterFile("cosmos/upgrade/query.proto", fileDescriptor_569a61f8872b804e) }
 
 var fileDescriptor_569a61f8872b804e = []byte{
-	// 286 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4a, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x2f, 0x2d, 0x48, 0x2f, 0x4a, 0x4c, 0x49, 0xd5, 0x2f, 0x2c, 0x4d, 0x2d, 0xaa,
-	0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x83, 0xc8, 0xe9, 0x41, 0xe5, 0xa4, 0x64, 0xd0,
-	0xd4, 0x42, 0x69, 0x88, 0x6a, 0x25, 0x49, 0x2e, 0xf1, 0x40, 0x90, 0x66, 0xe7, 0xd2, 0xa2, 0xa2,
-	0xd4, 0xbc, 0x92, 0x80, 0x9c, 0xc4, 0xbc, 0xa0, 0xd4, 0xc2, 0xd2, 0xd4, 0xe2, 0x12, 0x25, 0x17,
-	0x2e, 0x09, 0x4c, 0xa9, 0xe2, 0x82, 0xfc, 0xbc, 0xe2, 0x54, 0x21, 0x0d, 0x2e, 0x96, 0x82, 0x9c,
-	0xc4, 0x3c, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x11, 0x3d, 0x54, 0x3b, 0xf5, 0xc0, 0x6a,
-	0xc1, 0x2a, 0x94, 0x74, 0xa1, 0x16, 0x38, 0x16, 0x14, 0xe4, 0x64, 0xa6, 0xa6, 0x20, 0x59, 0x20,
-	0x24, 0xc4, 0xc5, 0x92, 0x97, 0x98, 0x9b, 0x0a, 0x36, 0x84, 0x33, 0x08, 0xcc, 0x56, 0x32, 0x82,
-	0x5a, 0x8a, 0xa2, 0x1c, 0x6a, 0xa9, 0x18, 0x17, 0x5b, 0x46, 0x6a, 0x66, 0x7a, 0x46, 0x09, 0x58,
-	0x07, 0x73, 0x10, 0x94, 0x67, 0x74, 0x9e, 0x91, 0x8b, 0x15, 0xac, 0x49, 0x28, 0x89, 0x8b, 0x1b,
-	0xc9, 0xb5, 0x42, 0xea, 0xe8, 0xee, 0xc2, 0xe1, 0x55, 0x29, 0x0d, 0xc2, 0x0a, 0x21, 0x6e, 0x50,
-	0x62, 0x00, 0xd9, 0x81, 0xe4, 0x38, 0x1c, 0x76, 0x60, 0xfa, 0x16, 0x87, 0x1d, 0x58, 0xfc, 0xa9,
-	0xc4, 0xe0, 0xe4, 0x76, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31,
-	0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x3a, 0xe9,
-	0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0xd0, 0x88, 0x85, 0x50, 0xba, 0xc5,
-	0x29, 0xd9, 0xfa, 0x15, 0xf0, 0x58, 0x2e, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x47, 0xb2,
-	0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x4f, 0x34, 0x23, 0x68, 0x30, 0x02, 0x00, 0x00,
+	// 356 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xcb, 0x4a, 0xc3, 0x40,
+	0x14, 0x4d, 0xec, 0x03, 0xbc, 0x15, 0x17, 0x43, 0xd1, 0x74, 0x90, 0x58, 0xb2, 0x31, 0x82, 0x4d,
+	0xa4, 0x7e, 0x81, 0x55, 0xdc, 0x09, 0x35, 0x4b, 0x77, 0x49, 0x33, 0xa4, 0xc1, 0x76, 0x66, 0x9a,
+	0x49, 0xc0, 0xfe, 0x85, 0x5f, 0xe3, 0x37, 0x74, 0xd9, 0xa5, 0x2b, 0x91, 0xf6, 0x47, 0x24, 0x93,
+	0x69, 0x89, 0x7d, 0x50, 0x57, 0x73, 0x87, 0x73, 0xee, 0x3d, 0xe7, 0x1e, 0x2e, 0xe0, 0x01, 0x13,
+	0x63, 0x26, 0xdc, 0x8c, 0x47, 0x89, 0x1f, 0x12, 0x77, 0x92, 0x91, 0x64, 0xea, 0xf0, 0x84, 0xa5,
+	0x0c, 0x9d, 0x16, 0x98, 0xa3, 0x30, 0xdc, 0x8c, 0x58, 0xc4, 0x24, 0xe4, 0xe6, 0x55, 0xc1, 0xc2,
+	0x17, 0x1b, 0x13, 0xd4, 0x5b, 0xa0, 0x56, 0x0b, 0xce, 0x5f, 0xf2, 0x91, 0x0f, 0x59, 0x92, 0x10,
+	0x9a, 0xf6, 0x47, 0x3e, 0xf5, 0xc8, 0x24, 0x23, 0x22, 0xb5, 0x1e, 0xc1, 0xd8, 0x86, 0x04, 0x67,
+	0x54, 0x10, 0x64, 0x43, 0x95, 0x8f, 0x7c, 0x6a, 0xe8, 0x6d, 0xdd, 0x6e, 0x74, 0x9b, 0xce, 0x5f,
+	0x27, 0x8e, 0xe4, 0x4a, 0x86, 0x85, 0xd5, 0x94, 0x3e, 0xa1, 0x61, 0x4c, 0xa3, 0x1c, 0x11, 0x2b,
+	0x85, 0x67, 0x68, 0xed, 0xc0, 0x94, 0xc4, 0x2d, 0xd4, 0xf2, 0x01, 0xc2, 0xd0, 0xdb, 0x95, 0x7d,
+	0x1a, 0xbd, 0xea, 0xec, 0xfb, 0x52, 0xf3, 0x0a, 0xa2, 0xd5, 0x51, 0xbb, 0xdc, 0x73, 0x3e, 0x8a,
+	0x49, 0x58, 0xda, 0x05, 0x21, 0xa8, 0x52, 0x7f, 0x4c, 0xa4, 0xdf, 0x63, 0x4f, 0xd6, 0x56, 0x17,
+	0x8c, 0x6d, 0xba, 0x12, 0x3f, 0x83, 0xfa, 0x90, 0xc4, 0xd1, 0x30, 0x95, 0x1d, 0x15, 0x4f, 0xfd,
+	0xba, 0x9f, 0x47, 0x50, 0x93, 0x4d, 0x28, 0x80, 0x46, 0x29, 0x18, 0x74, 0xb5, 0x69, 0x6f, 0x4f,
+	0xaa, 0xd8, 0x3e, 0x4c, 0x2c, 0x3c, 0x58, 0x1a, 0x22, 0x70, 0x52, 0x8e, 0x06, 0xed, 0xee, 0xdd,
+	0x91, 0x2c, 0xbe, 0xfe, 0x07, 0x73, 0x2d, 0x13, 0x40, 0xa3, 0x94, 0xc1, 0x9e, 0x55, 0xb6, 0x43,
+	0xc5, 0xf6, 0x61, 0xe2, 0x4a, 0xa3, 0xf7, 0x34, 0x5b, 0x98, 0xfa, 0x7c, 0x61, 0xea, 0x3f, 0x0b,
+	0x53, 0xff, 0x58, 0x9a, 0xda, 0x7c, 0x69, 0x6a, 0x5f, 0x4b, 0x53, 0x7b, 0xbd, 0x89, 0xe2, 0x74,
+	0x98, 0x05, 0xce, 0x80, 0x8d, 0x5d, 0x75, 0xaa, 0xc5, 0xd3, 0x11, 0xe1, 0x9b, 0xfb, 0xbe, 0xbe,
+	0xdb, 0x74, 0xca, 0x89, 0x08, 0xea, 0xf2, 0x6c, 0xef, 0x7e, 0x07, 0x00, 0x36, 0x42, 0x61, 0x50,
+	0x18, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -247,6 +338,8 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// CurrentPlan queries the current upgrade plan
 	CurrentPlan(ctx context.Context, in *QueryCurrentPlanRequest, opts ...grpc.CallOption) (*QueryCurrentPlanResponse, error)
+	// PendingPlans queries all the pending upgrade plans, ordered by height
+	PendingPlans(ctx context.Context, in *QueryPendingPlansRequest, opts ...grpc.CallOption) (*QueryPendingPlansResponse, error)
 	// AppliedPlan queries a previously applied upgrade plan by its name
 	AppliedPlan(ctx context.Context, in *QueryAppliedPlanRequest, opts ...grpc.CallOption) (*QueryAppliedPlanResponse, error)
 }
@@ -268,6 +361,15 @@ func (c *queryClient) CurrentPlan(ctx context.Context, in *QueryCurrentPlanReque
 	return out, nil
 }
 
+func (c *queryClient) PendingPlans(ctx context.Context, in *QueryPendingPlansRequest, opts ...grpc.CallOption) (*QueryPendingPlansResponse, error) {
+	out := new(QueryPendingPlansResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.upgrade.Query/PendingPlans", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) AppliedPlan(ctx context.Context, in *QueryAppliedPlanRequest, opts ...grpc.CallOption) (*QueryAppliedPlanResponse, error) {
 	out := new(QueryAppliedPlanResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.upgrade.Query/AppliedPlan", in, out, opts...)
@@ -281,6 +383,8 @@ func (c *queryClient) AppliedPlan(ctx context.Context, in *QueryAppliedPlanReque
 type QueryServer interface {
 	// CurrentPlan queries the current upgrade plan
 	CurrentPlan(context.Context, *QueryCurrentPlanRequest) (*QueryCurrentPlanResponse, error)
+	// PendingPlans queries all the pending upgrade plans, ordered by height
+	PendingPlans(context.Context, *QueryPendingPlansRequest) (*QueryPendingPlansResponse, error)
 	// AppliedPlan queries a previously applied upgrade plan by its name
 	AppliedPlan(context.Context, *QueryAppliedPlanRequest) (*QueryAppliedPlanResponse, error)
 }
@@ -292,6 +396,9 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) CurrentPlan(ctx context.Context, req *QueryCurrentPlanRequest) (*QueryCurrentPlanResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CurrentPlan not implemented")
 }
+func (*UnimplementedQueryServer) PendingPlans(ctx context.Context, req *QueryPendingPlansRequest) (*QueryPendingPlansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingPlans not implemented")
+}
 func (*UnimplementedQueryServer) AppliedPlan(ctx context.Context, req *QueryAppliedPlanRequest) (*QueryAppliedPlanResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AppliedPlan not implemented")
 }
@@ -318,6 +425,24 @@ func _Query_CurrentPlan_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PendingPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingPlansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingPlans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.upgrade.Query/PendingPlans",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingPlans(ctx, req.(*QueryPendingPlansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_AppliedPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryAppliedPlanRequest)
 	if err := dec(in); err != nil {
@@ -344,6 +469,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CurrentPlan",
 			Handler:    _Query_CurrentPlan_Handler,
 		},
+		{
+			MethodName: "PendingPlans",
+			Handler:    _Query_PendingPlans_Handler,
+		},
 		{
 			MethodName: "AppliedPlan",
 			Handler:    _Query_AppliedPlan_Handler,
@@ -411,6 +540,66 @@ func (m *QueryCurrentPlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryPendingPlansRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingPlansRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingPlansRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingPlansResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingPlansResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingPlansResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Plans) > 0 {
+		for iNdEx := len(m.Plans) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Plans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryAppliedPlanRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -502,6 +691,30 @@ func (m *QueryCurrentPlanResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryPendingPlansRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPendingPlansResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Plans) > 0 {
+		for _, e := range m.Plans {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *QueryAppliedPlanRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -675,6 +888,146 @@ func (m *QueryCurrentPlanResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryPendingPlansRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingPlansRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingPlansRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingPlansResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingPlansResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingPlansResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plans", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Plans = append(m.Plans, Plan{})
+			if err := m.Plans[len(m.Plans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryAppliedPlanRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
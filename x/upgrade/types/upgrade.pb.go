@@ -121,6 +121,8 @@ var xxx_messageInfo_SoftwareUpgradeProposal proto.InternalMessageInfo
 type CancelSoftwareUpgradeProposal struct {
 	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
 	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// name of the pending upgrade plan to cancel. If empty, all pending plans are cancelled.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 }
 
 func (m *CancelSoftwareUpgradeProposal) Reset()      { *m = CancelSoftwareUpgradeProposal{} }
@@ -164,30 +166,30 @@ func init() {
 func init() { proto.RegisterFile("cosmos/upgrade/upgrade.proto", fileDescriptor_f096ad3e7ee0b803) }
 
 var fileDescriptor_f096ad3e7ee0b803 = []byte{
-	// 358 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x52, 0xb1, 0x4e, 0xf3, 0x30,
-	0x10, 0x8e, 0xff, 0xe6, 0xaf, 0xfe, 0xba, 0xd2, 0x3f, 0x58, 0x15, 0x44, 0x15, 0x38, 0x51, 0xa7,
-	0x0e, 0xe0, 0x48, 0x65, 0x41, 0x8c, 0x65, 0x47, 0x55, 0x80, 0x05, 0x89, 0xc1, 0x4d, 0xdd, 0x34,
-	0x22, 0xc9, 0x45, 0xb1, 0x2b, 0xe0, 0x05, 0x60, 0xed, 0x23, 0xf0, 0x38, 0x1d, 0x3b, 0x76, 0x02,
-	0xda, 0x2e, 0x3c, 0x06, 0x8a, 0x9d, 0x20, 0xd8, 0x99, 0xee, 0xbe, 0xbb, 0x2f, 0xdf, 0x7d, 0x17,
-	0x1f, 0x3e, 0x08, 0x41, 0xa6, 0x20, 0xfd, 0x79, 0x1e, 0x15, 0x7c, 0x22, 0xea, 0xc8, 0xf2, 0x02,
-	0x14, 0x90, 0xff, 0xa6, 0xcb, 0xaa, 0x6a, 0xb7, 0x13, 0x41, 0x04, 0xba, 0xe5, 0x97, 0x99, 0x61,
-	0x75, 0xdd, 0x08, 0x20, 0x4a, 0x84, 0xaf, 0xd1, 0x78, 0x3e, 0xf5, 0x55, 0x9c, 0x0a, 0xa9, 0x78,
-	0x9a, 0x1b, 0x42, 0xef, 0x09, 0x61, 0x7b, 0x94, 0xf0, 0x8c, 0x10, 0x6c, 0x67, 0x3c, 0x15, 0x0e,
-	0xf2, 0x50, 0xbf, 0x15, 0xe8, 0x9c, 0x9c, 0x62, 0xbb, 0xe4, 0x3b, 0x7f, 0x3c, 0xd4, 0x6f, 0x0f,
-	0xba, 0xcc, 0x88, 0xb1, 0x5a, 0x8c, 0x5d, 0xd5, 0x62, 0xc3, 0x7f, 0xcb, 0x57, 0xd7, 0x5a, 0xbc,
-	0xb9, 0x28, 0xd0, 0x5f, 0x90, 0x3d, 0xdc, 0x9c, 0x89, 0x38, 0x9a, 0x29, 0xa7, 0xe1, 0xa1, 0x7e,
-	0x23, 0xa8, 0x50, 0x39, 0x25, 0xce, 0xa6, 0xe0, 0xd8, 0x66, 0x4a, 0x99, 0x9f, 0xd9, 0x1f, 0x2f,
-	0x2e, 0xea, 0x3d, 0x23, 0xbc, 0x7f, 0x09, 0x53, 0x75, 0xcf, 0x0b, 0x71, 0x6d, 0x76, 0x1a, 0x15,
-	0x90, 0x83, 0xe4, 0x09, 0xe9, 0xe0, 0xbf, 0x2a, 0x56, 0x49, 0x6d, 0xce, 0x00, 0xe2, 0xe1, 0xf6,
-	0x44, 0xc8, 0xb0, 0x88, 0x73, 0x15, 0x43, 0xa6, 0x4d, 0xb6, 0x82, 0xef, 0x25, 0xc2, 0xb0, 0x9d,
-	0x27, 0x3c, 0xd3, 0x1e, 0xda, 0x83, 0x0e, 0xfb, 0xf9, 0xcb, 0x58, 0xb9, 0xf7, 0xd0, 0x2e, 0x9d,
-	0x07, 0x9a, 0x57, 0x39, 0xb9, 0xc5, 0x87, 0xe7, 0x3c, 0x0b, 0x45, 0xf2, 0xcb, 0x76, 0x8c, 0xfc,
-	0xf0, 0x62, 0xb9, 0xa1, 0xd6, 0x7a, 0x43, 0xad, 0xe5, 0x96, 0xa2, 0xd5, 0x96, 0xa2, 0xf7, 0x2d,
-	0x45, 0x8b, 0x1d, 0xb5, 0x56, 0x3b, 0x6a, 0xad, 0x77, 0xd4, 0xba, 0x39, 0x8a, 0x62, 0x35, 0x9b,
-	0x8f, 0x59, 0x08, 0xa9, 0x5f, 0xdd, 0x80, 0x09, 0xc7, 0x72, 0x72, 0xe7, 0x3f, 0x7c, 0x1d, 0x84,
-	0x7a, 0xcc, 0x85, 0x1c, 0x37, 0xf5, 0x73, 0x9c, 0x7c, 0x06, 0x00, 0x00, 0xff, 0xff, 0x5d, 0x41,
-	0xa7, 0xa3, 0x2f, 0x02, 0x00, 0x00,
+	// 360 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x52, 0xbd, 0x4e, 0xeb, 0x30,
+	0x14, 0x8e, 0x6f, 0x73, 0xab, 0x5b, 0x57, 0xba, 0x83, 0x55, 0xdd, 0x1b, 0x55, 0xe0, 0x44, 0x9d,
+	0x3a, 0x80, 0x23, 0x95, 0x05, 0x31, 0x96, 0x1d, 0x55, 0x01, 0x16, 0x36, 0x37, 0x75, 0xd3, 0x88,
+	0x24, 0x27, 0xc4, 0xae, 0x80, 0x17, 0x80, 0xb5, 0x8f, 0xc0, 0xe3, 0x74, 0xec, 0xd8, 0x09, 0x68,
+	0xbb, 0xf0, 0x18, 0x28, 0x76, 0xc2, 0xcf, 0xce, 0x74, 0xfe, 0x3e, 0x9f, 0xf3, 0x9d, 0xcf, 0x07,
+	0xef, 0x85, 0x20, 0x53, 0x90, 0xfe, 0x3c, 0x8f, 0x0a, 0x3e, 0x11, 0xb5, 0x65, 0x79, 0x01, 0x0a,
+	0xc8, 0x5f, 0x53, 0x65, 0x55, 0xb6, 0xdb, 0x89, 0x20, 0x02, 0x5d, 0xf2, 0x4b, 0xcf, 0xa0, 0xba,
+	0x6e, 0x04, 0x10, 0x25, 0xc2, 0xd7, 0xd1, 0x78, 0x3e, 0xf5, 0x55, 0x9c, 0x0a, 0xa9, 0x78, 0x9a,
+	0x1b, 0x40, 0xef, 0x01, 0x61, 0x7b, 0x94, 0xf0, 0x8c, 0x10, 0x6c, 0x67, 0x3c, 0x15, 0x0e, 0xf2,
+	0x50, 0xbf, 0x15, 0x68, 0x9f, 0x1c, 0x63, 0xbb, 0xc4, 0x3b, 0xbf, 0x3c, 0xd4, 0x6f, 0x0f, 0xba,
+	0xcc, 0x34, 0x63, 0x75, 0x33, 0x76, 0x51, 0x37, 0x1b, 0xfe, 0x59, 0x3e, 0xbb, 0xd6, 0xe2, 0xc5,
+	0x45, 0x81, 0x7e, 0x41, 0xfe, 0xe1, 0xe6, 0x4c, 0xc4, 0xd1, 0x4c, 0x39, 0x0d, 0x0f, 0xf5, 0x1b,
+	0x41, 0x15, 0x95, 0x53, 0xe2, 0x6c, 0x0a, 0x8e, 0x6d, 0xa6, 0x94, 0xfe, 0x89, 0xfd, 0xf6, 0xe4,
+	0xa2, 0xde, 0x23, 0xc2, 0xff, 0xcf, 0x61, 0xaa, 0x6e, 0x79, 0x21, 0x2e, 0xcd, 0x4e, 0xa3, 0x02,
+	0x72, 0x90, 0x3c, 0x21, 0x1d, 0xfc, 0x5b, 0xc5, 0x2a, 0xa9, 0xc9, 0x99, 0x80, 0x78, 0xb8, 0x3d,
+	0x11, 0x32, 0x2c, 0xe2, 0x5c, 0xc5, 0x90, 0x69, 0x92, 0xad, 0xe0, 0x6b, 0x8a, 0x30, 0x6c, 0xe7,
+	0x09, 0xcf, 0x34, 0x87, 0xf6, 0xa0, 0xc3, 0xbe, 0x4b, 0xc6, 0xca, 0xbd, 0x87, 0x76, 0xc9, 0x3c,
+	0xd0, 0xb8, 0x8a, 0xc9, 0x0d, 0xde, 0x3f, 0xe5, 0x59, 0x28, 0x92, 0x9f, 0xa6, 0x53, 0x4b, 0xdc,
+	0xf8, 0x94, 0xd8, 0x8c, 0x1c, 0x9e, 0x2d, 0x37, 0xd4, 0x5a, 0x6f, 0xa8, 0xb5, 0xdc, 0x52, 0xb4,
+	0xda, 0x52, 0xf4, 0xba, 0xa5, 0x68, 0xb1, 0xa3, 0xd6, 0x6a, 0x47, 0xad, 0xf5, 0x8e, 0x5a, 0x57,
+	0x07, 0x51, 0xac, 0x66, 0xf3, 0x31, 0x0b, 0x21, 0xf5, 0xab, 0xbb, 0x30, 0xe6, 0x50, 0x4e, 0xae,
+	0xfd, 0xbb, 0x8f, 0x23, 0x51, 0xf7, 0xb9, 0x90, 0xe3, 0xa6, 0xfe, 0xa2, 0xa3, 0xf7, 0x01, 0x00,
+	0xce, 0xf4, 0x18, 0x0a, 0x43, 0x02, 0x00, 0x00,
 }
 
 func (this *Plan) Equal(that interface{}) bool {
@@ -278,6 +280,9 @@ func (this *CancelSoftwareUpgradeProposal) Equal(that interface{}) bool {
 	if this.Description != that1.Description {
 		return false
 	}
+	if this.Name != that1.Name {
+		return false
+	}
 	return true
 }
 func (m *Plan) Marshal() (dAtA []byte, err error) {
@@ -397,6 +402,13 @@ func (m *CancelSoftwareUpgradeProposal) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Description) > 0 {
 		i -= len(m.Description)
 		copy(dAtA[i:], m.Description)
@@ -480,6 +492,10 @@ func (m *CancelSoftwareUpgradeProposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovUpgrade(uint64(l))
 	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
 	return n
 }
 
@@ -901,6 +917,38 @@ func (m *CancelSoftwareUpgradeProposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUpgrade(dAtA[iNdEx:])